@@ -36,7 +36,7 @@ func main() {
 	initApp := server.InitServer(&config.Config, database.DB)
 
 	app := server.StartServer(initApp)
-	server.WaitForShutdown(app, func() {
+	server.WaitForShutdown(app, initApp.Scheduler.Stop, func() {
 		_ = database.Close()
 	})
 }
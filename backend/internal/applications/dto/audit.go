@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+// GetAuditLogRequest filters the audit log. ID is only meaningful together
+// with Entity.
+type GetAuditLogRequest struct {
+	Entity string `form:"entity"`
+	ID     *uint  `form:"id"`
+	Limit  int    `form:"limit"`
+	Page   int    `form:"page"`
+}
+
+type AuditEntryResponse struct {
+	ID         uint                          `json:"id"`
+	Entity     string                        `json:"entity"`
+	EntityID   uint                          `json:"entity_id"`
+	Action     string                        `json:"action"`
+	ActorID    *uint                         `json:"actor_id"`
+	ActorEmail string                        `json:"actor_email,omitempty"`
+	Changes    map[string]domain.AuditChange `json:"changes"`
+	CreatedAt  time.Time                     `json:"created_at"`
+}
+
+type AuditLogResponse struct {
+	Entries    []AuditEntryResponse `json:"entries"`
+	Pagination Pagination           `json:"pagination"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// TokenResponse is returned on login and refresh. Clients send the access
+// token as "Authorization: Bearer <token>" until it expires and then trade
+// the refresh token for a new pair.
+type TokenResponse struct {
+	AccessToken      string       `json:"access_token"`
+	AccessExpiresAt  time.Time    `json:"access_expires_at"`
+	RefreshToken     string       `json:"refresh_token"`
+	RefreshExpiresAt time.Time    `json:"refresh_expires_at"`
+	TokenType        string       `json:"token_type"`
+	User             UserResponse `json:"user"`
+}
+
+type UserResponse struct {
+	ID          uint      `json:"id"`
+	TenantID    uint      `json:"tenant_id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	Role        string    `json:"role"`
+	IsActive    bool      `json:"is_active"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateUserRequest adds a member of staff with one of the roles admin,
+// clerk, accountant or auditor.
+type CreateUserRequest struct {
+	Name     string `json:"name" binding:"required"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+	Role     string `json:"role" binding:"required"`
+}
+
+// UpdateUserRequest changes a user; fields left out keep their value.
+type UpdateUserRequest struct {
+	Name     *string `json:"name"`
+	Role     *string `json:"role"`
+	IsActive *bool   `json:"is_active"`
+	Password *string `json:"password"`
+}
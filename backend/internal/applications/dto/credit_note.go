@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type GetCreditNoteFilterRequest struct {
+	InvoiceID  *uint  `form:"invoice_id"`
+	CustomerID *uint  `form:"customer_id"`
+	Status     string `form:"status"`
+	Limit      int    `form:"limit"`
+	Page       int    `form:"page"`
+}
+
+// CreditNoteItemRequest is one credited line. A line that names the invoice
+// line it reverses takes the item, price and tax rate from it unless they are
+// given; other lines need an item and a price.
+type CreditNoteItemRequest struct {
+	InvoiceItemID *uint         `json:"invoice_item_id"`
+	ItemID        uint          `json:"item_id"`
+	Quantity      int           `json:"quantity" binding:"required,gt=0"`
+	Price         *domain.Money `json:"price"`
+	TaxRateID     *uint         `json:"tax_rate_id"`
+}
+
+type CreateCreditNoteRequest struct {
+	InvoiceID uint                    `json:"invoice_id" binding:"required"`
+	IssueDate time.Time               `json:"issue_date"`
+	Reason    string                  `json:"reason" binding:"max=255"`
+	Status    string                  `json:"status"`
+	Items     []CreditNoteItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+type UpdateCreditNoteRequest struct {
+	IssueDate time.Time               `json:"issue_date"`
+	Reason    string                  `json:"reason" binding:"max=255"`
+	Items     []CreditNoteItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+type CreditNoteResponse struct {
+	ID               uint         `json:"id"`
+	CreditNoteNumber string       `json:"credit_note_number"`
+	InvoiceID        uint         `json:"invoice_id"`
+	InvoiceNumber    string       `json:"invoice_number"`
+	CustomerName     string       `json:"customer_name"`
+	IssueDate        time.Time    `json:"issue_date"`
+	Reason           string       `json:"reason"`
+	TotalAmount      domain.Money `json:"total_amount"`
+	Currency         string       `json:"currency"`
+	Status           string       `json:"status"`
+}
+
+type CreditNoteListResponse struct {
+	CreditNotes []CreditNoteResponse `json:"credit_notes"`
+	Pagination  Pagination           `json:"pagination"`
+}
+
+type CreditNoteItemResponse struct {
+	ID            uint         `json:"id"`
+	InvoiceItemID *uint        `json:"invoice_item_id,omitempty"`
+	ItemID        uint         `json:"item_id"`
+	ItemName      string       `json:"item_name"`
+	Type          string       `json:"type"`
+	Quantity      int          `json:"quantity"`
+	Unit          string       `json:"unit"`
+	Price         domain.Money `json:"price"`
+	TotalPrice    domain.Money `json:"total_price"`
+	TaxRateID     *uint        `json:"tax_rate_id,omitempty"`
+	TaxCode       string       `json:"tax_code"`
+	TaxRate       float64      `json:"tax_rate"`
+	TaxAmount     domain.Money `json:"tax_amount"`
+}
+
+type CreditNoteDetailResponse struct {
+	ID               uint                     `json:"id"`
+	CreditNoteNumber string                   `json:"credit_note_number"`
+	InvoiceID        uint                     `json:"invoice_id"`
+	InvoiceNumber    string                   `json:"invoice_number"`
+	Customer         CustomerResponse         `json:"customer"`
+	IssueDate        time.Time                `json:"issue_date"`
+	Reason           string                   `json:"reason"`
+	Items            []CreditNoteItemResponse `json:"items"`
+	Subtotal         domain.Money             `json:"subtotal"`
+	Tax              domain.Money             `json:"tax"`
+	TaxBreakdown     []TaxBreakdownResponse   `json:"tax_breakdown"`
+	TotalAmount      domain.Money             `json:"total_amount"`
+	Currency         string                   `json:"currency"`
+	Status           string                   `json:"status"`
+	CreatedAt        time.Time                `json:"created_at"`
+	UpdatedAt        time.Time                `json:"updated_at"`
+}
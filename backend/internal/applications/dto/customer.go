@@ -1,24 +1,57 @@
 package dto
 
+import "invoice-system/internal/domain"
+
 type CreateCustomerRequest struct {
-	Name    string `json:"name" binding:"required"`
-	Email   string `json:"email" binding:"required,email"`
-	Phone   string `json:"phone" binding:"required"`
-	Address string `json:"address" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Email       string `json:"email" binding:"required,email"`
+	Phone       string `json:"phone" binding:"required"`
+	Address     string `json:"address" binding:"required"`
+	TaxRateID   *uint  `json:"tax_rate_id"`
+	Currency    string `json:"currency" binding:"omitempty,len=3"`
+	PriceListID *uint  `json:"price_list_id"`
 }
 
+// UpdateCustomerRequest changes the given fields of a customer; empty fields
+// keep their current value. ID is taken from the URL.
 type UpdateCustomerRequest struct {
-	ID      uint   `json:"id" binding:"required"`
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Phone   string `json:"phone"`
-	Address string `json:"address"`
+	ID          uint   `json:"-"`
+	Name        string `json:"name"`
+	Email       string `json:"email" binding:"omitempty,email"`
+	Phone       string `json:"phone"`
+	Address     string `json:"address"`
+	TaxRateID   *uint  `json:"tax_rate_id"`
+	Currency    string `json:"currency" binding:"omitempty,len=3"`
+	PriceListID *uint  `json:"price_list_id"`
+}
+
+type GetCustomerFilterRequest struct {
+	Search    string `form:"search"`
+	Sort      string `form:"sort"`
+	Order     string `form:"order" binding:"omitempty,oneof=asc desc"`
+	WithStats bool   `form:"with_stats"`
+	Limit     int    `form:"limit"`
+	Page      int    `form:"page"`
 }
 
 type CustomerResponse struct {
-	ID      uint   `json:"id"`
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Phone   string `json:"phone"`
-	Address string `json:"address"`
+	ID          uint                   `json:"id"`
+	Name        string                 `json:"name"`
+	Email       string                 `json:"email"`
+	Phone       string                 `json:"phone"`
+	Address     string                 `json:"address"`
+	TaxRateID   *uint                  `json:"tax_rate_id,omitempty"`
+	Currency    string                 `json:"currency"`
+	PriceListID *uint                  `json:"price_list_id,omitempty"`
+	Stats       *CustomerStatsResponse `json:"stats,omitempty"`
+}
+
+type CustomerStatsResponse struct {
+	InvoiceCount       int64        `json:"invoice_count"`
+	OutstandingBalance domain.Money `json:"outstanding_balance"`
+}
+
+type CustomerListResponse struct {
+	Customers  []CustomerResponse `json:"customers"`
+	Pagination Pagination         `json:"pagination"`
 }
@@ -0,0 +1,8 @@
+package dto
+
+// DocumentResponse is a rendered file ready to be sent to the client.
+type DocumentResponse struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
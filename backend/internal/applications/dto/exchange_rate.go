@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+type ExchangeRateRequest struct {
+	Currency string    `json:"currency" binding:"required,len=3"`
+	Rate     float64   `json:"rate" binding:"required,gt=0"`
+	RateDate time.Time `json:"rate_date" binding:"required"`
+}
+
+type ExchangeRateResponse struct {
+	ID           uint    `json:"id"`
+	BaseCurrency string  `json:"base_currency"`
+	Currency     string  `json:"currency"`
+	Rate         float64 `json:"rate"`
+	RateDate     string  `json:"rate_date"`
+}
+
+type ExchangeRateImportResponse struct {
+	Imported int `json:"imported"`
+}
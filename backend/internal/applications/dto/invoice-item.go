@@ -1,18 +1,25 @@
 package dto
 
+import "invoice-system/internal/domain"
+
 type InvoiceItemRequest struct {
-	ItemID   uint    `json:"item_id" binding:"required"`
-	Quantity int     `json:"quantity" binding:"required"`
-	Price    float64 `json:"price" binding:"required"`
+	ItemID   uint         `json:"item_id" binding:"required"`
+	Quantity int          `json:"quantity" binding:"required"`
+	Price    domain.Money `json:"price" binding:"required"`
 }
 
 type InvoiceItemResponse struct {
-	ID         uint    `json:"id"`
-	ItemID     uint    `json:"item_id"`
-	ItemName   string  `json:"item_name"`
-	Type       string  `json:"type"`
-	Quantity   int     `json:"quantity"`
-	Price      float64 `json:"price"`
-	TotalPrice float64 `json:"total_price"`
-	CreatedAt  string  `json:"created_at"`
+	ID         uint         `json:"id"`
+	ItemID     uint         `json:"item_id"`
+	ItemName   string       `json:"item_name"`
+	Type       string       `json:"type"`
+	Quantity   int          `json:"quantity"`
+	Unit       string       `json:"unit"`
+	Price      domain.Money `json:"price"`
+	TotalPrice domain.Money `json:"total_price"`
+	TaxRateID  *uint        `json:"tax_rate_id,omitempty"`
+	TaxCode    string       `json:"tax_code"`
+	TaxRate    float64      `json:"tax_rate"`
+	TaxAmount  domain.Money `json:"tax_amount"`
+	CreatedAt  string       `json:"created_at"`
 }
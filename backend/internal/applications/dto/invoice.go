@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
 
 type GetInvoiceFilterRequest struct {
 	InvoiceID    *string    `form:"invoice_id"`
@@ -10,21 +13,33 @@ type GetInvoiceFilterRequest struct {
 	CustomerName string     `form:"customer_name"`
 	DueDate      *time.Time `form:"due_date"`
 	Status       string     `form:"status"`
-	Cursor       *time.Time `form:"cursor"`
-	Limit        int        `form:"limit"`
-	Page         int        `form:"page"`
+	Currency     string     `form:"currency"`
+	// Overdue and DaysOverdueGte keep the unpaid invoices past their due
+	// date, the latter at least that many days past it.
+	Overdue        bool       `form:"overdue"`
+	DaysOverdueGte *int       `form:"days_overdue_gte"`
+	Cursor         *time.Time `form:"cursor"`
+	Limit          int        `form:"limit"`
+	Page           int        `form:"page"`
 }
 
 type InvoiceResponse struct {
-	ID            uint      `json:"id"`
-	InvoiceNumber string    `json:"invoice_number"`
-	IssueDate     time.Time `json:"issue_date"`
-	DueDate       time.Time `json:"due_date"`
-	Subject       string    `json:"subject"`
-	TotalItems    int       `json:"total_items"`
-	CustomerName  string    `json:"customer_name"`
-	TotalAmount   float64   `json:"total_amount"`
-	Status        string    `json:"status"`
+	ID             uint         `json:"id"`
+	InvoiceNumber  string       `json:"invoice_number"`
+	IssueDate      time.Time    `json:"issue_date"`
+	DueDate        time.Time    `json:"due_date"`
+	Subject        string       `json:"subject"`
+	TotalItems     int          `json:"total_items"`
+	CustomerName   string       `json:"customer_name"`
+	TotalAmount    domain.Money `json:"total_amount"`
+	AmountPaid     domain.Money `json:"amount_paid"`
+	AmountCredited domain.Money `json:"amount_credited"`
+	BalanceDue     domain.Money `json:"balance_due"`
+	Currency       string       `json:"currency"`
+	Status         string       `json:"status"`
+	DaysOverdue    int          `json:"days_overdue"`
+	OverdueAt      *time.Time   `json:"overdue_at,omitempty"`
+	VoidedAt       *time.Time   `json:"voided_at,omitempty"`
 }
 
 type InvoiceListResponse struct {
@@ -42,25 +57,39 @@ type Pagination struct {
 }
 
 type InvoiceDetailResponse struct {
-	ID            uint                  `json:"id"`
-	InvoiceNumber string                `json:"invoice_number"`
-	IssueDate     time.Time             `json:"issue_date"`
-	DueDate       time.Time             `json:"due_date"`
-	Subject       string                `json:"subject"`
-	Customer      CustomerResponse      `json:"customer"`
-	Items         []InvoiceItemResponse `json:"items"`
-	Subtotal      float64               `json:"subtotal"`
-	Tax           float64               `json:"tax"`
-	TotalAmount   float64               `json:"total_amount"`
-	Status        string                `json:"status"`
-	CreatedAt     time.Time             `json:"created_at"`
-	UpdatedAt     time.Time             `json:"updated_at"`
+	ID             uint                   `json:"id"`
+	InvoiceNumber  string                 `json:"invoice_number"`
+	IssueDate      time.Time              `json:"issue_date"`
+	DueDate        time.Time              `json:"due_date"`
+	Subject        string                 `json:"subject"`
+	Customer       CustomerResponse       `json:"customer"`
+	Items          []InvoiceItemResponse  `json:"items"`
+	Subtotal       domain.Money           `json:"subtotal"`
+	Tax            domain.Money           `json:"tax"`
+	TaxBreakdown   []TaxBreakdownResponse `json:"tax_breakdown"`
+	TotalAmount    domain.Money           `json:"total_amount"`
+	AmountPaid     domain.Money           `json:"amount_paid"`
+	AmountCredited domain.Money           `json:"amount_credited"`
+	BalanceDue     domain.Money           `json:"balance_due"`
+	Currency       string                 `json:"currency"`
+	Status         string                 `json:"status"`
+	QuoteID        *uint                  `json:"quote_id,omitempty"`
+	RecurringID    *uint                  `json:"recurring_invoice_id,omitempty"`
+	DaysOverdue    int                    `json:"days_overdue"`
+	OverdueAt      *time.Time             `json:"overdue_at,omitempty"`
+	VoidedAt       *time.Time             `json:"voided_at,omitempty"`
+	Version        uint                   `json:"version"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
 }
 
+// CreateInvoiceItemRequest is one invoice line. Price may be omitted, in
+// which case the item's catalog price is used.
 type CreateInvoiceItemRequest struct {
-	ItemID   uint    `json:"item_id" validate:"required"`
-	Quantity int     `json:"quantity" validate:"required,gt=0"`
-	Price    float64 `json:"price" validate:"required,gt=0"`
+	ItemID    uint          `json:"item_id" validate:"required"`
+	Quantity  int           `json:"quantity" binding:"required,gt=0"`
+	Price     *domain.Money `json:"price"`
+	TaxRateID *uint         `json:"tax_rate_id"`
 }
 
 type CreateInvoiceRequest struct {
@@ -68,25 +97,53 @@ type CreateInvoiceRequest struct {
 	DueDate     time.Time                  `json:"due_date" validate:"required"`
 	Subject     string                     `json:"subject"`
 	CustomerID  uint                       `json:"customer_id" validate:"required"`
-	Subtotal    float64                    `json:"subtotal" validate:"required,gt=0"`
-	TotalAmount float64                    `json:"total_amount" validate:"required,gt=0"`
-	Status      string                     `json:"status" validate:"oneof=paid unpaid"`
-	Items       []CreateInvoiceItemRequest `json:"items" validate:"required,dive"`
+	Currency    string                     `json:"currency" validate:"omitempty,len=3"`
+	Subtotal    domain.Money               `json:"subtotal" validate:"required"`
+	TotalAmount domain.Money               `json:"total_amount" validate:"required"`
+	Status      string                     `json:"status" validate:"omitempty,oneof=draft issued"`
+	Items       []CreateInvoiceItemRequest `json:"items" validate:"required,dive" binding:"dive"`
+	// QuoteID links the invoice to the quote it is converted from. It is set
+	// by the quote conversion and never read from the request body.
+	QuoteID *uint `json:"-"`
+	// RecurringID and RecurringRun identify the run of a recurring invoice
+	// that generates the invoice. They are set by the scheduler only.
+	RecurringID  *uint `json:"-"`
+	RecurringRun int   `json:"-"`
 }
 
 // UpdateInvoiceRequest represents the request payload for updating an invoice
 type UpdateInvoiceRequest struct {
-	IssueDate  time.Time `json:"issue_date"`
-	DueDate    time.Time `json:"due_date"`
-	Tax        float64
+	IssueDate  time.Time          `json:"issue_date"`
+	DueDate    time.Time          `json:"due_date"`
 	Subject    string             `json:"subject"`
 	CustomerID uint               `json:"customer_id"`
+	Currency   string             `json:"currency"`
 	Status     string             `json:"status"`
-	Items      []InvoiceItemInput `json:"items"`
+	Items      []InvoiceItemInput `json:"items" binding:"dive"`
+
+	// Version is the version of the invoice the edit was made to. The
+	// If-Match header takes its place when it is sent.
+	Version *uint `json:"version"`
+}
+
+// UpdateInvoiceStatusRequest moves an invoice to another lifecycle status.
+type UpdateInvoiceStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// DuplicateInvoiceRequest sets up the copy of an invoice. All fields are
+// optional: the copy is issued today, due after the same number of days as
+// the original, at the prices of the original.
+type DuplicateInvoiceRequest struct {
+	IssueDate time.Time `json:"issue_date"`
+	// RefreshPrices prices the lines from the catalog and the customer's
+	// price list again instead of copying the original prices.
+	RefreshPrices bool `json:"refresh_prices"`
 }
 
 type InvoiceItemInput struct {
-	ItemID   uint    `json:"item_id"`
-	Quantity int     `json:"quantity"`
-	Price    float64 `json:"price"`
+	ItemID    uint          `json:"item_id"`
+	Quantity  int           `json:"quantity" binding:"required,gt=0"`
+	Price     *domain.Money `json:"price"`
+	TaxRateID *uint         `json:"tax_rate_id"`
 }
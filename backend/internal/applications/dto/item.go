@@ -1,10 +1,17 @@
 package dto
 
+import "invoice-system/internal/domain"
+
 type DTOItemResponse struct {
-	ID       uint   `json:"id"`
-	Name     string `json:"name"`
-	Type     string `json:"type"`
-	IsActive bool   `json:"is_active"`
+	ID          uint         `json:"id"`
+	SKU         string       `json:"sku,omitempty"`
+	Name        string       `json:"name"`
+	Type        string       `json:"type"`
+	Description string       `json:"description,omitempty"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	Unit        string       `json:"unit"`
+	IsActive    bool         `json:"is_active"`
+	TaxRateID   *uint        `json:"tax_rate_id,omitempty"`
 }
 
 type DTOItemRequest struct {
@@ -13,6 +20,26 @@ type DTOItemRequest struct {
 }
 
 type DTOAddItemRequest struct {
-	Name string `json:"name" binding:"required"`
-	Type string `json:"type" binding:"required"`
+	SKU         string       `json:"sku" binding:"omitempty,max=64"`
+	Name        string       `json:"name" binding:"required"`
+	Type        string       `json:"type" binding:"required"`
+	Description string       `json:"description"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	Unit        string       `json:"unit" binding:"omitempty,max=20"`
+	TaxRateID   *uint        `json:"tax_rate_id"`
+}
+
+// DTOUpdateItemRequest changes a catalog item. Omitted fields keep their
+// current value; invoices already issued keep the price they were created
+// with.
+type DTOUpdateItemRequest struct {
+	ID          uint          `json:"-"`
+	SKU         *string       `json:"sku" binding:"omitempty,max=64"`
+	Name        string        `json:"name"`
+	Type        string        `json:"type"`
+	Description *string       `json:"description"`
+	UnitPrice   *domain.Money `json:"unit_price"`
+	Unit        string        `json:"unit" binding:"omitempty,max=20"`
+	IsActive    *bool         `json:"is_active"`
+	TaxRateID   *uint         `json:"tax_rate_id"`
 }
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type CreatePaymentRequest struct {
+	Amount      domain.Money `json:"amount"`
+	PaymentDate time.Time    `json:"payment_date"`
+	Method      string       `json:"method" binding:"required"`
+	Reference   string       `json:"reference" binding:"max=100"`
+	Notes       string       `json:"notes" binding:"max=255"`
+}
+
+type PaymentResponse struct {
+	ID          uint         `json:"id"`
+	InvoiceID   uint         `json:"invoice_id"`
+	Amount      domain.Money `json:"amount"`
+	PaymentDate time.Time    `json:"payment_date"`
+	Method      string       `json:"method"`
+	Reference   string       `json:"reference,omitempty"`
+	Notes       string       `json:"notes,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+// InvoicePaymentsResponse lists the payments of an invoice together with the
+// balance they leave.
+type InvoicePaymentsResponse struct {
+	InvoiceID      uint              `json:"invoice_id"`
+	Currency       string            `json:"currency"`
+	TotalAmount    domain.Money      `json:"total_amount"`
+	AmountPaid     domain.Money      `json:"amount_paid"`
+	AmountCredited domain.Money      `json:"amount_credited"`
+	BalanceDue     domain.Money      `json:"balance_due"`
+	Status         string            `json:"status"`
+	Payments       []PaymentResponse `json:"payments"`
+}
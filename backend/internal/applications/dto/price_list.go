@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type PriceListRequest struct {
+	Name        string     `json:"name" binding:"required,max=100"`
+	Description string     `json:"description"`
+	IsDefault   bool       `json:"is_default"`
+	ValidFrom   time.Time  `json:"valid_from" binding:"required"`
+	ValidTo     *time.Time `json:"valid_to"`
+}
+
+type PriceListItemRequest struct {
+	ItemID    uint         `json:"item_id" binding:"required"`
+	UnitPrice domain.Money `json:"unit_price"`
+}
+
+type PriceListResponse struct {
+	ID          uint                    `json:"id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	IsDefault   bool                    `json:"is_default"`
+	ValidFrom   string                  `json:"valid_from"`
+	ValidTo     *string                 `json:"valid_to"`
+	Items       []PriceListItemResponse `json:"items,omitempty"`
+}
+
+type PriceListItemResponse struct {
+	ItemID    uint         `json:"item_id"`
+	UnitPrice domain.Money `json:"unit_price"`
+}
+
+// ItemPriceRequest asks for the price of an item for a customer on a date.
+// Without a customer only default price lists apply; without a date today's
+// price is returned.
+type ItemPriceRequest struct {
+	CustomerID uint      `form:"customer_id"`
+	Date       time.Time `form:"date" time_format:"2006-01-02"`
+}
+
+type ItemPriceResponse struct {
+	ItemID      uint         `json:"item_id"`
+	CustomerID  uint         `json:"customer_id,omitempty"`
+	Date        string       `json:"date"`
+	UnitPrice   domain.Money `json:"unit_price"`
+	Unit        string       `json:"unit"`
+	PriceListID *uint        `json:"price_list_id"`
+}
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type GetQuoteFilterRequest struct {
+	CustomerID *uint  `form:"customer_id"`
+	Status     string `form:"status"`
+	Limit      int    `form:"limit"`
+	Page       int    `form:"page"`
+}
+
+// QuoteRequest creates or replaces a quote. Lines are priced like invoice
+// lines; without an expiry date the quote is valid for 30 days.
+type QuoteRequest struct {
+	CustomerID uint               `json:"customer_id" binding:"required"`
+	IssueDate  time.Time          `json:"issue_date"`
+	ExpiryDate time.Time          `json:"expiry_date"`
+	Subject    string             `json:"subject" binding:"max=255"`
+	Currency   string             `json:"currency"`
+	Items      []QuoteItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+// QuoteItemRequest is one quote line. Price may be omitted, in which case
+// the item's effective price for the customer is used.
+type QuoteItemRequest struct {
+	ItemID    uint          `json:"item_id" binding:"required"`
+	Quantity  int           `json:"quantity" binding:"required,gt=0"`
+	Price     *domain.Money `json:"price"`
+	TaxRateID *uint         `json:"tax_rate_id"`
+}
+
+type UpdateQuoteStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// ConvertQuoteRequest sets up the invoice created from a quote. All fields
+// are optional: the invoice is issued today as a draft, due in 30 days.
+type ConvertQuoteRequest struct {
+	IssueDate time.Time `json:"issue_date"`
+	DueDate   time.Time `json:"due_date"`
+	Status    string    `json:"status"`
+}
+
+type QuoteResponse struct {
+	ID           uint         `json:"id"`
+	QuoteNumber  string       `json:"quote_number"`
+	CustomerName string       `json:"customer_name"`
+	IssueDate    time.Time    `json:"issue_date"`
+	ExpiryDate   time.Time    `json:"expiry_date"`
+	Subject      string       `json:"subject"`
+	TotalAmount  domain.Money `json:"total_amount"`
+	Currency     string       `json:"currency"`
+	Status       string       `json:"status"`
+	InvoiceID    *uint        `json:"invoice_id,omitempty"`
+}
+
+type QuoteListResponse struct {
+	Quotes     []QuoteResponse `json:"quotes"`
+	Pagination Pagination      `json:"pagination"`
+}
+
+type QuoteDetailResponse struct {
+	ID           uint                   `json:"id"`
+	QuoteNumber  string                 `json:"quote_number"`
+	Customer     CustomerResponse       `json:"customer"`
+	IssueDate    time.Time              `json:"issue_date"`
+	ExpiryDate   time.Time              `json:"expiry_date"`
+	Subject      string                 `json:"subject"`
+	Items        []InvoiceItemResponse  `json:"items"`
+	Subtotal     domain.Money           `json:"subtotal"`
+	Tax          domain.Money           `json:"tax"`
+	TaxBreakdown []TaxBreakdownResponse `json:"tax_breakdown"`
+	TotalAmount  domain.Money           `json:"total_amount"`
+	Currency     string                 `json:"currency"`
+	Status       string                 `json:"status"`
+	InvoiceID    *uint                  `json:"invoice_id,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type GetRecurringInvoiceFilterRequest struct {
+	CustomerID *uint  `form:"customer_id"`
+	Status     string `form:"status"`
+	Limit      int    `form:"limit"`
+	Page       int    `form:"page"`
+}
+
+// CreateRecurringInvoiceRequest sets up a recurring invoice. The first
+// invoice is generated on the start date; without an end date or a maximum
+// number of occurrences the schedule runs until it is paused or deleted.
+type CreateRecurringInvoiceRequest struct {
+	CustomerID      uint                          `json:"customer_id" binding:"required"`
+	Subject         string                        `json:"subject" binding:"max=255"`
+	Currency        string                        `json:"currency"`
+	Interval        string                        `json:"interval" binding:"required"`
+	StartDate       time.Time                     `json:"start_date" binding:"required"`
+	EndDate         *time.Time                    `json:"end_date"`
+	MaxOccurrences  *int                          `json:"max_occurrences"`
+	PaymentTermDays *int                          `json:"payment_term_days"`
+	InvoiceStatus   string                        `json:"invoice_status"`
+	Items           []RecurringInvoiceItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+// UpdateRecurringInvoiceRequest changes what future invoices look like and
+// when the schedule ends. The interval and start date can't be changed.
+type UpdateRecurringInvoiceRequest struct {
+	CustomerID      uint                          `json:"customer_id" binding:"required"`
+	Subject         string                        `json:"subject" binding:"max=255"`
+	Currency        string                        `json:"currency"`
+	EndDate         *time.Time                    `json:"end_date"`
+	MaxOccurrences  *int                          `json:"max_occurrences"`
+	PaymentTermDays *int                          `json:"payment_term_days"`
+	InvoiceStatus   string                        `json:"invoice_status"`
+	Items           []RecurringInvoiceItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+// RecurringInvoiceItemRequest is one line of the template. Without a price,
+// every invoice takes the item's effective price on its issue date.
+type RecurringInvoiceItemRequest struct {
+	ItemID    uint          `json:"item_id" binding:"required"`
+	Quantity  int           `json:"quantity" binding:"required,gt=0"`
+	Price     *domain.Money `json:"price"`
+	TaxRateID *uint         `json:"tax_rate_id"`
+}
+
+// UpdateRecurringInvoiceStatusRequest pauses or resumes a recurring invoice.
+type UpdateRecurringInvoiceStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+type RecurringInvoiceResponse struct {
+	ID           uint       `json:"id"`
+	CustomerName string     `json:"customer_name"`
+	Subject      string     `json:"subject"`
+	Interval     string     `json:"interval"`
+	NextRunDate  time.Time  `json:"next_run_date"`
+	LastRunDate  *time.Time `json:"last_run_date"`
+	Occurrences  int        `json:"occurrences"`
+	Status       string     `json:"status"`
+}
+
+type RecurringInvoiceListResponse struct {
+	RecurringInvoices []RecurringInvoiceResponse `json:"recurring_invoices"`
+	Pagination        Pagination                 `json:"pagination"`
+}
+
+type RecurringInvoiceItemResponse struct {
+	ID        uint          `json:"id"`
+	ItemID    uint          `json:"item_id"`
+	ItemName  string        `json:"item_name"`
+	Quantity  int           `json:"quantity"`
+	Price     *domain.Money `json:"price"`
+	TaxRateID *uint         `json:"tax_rate_id"`
+}
+
+type RecurringInvoiceDetailResponse struct {
+	ID              uint                           `json:"id"`
+	Customer        CustomerResponse               `json:"customer"`
+	Subject         string                         `json:"subject"`
+	Currency        string                         `json:"currency"`
+	Interval        string                         `json:"interval"`
+	StartDate       time.Time                      `json:"start_date"`
+	NextRunDate     time.Time                      `json:"next_run_date"`
+	LastRunDate     *time.Time                     `json:"last_run_date"`
+	EndDate         *time.Time                     `json:"end_date"`
+	MaxOccurrences  *int                           `json:"max_occurrences"`
+	Occurrences     int                            `json:"occurrences"`
+	PaymentTermDays int                            `json:"payment_term_days"`
+	InvoiceStatus   string                         `json:"invoice_status"`
+	Status          string                         `json:"status"`
+	Items           []RecurringInvoiceItemResponse `json:"items"`
+	CreatedAt       time.Time                      `json:"created_at"`
+	UpdatedAt       time.Time                      `json:"updated_at"`
+}
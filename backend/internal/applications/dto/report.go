@@ -0,0 +1,139 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type ReportFilterRequest struct {
+	From *time.Time `form:"from"`
+	To   *time.Time `form:"to"`
+}
+
+type CurrencyTotalResponse struct {
+	Currency       string       `json:"currency"`
+	InvoiceCount   int64        `json:"invoice_count"`
+	Subtotal       domain.Money `json:"subtotal"`
+	Tax            domain.Money `json:"tax"`
+	TotalAmount    domain.Money `json:"total_amount"`
+	ConvertedTotal domain.Money `json:"converted_total"`
+}
+
+type InvoiceTotalsResponse struct {
+	BaseCurrency string                  `json:"base_currency"`
+	InvoiceCount int64                   `json:"invoice_count"`
+	Subtotal     domain.Money            `json:"subtotal"`
+	Tax          domain.Money            `json:"tax"`
+	TotalAmount  domain.Money            `json:"total_amount"`
+	ByCurrency   []CurrencyTotalResponse `json:"by_currency"`
+}
+
+// AgingReportRequest takes the balances at the end of AsOf, today when it
+// is not set, optionally for a single customer.
+type AgingReportRequest struct {
+	AsOf       *time.Time `form:"as_of"`
+	CustomerID *uint      `form:"customer_id"`
+}
+
+// AgingBucketsResponse splits an outstanding balance by days past due.
+type AgingBucketsResponse struct {
+	Current    domain.Money `json:"current"`
+	Days1To30  domain.Money `json:"days_1_30"`
+	Days31To60 domain.Money `json:"days_31_60"`
+	Days61To90 domain.Money `json:"days_61_90"`
+	DaysOver90 domain.Money `json:"days_over_90"`
+	Total      domain.Money `json:"total"`
+}
+
+type AgingCustomerResponse struct {
+	CustomerID   uint   `json:"customer_id"`
+	CustomerName string `json:"customer_name"`
+	Currency     string `json:"currency"`
+	AgingBucketsResponse
+}
+
+type AgingTotalResponse struct {
+	Currency string `json:"currency"`
+	AgingBucketsResponse
+}
+
+type AgingReportResponse struct {
+	AsOf      time.Time               `json:"as_of"`
+	Customers []AgingCustomerResponse `json:"customers"`
+	Totals    []AgingTotalResponse    `json:"totals"`
+}
+
+// PeriodReportRequest groups the invoices issued within [From, To] by
+// Period: day, week or month, the default. An empty Currency keeps all
+// currencies, each reported on its own lines.
+type PeriodReportRequest struct {
+	From     *time.Time `form:"from"`
+	To       *time.Time `form:"to"`
+	Period   string     `form:"period"`
+	Currency string     `form:"currency"`
+}
+
+// TopSalesRequest ranks the sales in one currency, the base currency when
+// Currency is empty. SortBy orders the top items by revenue, the default,
+// or quantity.
+type TopSalesRequest struct {
+	From     *time.Time `form:"from"`
+	To       *time.Time `form:"to"`
+	Currency string     `form:"currency"`
+	Limit    int        `form:"limit"`
+	SortBy   string     `form:"sort_by"`
+}
+
+type RevenuePeriodResponse struct {
+	PeriodStart  time.Time    `json:"period_start"`
+	Currency     string       `json:"currency"`
+	InvoiceCount int64        `json:"invoice_count"`
+	Billed       domain.Money `json:"billed"`
+	Paid         domain.Money `json:"paid"`
+	Credited     domain.Money `json:"credited"`
+	Unpaid       domain.Money `json:"unpaid"`
+}
+
+type RevenueReportResponse struct {
+	Period  string                  `json:"period"`
+	Periods []RevenuePeriodResponse `json:"periods"`
+}
+
+type TaxPeriodResponse struct {
+	PeriodStart time.Time    `json:"period_start"`
+	Currency    string       `json:"currency"`
+	TaxCode     string       `json:"tax_code"`
+	TaxRate     float64      `json:"tax_rate"`
+	Taxable     domain.Money `json:"taxable"`
+	Tax         domain.Money `json:"tax"`
+}
+
+type TaxReportResponse struct {
+	Period  string              `json:"period"`
+	Periods []TaxPeriodResponse `json:"periods"`
+}
+
+type CustomerSalesResponse struct {
+	CustomerID   uint         `json:"customer_id"`
+	CustomerName string       `json:"customer_name"`
+	InvoiceCount int64        `json:"invoice_count"`
+	Billed       domain.Money `json:"billed"`
+}
+
+type TopCustomersResponse struct {
+	Currency  string                  `json:"currency"`
+	Customers []CustomerSalesResponse `json:"customers"`
+}
+
+type ItemSalesResponse struct {
+	ItemID   uint         `json:"item_id"`
+	ItemName string       `json:"item_name"`
+	Quantity int64        `json:"quantity"`
+	Revenue  domain.Money `json:"revenue"`
+}
+
+type TopItemsResponse struct {
+	Currency string              `json:"currency"`
+	SortBy   string              `json:"sort_by"`
+	Items    []ItemSalesResponse `json:"items"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+// StatementRequest covers the days from From to To, both included. To
+// defaults to today and From to the first day of To's month. Currency
+// defaults to the customer's currency.
+type StatementRequest struct {
+	From     *time.Time `form:"from"`
+	To       *time.Time `form:"to"`
+	Currency string     `form:"currency"`
+}
+
+type StatementEntryResponse struct {
+	Type          string       `json:"type"`
+	Date          time.Time    `json:"date"`
+	Reference     string       `json:"reference"`
+	Description   string       `json:"description"`
+	InvoiceID     uint         `json:"invoice_id"`
+	InvoiceNumber string       `json:"invoice_number"`
+	Debit         domain.Money `json:"debit"`
+	Credit        domain.Money `json:"credit"`
+	Balance       domain.Money `json:"balance"`
+}
+
+type StatementResponse struct {
+	Customer       CustomerResponse         `json:"customer"`
+	Currency       string                   `json:"currency"`
+	From           time.Time                `json:"from"`
+	To             time.Time                `json:"to"`
+	OpeningBalance domain.Money             `json:"opening_balance"`
+	TotalDebits    domain.Money             `json:"total_debits"`
+	TotalCredits   domain.Money             `json:"total_credits"`
+	ClosingBalance domain.Money             `json:"closing_balance"`
+	Entries        []StatementEntryResponse `json:"entries"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "invoice-system/internal/domain"
+
+type TaxRateRequest struct {
+	Code      string  `json:"code" binding:"required,max=20"`
+	Name      string  `json:"name" binding:"required"`
+	Type      string  `json:"type" binding:"required,oneof=standard reduced zero exempt"`
+	Rate      float64 `json:"rate" binding:"gte=0,lte=100"`
+	IsDefault bool    `json:"is_default"`
+	IsActive  *bool   `json:"is_active"`
+}
+
+type TaxRateResponse struct {
+	ID        uint    `json:"id"`
+	Code      string  `json:"code"`
+	Name      string  `json:"name"`
+	Type      string  `json:"type"`
+	Rate      float64 `json:"rate"`
+	IsDefault bool    `json:"is_default"`
+	IsActive  bool    `json:"is_active"`
+}
+
+type TaxBreakdownResponse struct {
+	TaxRateID     *uint        `json:"tax_rate_id,omitempty"`
+	Code          string       `json:"code"`
+	Rate          float64      `json:"rate"`
+	TaxableAmount domain.Money `json:"taxable_amount"`
+	TaxAmount     domain.Money `json:"tax_amount"`
+}
@@ -0,0 +1,40 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+func ToDomainAuditFilter(req dto.GetAuditLogRequest) domain.AuditFilter {
+	return domain.AuditFilter{
+		EntityType: req.Entity,
+		EntityID:   req.ID,
+		Limit:      req.Limit,
+		Page:       req.Page,
+	}
+}
+
+func ToAuditEntryResponse(d domain.AuditEntry) dto.AuditEntryResponse {
+	return dto.AuditEntryResponse{
+		ID:         d.ID,
+		Entity:     d.EntityType,
+		EntityID:   d.EntityID,
+		Action:     d.Action,
+		ActorID:    d.ActorID,
+		ActorEmail: d.ActorEmail,
+		Changes:    d.Changes,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func ToAuditLogResponse(entries []domain.AuditEntry, pagination domain.Pagination) dto.AuditLogResponse {
+	resp := make([]dto.AuditEntryResponse, len(entries))
+	for i, e := range entries {
+		resp[i] = ToAuditEntryResponse(e)
+	}
+
+	return dto.AuditLogResponse{
+		Entries:    resp,
+		Pagination: ToPaginationResponse(pagination),
+	}
+}
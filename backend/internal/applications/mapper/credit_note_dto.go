@@ -0,0 +1,108 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+func ToDomainCreditNoteFilter(req dto.GetCreditNoteFilterRequest) domain.CreditNoteFilter {
+	return domain.CreditNoteFilter{
+		InvoiceID:  req.InvoiceID,
+		CustomerID: req.CustomerID,
+		Status:     req.Status,
+		Limit:      req.Limit,
+		Page:       req.Page,
+	}
+}
+
+func ToCreditNoteResponse(d domain.CreditNote) dto.CreditNoteResponse {
+	resp := dto.CreditNoteResponse{
+		ID:               d.ID,
+		CreditNoteNumber: d.CreditNoteNumber,
+		InvoiceID:        d.InvoiceID,
+		IssueDate:        d.IssueDate,
+		Reason:           d.Reason,
+		TotalAmount:      d.TotalAmount,
+		Currency:         d.Currency,
+		Status:           d.Status,
+	}
+
+	if d.Invoice != nil {
+		resp.InvoiceNumber = d.Invoice.InvoiceNumber
+	}
+	if d.Customer != nil {
+		resp.CustomerName = d.Customer.Name
+	}
+
+	return resp
+}
+
+func ToCreditNoteListResponse(creditNotes []domain.CreditNote, pagination domain.Pagination) dto.CreditNoteListResponse {
+	resp := make([]dto.CreditNoteResponse, len(creditNotes))
+	for i, cn := range creditNotes {
+		resp[i] = ToCreditNoteResponse(cn)
+	}
+
+	return dto.CreditNoteListResponse{
+		CreditNotes: resp,
+		Pagination:  ToPaginationResponse(pagination),
+	}
+}
+
+func ToCreditNoteDetailResponse(d domain.CreditNote) dto.CreditNoteDetailResponse {
+	var customer dto.CustomerResponse
+	if d.Customer != nil {
+		customer = dto.CustomerResponse{
+			ID:        d.Customer.ID,
+			Name:      d.Customer.Name,
+			Email:     d.Customer.Email,
+			Phone:     d.Customer.Phone,
+			Address:   d.Customer.Address,
+			TaxRateID: d.Customer.TaxRateID,
+			Currency:  d.Customer.Currency,
+		}
+	}
+
+	items := make([]dto.CreditNoteItemResponse, len(d.Items))
+	for i, it := range d.Items {
+		items[i] = dto.CreditNoteItemResponse{
+			ID:            it.ID,
+			InvoiceItemID: it.InvoiceItemID,
+			ItemID:        it.ItemID,
+			ItemName:      it.ItemName,
+			Type:          it.Type,
+			Quantity:      it.Quantity,
+			Unit:          it.Unit,
+			Price:         it.Price,
+			TotalPrice:    it.TotalPrice,
+			TaxRateID:     it.TaxRateID,
+			TaxCode:       it.TaxCode,
+			TaxRate:       it.TaxRate,
+			TaxAmount:     it.TaxAmount,
+		}
+	}
+
+	var invoiceNumber string
+	if d.Invoice != nil {
+		invoiceNumber = d.Invoice.InvoiceNumber
+	}
+
+	return dto.CreditNoteDetailResponse{
+		ID:               d.ID,
+		CreditNoteNumber: d.CreditNoteNumber,
+		InvoiceID:        d.InvoiceID,
+		InvoiceNumber:    invoiceNumber,
+		Customer:         customer,
+		IssueDate:        d.IssueDate,
+		Reason:           d.Reason,
+		Items:            items,
+		Subtotal:         d.Subtotal,
+		Tax:              d.Tax,
+		TaxBreakdown:     ToTaxBreakdownResponse(d.TaxBreakdown()),
+		TotalAmount:      d.TotalAmount,
+		Currency:         d.Currency,
+		Status:           d.Status,
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+	}
+}
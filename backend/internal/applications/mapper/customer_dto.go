@@ -3,35 +3,70 @@ package mapper
 import (
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/domain"
+	"strings"
 )
 
 func ToDomainCustomerCreate(req dto.CreateCustomerRequest) domain.Customer {
 	return domain.Customer{
-		Name:    req.Name,
-		Email:   req.Email,
-		Phone:   req.Phone,
-		Address: req.Address,
+		Name:        req.Name,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+		TaxRateID:   req.TaxRateID,
+		PriceListID: req.PriceListID,
+		Currency:    req.Currency,
 	}
 }
 
 func ToDomainCustomerUpdate(req dto.UpdateCustomerRequest) domain.Customer {
 	return domain.Customer{
-		ID:      req.ID,
-		Name:    req.Name,
-		Email:   req.Email,
-		Phone:   req.Phone,
-		Address: req.Address,
+		ID:          req.ID,
+		Name:        req.Name,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+		TaxRateID:   req.TaxRateID,
+		PriceListID: req.PriceListID,
+		Currency:    req.Currency,
+	}
+}
+
+func ToDomainCustomerFilter(req dto.GetCustomerFilterRequest) domain.CustomerFilter {
+	sortBy := req.Sort
+	if sortBy == "" {
+		sortBy = domain.CustomerSortName
+	}
+
+	return domain.CustomerFilter{
+		Search:    strings.TrimSpace(req.Search),
+		SortBy:    sortBy,
+		SortDesc:  req.Order == "desc",
+		WithStats: req.WithStats,
+		Limit:     req.Limit,
+		Page:      req.Page,
 	}
 }
 
 func ToCustomerResponse(d domain.Customer) dto.CustomerResponse {
-	return dto.CustomerResponse{
-		ID:      d.ID,
-		Name:    d.Name,
-		Email:   d.Email,
-		Phone:   d.Phone,
-		Address: d.Address,
+	resp := dto.CustomerResponse{
+		ID:          d.ID,
+		Name:        d.Name,
+		Email:       d.Email,
+		Phone:       d.Phone,
+		Address:     d.Address,
+		TaxRateID:   d.TaxRateID,
+		PriceListID: d.PriceListID,
+		Currency:    d.Currency,
 	}
+
+	if d.Stats != nil {
+		resp.Stats = &dto.CustomerStatsResponse{
+			InvoiceCount:       d.Stats.InvoiceCount,
+			OutstandingBalance: d.Stats.OutstandingBalance,
+		}
+	}
+
+	return resp
 }
 
 func ToCustomerResponseList(customer []domain.Customer) []dto.CustomerResponse {
@@ -41,3 +76,10 @@ func ToCustomerResponseList(customer []domain.Customer) []dto.CustomerResponse {
 	}
 	return res
 }
+
+func ToCustomerListResponse(customers []domain.Customer, pagination domain.Pagination) dto.CustomerListResponse {
+	return dto.CustomerListResponse{
+		Customers:  ToCustomerResponseList(customers),
+		Pagination: ToPaginationResponse(pagination),
+	}
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+// Domain -> DTO
+func ToExchangeRateResponse(d domain.ExchangeRate) dto.ExchangeRateResponse {
+	return dto.ExchangeRateResponse{
+		ID:           d.ID,
+		BaseCurrency: d.BaseCurrency,
+		Currency:     d.Currency,
+		Rate:         d.Rate,
+		RateDate:     d.RateDate.Format("2006-01-02"),
+	}
+}
+
+// Domain List -> DTO List
+func ToExchangeRateResponseList(rates []domain.ExchangeRate) []dto.ExchangeRateResponse {
+	res := make([]dto.ExchangeRateResponse, len(rates))
+	for i, r := range rates {
+		res[i] = ToExchangeRateResponse(r)
+	}
+	return res
+}
+
+// Request → Domain filter
+func ToDomainReportFilter(req dto.ReportFilterRequest) domain.ReportFilter {
+	return domain.ReportFilter{
+		From: req.From,
+		To:   req.To,
+	}
+}
@@ -9,16 +9,19 @@ import (
 // Request → Domain filter
 func ToDomainInvoiceFilter(req dto.GetInvoiceFilterRequest) domain.InvoiceFilter {
 	return domain.InvoiceFilter{
-		InvoiceID:    req.InvoiceID,
-		IssueDate:    req.IssueDate,
-		Subject:      req.Subject,
-		TotalItems:   req.TotalItems,
-		CustomerName: req.CustomerName,
-		DueDate:      req.DueDate,
-		Status:       req.Status,
-		Cursor:       req.Cursor,
-		Limit:        req.Limit,
-		Page:         req.Page,
+		InvoiceID:      req.InvoiceID,
+		IssueDate:      req.IssueDate,
+		Subject:        req.Subject,
+		TotalItems:     req.TotalItems,
+		CustomerName:   req.CustomerName,
+		DueDate:        req.DueDate,
+		Status:         req.Status,
+		Currency:       req.Currency,
+		Overdue:        req.Overdue,
+		DaysOverdueGte: req.DaysOverdueGte,
+		Cursor:         req.Cursor,
+		Limit:          req.Limit,
+		Page:           req.Page,
 	}
 }
 
@@ -30,15 +33,22 @@ func ToInvoiceResponse(d domain.Invoice) dto.InvoiceResponse {
 	}
 
 	return dto.InvoiceResponse{
-		ID:            d.ID,
-		InvoiceNumber: d.InvoiceNumber,
-		IssueDate:     d.IssueDate,
-		Subject:       d.Subject,
-		TotalItems:    d.TotalItems,
-		CustomerName:  customerName,
-		TotalAmount:   d.TotalAmount,
-		DueDate:       d.DueDate,
-		Status:        d.Status,
+		ID:             d.ID,
+		InvoiceNumber:  d.InvoiceNumber,
+		IssueDate:      d.IssueDate,
+		Subject:        d.Subject,
+		TotalItems:     d.TotalItems,
+		CustomerName:   customerName,
+		TotalAmount:    d.TotalAmount,
+		AmountPaid:     d.AmountPaid,
+		AmountCredited: d.AmountCredited,
+		BalanceDue:     d.BalanceDue(),
+		Currency:       d.Currency,
+		DueDate:        d.DueDate,
+		Status:         d.Status,
+		DaysOverdue:    d.DaysOverdue(time.Now()),
+		OverdueAt:      d.OverdueAt,
+		VoidedAt:       d.VoidedAt,
 	}
 }
 
@@ -46,11 +56,13 @@ func ToInvoiceDetailResponse(d domain.Invoice) dto.InvoiceDetailResponse {
 	var customer dto.CustomerResponse
 	if d.Customer != nil {
 		customer = dto.CustomerResponse{
-			ID:      d.Customer.ID,
-			Name:    d.Customer.Name,
-			Email:   d.Customer.Email,
-			Phone:   d.Customer.Phone,
-			Address: d.Customer.Address,
+			ID:        d.Customer.ID,
+			Name:      d.Customer.Name,
+			Email:     d.Customer.Email,
+			Phone:     d.Customer.Phone,
+			Address:   d.Customer.Address,
+			TaxRateID: d.Customer.TaxRateID,
+			Currency:  d.Customer.Currency,
 		}
 	}
 
@@ -62,26 +74,42 @@ func ToInvoiceDetailResponse(d domain.Invoice) dto.InvoiceDetailResponse {
 			ItemName:   item.ItemName,
 			Type:       item.Type,
 			Quantity:   item.Quantity,
+			Unit:       item.Unit,
 			Price:      item.Price,
 			TotalPrice: item.TotalPrice,
+			TaxRateID:  item.TaxRateID,
+			TaxCode:    item.TaxCode,
+			TaxRate:    item.TaxRate,
+			TaxAmount:  item.TaxAmount,
 			CreatedAt:  item.CreatedAt.Format(time.RFC3339),
 		}
 	}
 
 	return dto.InvoiceDetailResponse{
-		ID:            d.ID,
-		InvoiceNumber: d.InvoiceNumber,
-		IssueDate:     d.IssueDate,
-		DueDate:       d.DueDate,
-		Subject:       d.Subject,
-		Customer:      customer,
-		Subtotal:      d.Subtotal,
-		Tax:           d.Tax,
-		TotalAmount:   d.TotalAmount,
-		Status:        d.Status,
-		Items:         items,
-		CreatedAt:     d.CreatedAt,
-		UpdatedAt:     d.UpdatedAt,
+		ID:             d.ID,
+		InvoiceNumber:  d.InvoiceNumber,
+		IssueDate:      d.IssueDate,
+		DueDate:        d.DueDate,
+		Subject:        d.Subject,
+		Customer:       customer,
+		Subtotal:       d.Subtotal,
+		Tax:            d.Tax,
+		TaxBreakdown:   ToTaxBreakdownResponse(d.TaxBreakdown()),
+		TotalAmount:    d.TotalAmount,
+		AmountPaid:     d.AmountPaid,
+		AmountCredited: d.AmountCredited,
+		BalanceDue:     d.BalanceDue(),
+		Currency:       d.Currency,
+		Status:         d.Status,
+		QuoteID:        d.QuoteID,
+		RecurringID:    d.RecurringID,
+		DaysOverdue:    d.DaysOverdue(time.Now()),
+		OverdueAt:      d.OverdueAt,
+		VoidedAt:       d.VoidedAt,
+		Items:          items,
+		Version:        d.Version,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
 	}
 }
 
@@ -91,14 +119,18 @@ func ToInvoiceListResponse(invoices []domain.Invoice, pagination domain.Paginati
 		resp[i] = ToInvoiceResponse(inv)
 	}
 	return dto.InvoiceListResponse{
-		Invoices: resp,
-		Pagination: dto.Pagination{
-			TotalItems:  pagination.TotalItems,
-			TotalPages:  pagination.TotalPages,
-			CurrentPage: pagination.CurrentPage,
-			PrevPage:    pagination.PrevPage,
-			NextPage:    pagination.NextPage,
-			Limit:       pagination.Limit,
-		},
+		Invoices:   resp,
+		Pagination: ToPaginationResponse(pagination),
+	}
+}
+
+func ToPaginationResponse(p domain.Pagination) dto.Pagination {
+	return dto.Pagination{
+		TotalItems:  p.TotalItems,
+		TotalPages:  p.TotalPages,
+		CurrentPage: p.CurrentPage,
+		PrevPage:    p.PrevPage,
+		NextPage:    p.NextPage,
+		Limit:       p.Limit,
 	}
 }
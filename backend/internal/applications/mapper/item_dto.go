@@ -3,15 +3,21 @@ package mapper
 import (
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/domain"
+	"strings"
 )
 
 // Domain -> DTO
 func ToDTOItemResponse(d domain.Item) dto.DTOItemResponse {
 	return dto.DTOItemResponse{
-		ID:       d.ID,
-		Name:     d.Name,
-		Type:     d.Type,
-		IsActive: d.IsActive,
+		ID:          d.ID,
+		SKU:         d.SKU,
+		Name:        d.Name,
+		Type:        d.Type,
+		Description: d.Description,
+		UnitPrice:   d.UnitPrice,
+		Unit:        d.Unit,
+		IsActive:    d.IsActive,
+		TaxRateID:   d.TaxRateID,
 	}
 }
 
@@ -26,8 +32,18 @@ func ToDTOItemResponseList(items []domain.Item) []dto.DTOItemResponse {
 
 // DTO -> Domain
 func ToDomainAddItemRequest(req dto.DTOAddItemRequest) domain.Item {
+	unit := strings.TrimSpace(req.Unit)
+	if unit == "" {
+		unit = domain.DefaultItemUnit
+	}
+
 	return domain.Item{
-		Name: req.Name,
-		Type: req.Type,
+		SKU:         strings.TrimSpace(req.SKU),
+		Name:        req.Name,
+		Type:        req.Type,
+		Description: req.Description,
+		UnitPrice:   req.UnitPrice,
+		Unit:        unit,
+		TaxRateID:   req.TaxRateID,
 	}
 }
@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"strings"
+)
+
+// DTO -> Domain
+func ToDomainPayment(invoiceID uint, req dto.CreatePaymentRequest) domain.Payment {
+	return domain.Payment{
+		InvoiceID:   invoiceID,
+		Amount:      req.Amount,
+		PaymentDate: req.PaymentDate,
+		Method:      strings.ToLower(strings.TrimSpace(req.Method)),
+		Reference:   strings.TrimSpace(req.Reference),
+		Notes:       req.Notes,
+	}
+}
+
+// Domain -> DTO
+func ToPaymentResponse(d domain.Payment) dto.PaymentResponse {
+	return dto.PaymentResponse{
+		ID:          d.ID,
+		InvoiceID:   d.InvoiceID,
+		Amount:      d.Amount,
+		PaymentDate: d.PaymentDate,
+		Method:      d.Method,
+		Reference:   d.Reference,
+		Notes:       d.Notes,
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func ToInvoicePaymentsResponse(inv domain.Invoice, payments []domain.Payment) dto.InvoicePaymentsResponse {
+	resp := make([]dto.PaymentResponse, len(payments))
+	for i, p := range payments {
+		resp[i] = ToPaymentResponse(p)
+	}
+
+	return dto.InvoicePaymentsResponse{
+		InvoiceID:      inv.ID,
+		Currency:       inv.Currency,
+		TotalAmount:    inv.TotalAmount,
+		AmountPaid:     inv.AmountPaid,
+		AmountCredited: inv.AmountCredited,
+		BalanceDue:     inv.BalanceDue(),
+		Status:         inv.Status,
+		Payments:       resp,
+	}
+}
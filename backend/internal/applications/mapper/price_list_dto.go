@@ -0,0 +1,65 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"time"
+)
+
+// DTO -> Domain
+func ToDomainPriceList(req dto.PriceListRequest) domain.PriceList {
+	return domain.PriceList{
+		Name:        req.Name,
+		Description: req.Description,
+		IsDefault:   req.IsDefault,
+		ValidFrom:   req.ValidFrom,
+		ValidTo:     req.ValidTo,
+	}
+}
+
+// Domain -> DTO
+func ToPriceListResponse(d domain.PriceList) dto.PriceListResponse {
+	var validTo *string
+	if d.ValidTo != nil {
+		s := d.ValidTo.Format("2006-01-02")
+		validTo = &s
+	}
+
+	var items []dto.PriceListItemResponse
+	for _, it := range d.Items {
+		items = append(items, dto.PriceListItemResponse{
+			ItemID:    it.ItemID,
+			UnitPrice: it.UnitPrice,
+		})
+	}
+
+	return dto.PriceListResponse{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		IsDefault:   d.IsDefault,
+		ValidFrom:   d.ValidFrom.Format("2006-01-02"),
+		ValidTo:     validTo,
+		Items:       items,
+	}
+}
+
+// Domain List -> DTO List
+func ToPriceListResponseList(lists []domain.PriceList) []dto.PriceListResponse {
+	res := make([]dto.PriceListResponse, len(lists))
+	for i, l := range lists {
+		res[i] = ToPriceListResponse(l)
+	}
+	return res
+}
+
+func ToItemPriceResponse(price domain.ItemPrice, customerID uint, date time.Time) dto.ItemPriceResponse {
+	return dto.ItemPriceResponse{
+		ItemID:      price.Item.ID,
+		CustomerID:  customerID,
+		Date:        date.Format("2006-01-02"),
+		UnitPrice:   price.UnitPrice,
+		Unit:        price.Item.Unit,
+		PriceListID: price.PriceListID,
+	}
+}
@@ -0,0 +1,101 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"time"
+)
+
+func ToDomainQuoteFilter(req dto.GetQuoteFilterRequest) domain.QuoteFilter {
+	return domain.QuoteFilter{
+		CustomerID: req.CustomerID,
+		Status:     req.Status,
+		Limit:      req.Limit,
+		Page:       req.Page,
+	}
+}
+
+func ToQuoteResponse(d domain.Quote) dto.QuoteResponse {
+	var customerName string
+	if d.Customer != nil {
+		customerName = d.Customer.Name
+	}
+
+	return dto.QuoteResponse{
+		ID:           d.ID,
+		QuoteNumber:  d.QuoteNumber,
+		CustomerName: customerName,
+		IssueDate:    d.IssueDate,
+		ExpiryDate:   d.ExpiryDate,
+		Subject:      d.Subject,
+		TotalAmount:  d.TotalAmount,
+		Currency:     d.Currency,
+		Status:       d.Status,
+		InvoiceID:    d.InvoiceID,
+	}
+}
+
+func ToQuoteListResponse(quotes []domain.Quote, pagination domain.Pagination) dto.QuoteListResponse {
+	resp := make([]dto.QuoteResponse, len(quotes))
+	for i, q := range quotes {
+		resp[i] = ToQuoteResponse(q)
+	}
+
+	return dto.QuoteListResponse{
+		Quotes:     resp,
+		Pagination: ToPaginationResponse(pagination),
+	}
+}
+
+func ToQuoteDetailResponse(d domain.Quote) dto.QuoteDetailResponse {
+	var customer dto.CustomerResponse
+	if d.Customer != nil {
+		customer = dto.CustomerResponse{
+			ID:        d.Customer.ID,
+			Name:      d.Customer.Name,
+			Email:     d.Customer.Email,
+			Phone:     d.Customer.Phone,
+			Address:   d.Customer.Address,
+			TaxRateID: d.Customer.TaxRateID,
+			Currency:  d.Customer.Currency,
+		}
+	}
+
+	items := make([]dto.InvoiceItemResponse, len(d.Items))
+	for i, item := range d.Items {
+		items[i] = dto.InvoiceItemResponse{
+			ID:         item.ID,
+			ItemID:     item.ItemID,
+			ItemName:   item.ItemName,
+			Type:       item.Type,
+			Quantity:   item.Quantity,
+			Unit:       item.Unit,
+			Price:      item.Price,
+			TotalPrice: item.TotalPrice,
+			TaxRateID:  item.TaxRateID,
+			TaxCode:    item.TaxCode,
+			TaxRate:    item.TaxRate,
+			TaxAmount:  item.TaxAmount,
+			CreatedAt:  item.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return dto.QuoteDetailResponse{
+		ID:           d.ID,
+		QuoteNumber:  d.QuoteNumber,
+		Customer:     customer,
+		IssueDate:    d.IssueDate,
+		ExpiryDate:   d.ExpiryDate,
+		Subject:      d.Subject,
+		Items:        items,
+		Subtotal:     d.Subtotal,
+		Tax:          d.Tax,
+		TaxBreakdown: ToTaxBreakdownResponse(d.TaxBreakdown()),
+		TotalAmount:  d.TotalAmount,
+		Currency:     d.Currency,
+		Status:       d.Status,
+		InvoiceID:    d.InvoiceID,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
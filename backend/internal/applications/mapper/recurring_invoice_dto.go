@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+func ToDomainRecurringInvoiceFilter(req dto.GetRecurringInvoiceFilterRequest) domain.RecurringInvoiceFilter {
+	return domain.RecurringInvoiceFilter{
+		CustomerID: req.CustomerID,
+		Status:     req.Status,
+		Limit:      req.Limit,
+		Page:       req.Page,
+	}
+}
+
+func ToRecurringInvoiceResponse(d domain.RecurringInvoice) dto.RecurringInvoiceResponse {
+	var customerName string
+	if d.Customer != nil {
+		customerName = d.Customer.Name
+	}
+
+	return dto.RecurringInvoiceResponse{
+		ID:           d.ID,
+		CustomerName: customerName,
+		Subject:      d.Subject,
+		Interval:     d.Interval,
+		NextRunDate:  d.NextRunDate,
+		LastRunDate:  d.LastRunDate,
+		Occurrences:  d.Occurrences,
+		Status:       d.Status,
+	}
+}
+
+func ToRecurringInvoiceListResponse(recurring []domain.RecurringInvoice, pagination domain.Pagination) dto.RecurringInvoiceListResponse {
+	resp := make([]dto.RecurringInvoiceResponse, len(recurring))
+	for i, r := range recurring {
+		resp[i] = ToRecurringInvoiceResponse(r)
+	}
+
+	return dto.RecurringInvoiceListResponse{
+		RecurringInvoices: resp,
+		Pagination:        ToPaginationResponse(pagination),
+	}
+}
+
+func ToRecurringInvoiceDetailResponse(d domain.RecurringInvoice) dto.RecurringInvoiceDetailResponse {
+	var customer dto.CustomerResponse
+	if d.Customer != nil {
+		customer = dto.CustomerResponse{
+			ID:        d.Customer.ID,
+			Name:      d.Customer.Name,
+			Email:     d.Customer.Email,
+			Phone:     d.Customer.Phone,
+			Address:   d.Customer.Address,
+			TaxRateID: d.Customer.TaxRateID,
+			Currency:  d.Customer.Currency,
+		}
+	}
+
+	items := make([]dto.RecurringInvoiceItemResponse, len(d.Items))
+	for i, item := range d.Items {
+		items[i] = dto.RecurringInvoiceItemResponse{
+			ID:        item.ID,
+			ItemID:    item.ItemID,
+			ItemName:  item.ItemName,
+			Quantity:  item.Quantity,
+			Price:     item.Price,
+			TaxRateID: item.TaxRateID,
+		}
+	}
+
+	return dto.RecurringInvoiceDetailResponse{
+		ID:              d.ID,
+		Customer:        customer,
+		Subject:         d.Subject,
+		Currency:        d.Currency,
+		Interval:        d.Interval,
+		StartDate:       d.StartDate,
+		NextRunDate:     d.NextRunDate,
+		LastRunDate:     d.LastRunDate,
+		EndDate:         d.EndDate,
+		MaxOccurrences:  d.MaxOccurrences,
+		Occurrences:     d.Occurrences,
+		PaymentTermDays: d.PaymentTermDays,
+		InvoiceStatus:   d.InvoiceStatus,
+		Status:          d.Status,
+		Items:           items,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+	}
+}
@@ -0,0 +1,67 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+func ToAgingBucketsResponse(r domain.AgingRow) dto.AgingBucketsResponse {
+	return dto.AgingBucketsResponse{
+		Current:    r.Current,
+		Days1To30:  r.Days1To30,
+		Days31To60: r.Days31To60,
+		Days61To90: r.Days61To90,
+		DaysOver90: r.DaysOver90,
+		Total:      r.Total(),
+	}
+}
+
+func ToAgingCustomerResponse(r domain.AgingRow) dto.AgingCustomerResponse {
+	return dto.AgingCustomerResponse{
+		CustomerID:           r.CustomerID,
+		CustomerName:         r.CustomerName,
+		Currency:             r.Currency,
+		AgingBucketsResponse: ToAgingBucketsResponse(r),
+	}
+}
+
+func ToRevenuePeriodResponse(r domain.RevenueTotal) dto.RevenuePeriodResponse {
+	return dto.RevenuePeriodResponse{
+		PeriodStart:  r.PeriodStart,
+		Currency:     r.Currency,
+		InvoiceCount: r.InvoiceCount,
+		Billed:       r.Billed,
+		Paid:         r.Paid,
+		Credited:     r.Credited,
+		Unpaid:       r.Unpaid(),
+	}
+}
+
+func ToTaxPeriodResponse(t domain.TaxTotal) dto.TaxPeriodResponse {
+	return dto.TaxPeriodResponse{
+		PeriodStart: t.PeriodStart,
+		Currency:    t.Currency,
+		TaxCode:     t.TaxCode,
+		TaxRate:     t.TaxRate,
+		Taxable:     t.Taxable,
+		Tax:         t.Tax,
+	}
+}
+
+func ToCustomerSalesResponse(c domain.CustomerSales) dto.CustomerSalesResponse {
+	return dto.CustomerSalesResponse{
+		CustomerID:   c.CustomerID,
+		CustomerName: c.CustomerName,
+		InvoiceCount: c.InvoiceCount,
+		Billed:       c.Billed,
+	}
+}
+
+func ToItemSalesResponse(i domain.ItemSales) dto.ItemSalesResponse {
+	return dto.ItemSalesResponse{
+		ItemID:   i.ItemID,
+		ItemName: i.ItemName,
+		Quantity: i.Quantity,
+		Revenue:  i.Revenue,
+	}
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+func ToStatementResponse(s domain.Statement) dto.StatementResponse {
+	entries := make([]dto.StatementEntryResponse, len(s.Entries))
+	for i, e := range s.Entries {
+		entries[i] = dto.StatementEntryResponse{
+			Type:          e.Type,
+			Date:          e.Date,
+			Reference:     e.Reference,
+			Description:   e.Description,
+			InvoiceID:     e.InvoiceID,
+			InvoiceNumber: e.InvoiceNumber,
+			Debit:         e.Debit,
+			Credit:        e.Credit,
+			Balance:       e.Balance,
+		}
+	}
+
+	return dto.StatementResponse{
+		Customer:       ToCustomerResponse(s.Customer),
+		Currency:       s.Currency,
+		From:           s.From,
+		To:             s.To,
+		OpeningBalance: s.OpeningBalance,
+		TotalDebits:    s.TotalDebits(),
+		TotalCredits:   s.TotalCredits(),
+		ClosingBalance: s.ClosingBalance(),
+		Entries:        entries,
+	}
+}
@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+// DTO -> Domain
+func ToDomainTaxRate(req dto.TaxRateRequest) domain.TaxRate {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	return domain.TaxRate{
+		Code:      req.Code,
+		Name:      req.Name,
+		Type:      req.Type,
+		Rate:      req.Rate,
+		IsDefault: req.IsDefault,
+		IsActive:  isActive,
+	}
+}
+
+// Domain -> DTO
+func ToTaxRateResponse(d domain.TaxRate) dto.TaxRateResponse {
+	return dto.TaxRateResponse{
+		ID:        d.ID,
+		Code:      d.Code,
+		Name:      d.Name,
+		Type:      d.Type,
+		Rate:      d.Rate,
+		IsDefault: d.IsDefault,
+		IsActive:  d.IsActive,
+	}
+}
+
+// Domain List -> DTO List
+func ToTaxRateResponseList(rates []domain.TaxRate) []dto.TaxRateResponse {
+	res := make([]dto.TaxRateResponse, len(rates))
+	for i, r := range rates {
+		res[i] = ToTaxRateResponse(r)
+	}
+	return res
+}
+
+func ToTaxBreakdownResponse(breakdown []domain.TaxBreakdown) []dto.TaxBreakdownResponse {
+	res := make([]dto.TaxBreakdownResponse, len(breakdown))
+	for i, b := range breakdown {
+		res[i] = dto.TaxBreakdownResponse{
+			TaxRateID:     b.TaxRateID,
+			Code:          b.Code,
+			Rate:          b.Rate,
+			TaxableAmount: b.TaxableAmount,
+			TaxAmount:     b.TaxAmount,
+		}
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+func ToUserResponse(d domain.User) dto.UserResponse {
+	return dto.UserResponse{
+		ID:          d.ID,
+		TenantID:    d.TenantID,
+		Name:        d.Name,
+		Email:       d.Email,
+		Role:        d.Role,
+		IsActive:    d.IsActive,
+		Permissions: permissionNames(d),
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func ToUserResponses(users []domain.User) []dto.UserResponse {
+	result := make([]dto.UserResponse, len(users))
+	for i, u := range users {
+		result[i] = ToUserResponse(u)
+	}
+	return result
+}
+
+// permissionNames lists what the user may do, so clients can hide what
+// would be refused anyway.
+func permissionNames(u domain.User) []string {
+	names := []string{}
+	for _, p := range domain.AllPermissions() {
+		if u.Can(p) {
+			names = append(names, string(p))
+		}
+	}
+	return names
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+// AuditRepository reads the audit log. Entries are written by the
+// repositories of the audited records, in the transaction making the change.
+type AuditRepository interface {
+	GetAuditEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, domain.Pagination, error)
+}
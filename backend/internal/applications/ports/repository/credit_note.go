@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+type CreditNoteRepository interface {
+	GetAllCreditNotes(ctx context.Context, filter domain.CreditNoteFilter) ([]domain.CreditNote, domain.Pagination, error)
+	GetCreditNoteByID(ctx context.Context, id uint) (domain.CreditNote, error)
+	// CreateCreditNote numbers and stores the credit note. One created as
+	// issued is applied to its invoice in the same transaction, with the
+	// invoice row locked like for payments.
+	CreateCreditNote(ctx context.Context, creditNote *domain.CreditNote) error
+	// UpdateCreditNote replaces the header and lines of a draft. It fails
+	// with utils.ErrCreditNoteLocked once the credit note is issued.
+	UpdateCreditNote(ctx context.Context, id uint, creditNote domain.CreditNote) error
+	// IssueCreditNote issues a draft and applies it to its invoice.
+	IssueCreditNote(ctx context.Context, id uint) error
+	DeleteCreditNote(ctx context.Context, id uint) error
+	// GetCreditedQuantities returns the quantity already credited per line of
+	// the invoice, leaving out the credit note excludeID.
+	GetCreditedQuantities(ctx context.Context, invoiceID, excludeID uint) (map[uint]int, error)
+}
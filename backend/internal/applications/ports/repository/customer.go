@@ -1,8 +1,16 @@
 package repository
 
-import "invoice-system/internal/domain"
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
 
 type CustomerRepository interface {
-	CreateCustomer(customer *domain.Customer) error
-	FindCustomers() ([]domain.Customer, error)
+	CreateCustomer(ctx context.Context, customer *domain.Customer) error
+	FindCustomers(ctx context.Context, filter domain.CustomerFilter) ([]domain.Customer, domain.Pagination, error)
+	GetCustomerByID(ctx context.Context, id uint) (domain.Customer, error)
+	UpdateCustomer(ctx context.Context, customer domain.Customer) error
+	DeleteCustomer(ctx context.Context, id uint) error
+	RestoreCustomer(ctx context.Context, id uint) error
+	CountOutstandingInvoices(ctx context.Context, customerID uint) (int64, error)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type ExchangeRateRepository interface {
+	GetRates(ctx context.Context, baseCurrency, currency string) ([]domain.ExchangeRate, error)
+	// GetRateOn returns the most recent rate published on or before date.
+	GetRateOn(ctx context.Context, baseCurrency, currency string, date time.Time) (domain.ExchangeRate, error)
+	UpsertRates(ctx context.Context, rates []domain.ExchangeRate) error
+}
@@ -1,12 +1,33 @@
 package repository
 
 import (
+	"context"
 	"invoice-system/internal/domain"
+	"time"
 )
 
 type InvoiceRepository interface {
-	GetAllInvoices(filters domain.InvoiceFilter) ([]domain.Invoice, domain.Pagination, error)
-	CreateInvoice(invoice domain.Invoice) error
-	GetInvoiceByID(id uint) (domain.Invoice, error)
-	UpdateInvoice(id uint, invoice domain.Invoice) error
+	GetAllInvoices(ctx context.Context, filters domain.InvoiceFilter) ([]domain.Invoice, domain.Pagination, error)
+	// CreateInvoice numbers and stores the invoice, filling in its ID and
+	// number. An invoice created from a quote also marks the quote accepted
+	// and linked to it, in the same transaction. It fails with
+	// utils.ErrQuoteAlreadyConverted when another invoice was already
+	// created from the same quote, and with
+	// utils.ErrRecurringRunExists when the recurring run was already invoiced.
+	CreateInvoice(ctx context.Context, invoice *domain.Invoice) error
+	GetInvoiceByID(ctx context.Context, id uint) (domain.Invoice, error)
+	UpdateInvoice(ctx context.Context, id uint, invoice domain.Invoice) error
+	// UpdateInvoiceStatus moves the invoice from one status to another. It
+	// fails with utils.ErrInvalidStatusTransition when the invoice is no
+	// longer in status from.
+	UpdateInvoiceStatus(ctx context.Context, id uint, from, to string) error
+	// MarkOverdueInvoices moves the issued and partially paid invoices due
+	// before asOf to overdue, stamping them with now, and returns how many
+	// were moved. It is safe to run concurrently.
+	MarkOverdueInvoices(ctx context.Context, asOf, now time.Time) (int64, error)
+	// DeleteInvoice removes a draft invoice and its items for good and
+	// soft-deletes any other invoice. It fails with utils.ErrInvoiceNotFound
+	// when there is no such invoice, and with utils.ErrInvoiceHasSettlements
+	// when payments or issued credit notes were recorded against it.
+	DeleteInvoice(ctx context.Context, id uint) error
 }
@@ -1,8 +1,14 @@
 package repository
 
-import "invoice-system/internal/domain"
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
 
 type ItemRepository interface {
-	GetAllItems(NameOrType string, limit uint) ([]domain.Item, error)
-	AddItem(item domain.Item) error
+	GetAllItems(ctx context.Context, NameOrType string, limit uint) ([]domain.Item, error)
+	GetItemByID(ctx context.Context, id uint) (domain.Item, error)
+	GetItemsByIDs(ctx context.Context, ids []uint) (map[uint]domain.Item, error)
+	AddItem(ctx context.Context, item domain.Item) error
+	UpdateItem(ctx context.Context, item domain.Item) error
 }
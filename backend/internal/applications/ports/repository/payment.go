@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+type PaymentRepository interface {
+	GetPaymentsByInvoiceID(ctx context.Context, invoiceID uint) ([]domain.Payment, error)
+	// CreatePayment records the payment and applies it to its invoice in one
+	// transaction, with the invoice row locked so concurrent payments can't
+	// both pass the balance check.
+	CreatePayment(ctx context.Context, payment *domain.Payment) (domain.Invoice, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type PriceListRepository interface {
+	GetAllPriceLists(ctx context.Context) ([]domain.PriceList, error)
+	GetPriceListByID(ctx context.Context, id uint) (domain.PriceList, error)
+	CreatePriceList(ctx context.Context, priceList *domain.PriceList) error
+	UpdatePriceList(ctx context.Context, priceList domain.PriceList) error
+	UpsertPriceListItem(ctx context.Context, item domain.PriceListItem) error
+	DeletePriceListItem(ctx context.Context, priceListID, itemID uint) error
+	// GetEffectivePrices returns the prices of itemIDs on every list in
+	// effect on date that is either a default list or priceListID.
+	GetEffectivePrices(ctx context.Context, itemIDs []uint, priceListID *uint, date time.Time) ([]domain.PriceListPrice, error)
+}
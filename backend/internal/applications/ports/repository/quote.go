@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+type QuoteRepository interface {
+	GetAllQuotes(ctx context.Context, filter domain.QuoteFilter) ([]domain.Quote, domain.Pagination, error)
+	GetQuoteByID(ctx context.Context, id uint) (domain.Quote, error)
+	// CreateQuote numbers and stores the quote, filling in its ID and number.
+	CreateQuote(ctx context.Context, quote *domain.Quote) error
+	// UpdateQuote replaces the header and lines of a draft. It fails with
+	// utils.ErrQuoteLocked once the quote has been sent.
+	UpdateQuote(ctx context.Context, id uint, quote domain.Quote) error
+	// UpdateQuoteStatus moves the quote from one status to another. It fails
+	// with utils.ErrInvalidQuoteTransition when the quote is no longer in
+	// status from.
+	UpdateQuoteStatus(ctx context.Context, id uint, from, to string) error
+}
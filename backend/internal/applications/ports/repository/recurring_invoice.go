@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type RecurringInvoiceRepository interface {
+	GetAllRecurringInvoices(ctx context.Context, filter domain.RecurringInvoiceFilter) ([]domain.RecurringInvoice, domain.Pagination, error)
+	GetRecurringInvoiceByID(ctx context.Context, id uint) (domain.RecurringInvoice, error)
+	// CreateRecurringInvoice stores the template, filling in its ID.
+	CreateRecurringInvoice(ctx context.Context, recurring *domain.RecurringInvoice) error
+	// UpdateRecurringInvoice replaces the lines and the invoice settings of
+	// the template. The schedule itself is left to UpdateRecurringSchedule.
+	UpdateRecurringInvoice(ctx context.Context, id uint, recurring domain.RecurringInvoice) error
+	DeleteRecurringInvoice(ctx context.Context, id uint) error
+	// GetDueRecurringInvoices lists the active templates with a run on or
+	// before date, including their lines.
+	GetDueRecurringInvoices(ctx context.Context, date time.Time) ([]domain.RecurringInvoice, error)
+	// UpdateRecurringSchedule stores the next run, occurrence count and
+	// status of the template. It fails with utils.ErrRecurringRunConflict
+	// when the template no longer has fromOccurrences occurrences.
+	UpdateRecurringSchedule(ctx context.Context, recurring domain.RecurringInvoice, fromOccurrences int) error
+}
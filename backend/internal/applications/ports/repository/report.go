@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+type ReportRepository interface {
+	GetInvoiceTotalsByCurrencyDay(ctx context.Context, filter domain.ReportFilter) ([]domain.CurrencyDayTotal, error)
+	// GetAgingRows returns the balance each customer still owed on the
+	// as-of day per currency, bucketed by days past due. Customers who owed
+	// nothing are left out.
+	GetAgingRows(ctx context.Context, filter domain.AgingFilter) ([]domain.AgingRow, error)
+	// GetRevenueByPeriod, GetTaxByPeriod, GetTopCustomers and GetTopItems
+	// only count invoices that were billed, leaving out drafts and cancelled
+	// or void invoices.
+	GetRevenueByPeriod(ctx context.Context, filter domain.ReportFilter, period string) ([]domain.RevenueTotal, error)
+	GetTaxByPeriod(ctx context.Context, filter domain.ReportFilter, period string) ([]domain.TaxTotal, error)
+	GetTopCustomers(ctx context.Context, filter domain.ReportFilter, limit int) ([]domain.CustomerSales, error)
+	// GetTopItems ranks items by domain.ItemSalesByRevenue or
+	// domain.ItemSalesByQuantity and fails with utils.ErrInvalidSortField
+	// for any other order.
+	GetTopItems(ctx context.Context, filter domain.ReportFilter, orderBy string, limit int) ([]domain.ItemSales, error)
+}
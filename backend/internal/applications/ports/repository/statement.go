@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+type StatementRepository interface {
+	// GetStatement collects the billed invoices of the customer in the
+	// filter's currency with their payments and issued credit notes. Those
+	// dated before From make up the opening balance, those from From to the
+	// end of the day of To are the entries. Draft, cancelled and void
+	// invoices and everything on them are left out.
+	GetStatement(ctx context.Context, filter domain.StatementFilter) (domain.Statement, error)
+}
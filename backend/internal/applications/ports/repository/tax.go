@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+type TaxRateRepository interface {
+	GetAllTaxRates(ctx context.Context) ([]domain.TaxRate, error)
+	GetTaxRateByID(ctx context.Context, id uint) (domain.TaxRate, error)
+	GetDefaultTaxRate(ctx context.Context) (domain.TaxRate, error)
+	CreateTaxRate(ctx context.Context, rate *domain.TaxRate) error
+	UpdateTaxRate(ctx context.Context, id uint, rate domain.TaxRate) error
+	GetCustomerTaxRate(ctx context.Context, customerID uint) (*domain.TaxRate, error)
+	GetItemTaxRates(ctx context.Context, itemIDs []uint) (map[uint]domain.TaxRate, error)
+}
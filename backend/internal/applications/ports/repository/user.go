@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"invoice-system/internal/domain"
+)
+
+type UserRepository interface {
+	// CreateUser stores the user and fills in its ID. It fails with
+	// utils.ErrUserAlreadyExists when the email is taken.
+	CreateUser(ctx context.Context, user *domain.User) error
+	// GetUserByID and GetUserByEmail fail with utils.ErrUserNotFound when
+	// there is no such user.
+	GetUserByID(ctx context.Context, id uint) (domain.User, error)
+	GetUserByEmail(ctx context.Context, email string) (domain.User, error)
+	GetAllUsers(ctx context.Context) ([]domain.User, error)
+	// UpdateUser saves the name, role, active flag and password hash of the
+	// user.
+	UpdateUser(ctx context.Context, user domain.User) error
+	CountUsers(ctx context.Context) (int64, error)
+	// CountActiveAdmins counts the active users with the admin role.
+	CountActiveAdmins(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,10 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type AuditService interface {
+	GetAuditLog(ctx context.Context, req dto.GetAuditLogRequest) (dto.AuditLogResponse, error)
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+type AuthService interface {
+	// Login checks the credentials and returns a new access and refresh
+	// token. It fails with utils.ErrInvalidCredentials.
+	Login(ctx context.Context, req dto.LoginRequest) (dto.TokenResponse, error)
+	// Refresh trades a refresh token for a new pair of tokens.
+	Refresh(ctx context.Context, req dto.RefreshTokenRequest) (dto.TokenResponse, error)
+	// Authenticate returns the active user an access token was issued to.
+	Authenticate(ctx context.Context, accessToken string) (domain.User, error)
+	// BootstrapUser creates the first user, an admin of the default
+	// organization, when there are none yet, so a fresh installation can be
+	// signed in to.
+	BootstrapUser(ctx context.Context, name, email, password string) error
+}
@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type CreditNoteService interface {
+	GetAllCreditNotes(ctx context.Context, filter dto.GetCreditNoteFilterRequest) (dto.CreditNoteListResponse, error)
+	GetCreditNoteByID(ctx context.Context, id uint) (dto.CreditNoteDetailResponse, error)
+	CreateCreditNote(ctx context.Context, req dto.CreateCreditNoteRequest) (dto.CreditNoteDetailResponse, error)
+	UpdateCreditNote(ctx context.Context, id uint, req dto.UpdateCreditNoteRequest) error
+	IssueCreditNote(ctx context.Context, id uint) error
+	DeleteCreditNote(ctx context.Context, id uint) error
+	RenderCreditNotePDF(ctx context.Context, id uint) (dto.DocumentResponse, error)
+}
@@ -0,0 +1,8 @@
+package services
+
+import "invoice-system/internal/domain"
+
+// CreditNoteRenderer turns a credit note into a printable document.
+type CreditNoteRenderer interface {
+	RenderCreditNote(creditNote domain.CreditNote) ([]byte, error)
+}
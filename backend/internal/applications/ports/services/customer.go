@@ -1,8 +1,15 @@
 package services
 
-import "invoice-system/internal/applications/dto"
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
 
 type CustomerService interface {
-	Create(req dto.CreateCustomerRequest) error
-	FindCustomers() ([]dto.CustomerResponse, error)
+	Create(ctx context.Context, req dto.CreateCustomerRequest) error
+	FindCustomers(ctx context.Context, filter dto.GetCustomerFilterRequest) (dto.CustomerListResponse, error)
+	GetCustomerByID(ctx context.Context, id uint) (dto.CustomerResponse, error)
+	Update(ctx context.Context, req dto.UpdateCustomerRequest) error
+	Delete(ctx context.Context, id uint) error
+	Restore(ctx context.Context, id uint) error
 }
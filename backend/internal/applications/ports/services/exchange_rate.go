@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"io"
+	"time"
+)
+
+type ExchangeRateService interface {
+	BaseCurrency(ctx context.Context) string
+	GetRates(ctx context.Context, currency string) ([]dto.ExchangeRateResponse, error)
+	CreateRate(ctx context.Context, req dto.ExchangeRateRequest) error
+	ImportRates(ctx context.Context, r io.Reader) (dto.ExchangeRateImportResponse, error)
+	Convert(ctx context.Context, amount domain.Money, currency string, date time.Time) (domain.Money, error)
+}
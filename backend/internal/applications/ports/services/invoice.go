@@ -1,12 +1,26 @@
 package services
 
 import (
+	"context"
 	"invoice-system/internal/applications/dto"
+	"time"
 )
 
 type InvoiceService interface {
-	GetAllInvoices(filters dto.GetInvoiceFilterRequest) (dto.InvoiceListResponse, error)
-	CreateInvoice(req dto.CreateInvoiceRequest) error
-	GetInvoiceByID(id uint) (dto.InvoiceDetailResponse, error)
-	UpdateInvoice(id uint, req dto.UpdateInvoiceRequest) error
+	GetAllInvoices(ctx context.Context, filters dto.GetInvoiceFilterRequest) (dto.InvoiceListResponse, error)
+	CreateInvoice(ctx context.Context, req dto.CreateInvoiceRequest) (dto.InvoiceDetailResponse, error)
+	GetInvoiceByID(ctx context.Context, id uint) (dto.InvoiceDetailResponse, error)
+	UpdateInvoice(ctx context.Context, id uint, req dto.UpdateInvoiceRequest) error
+	UpdateInvoiceStatus(ctx context.Context, id uint, req dto.UpdateInvoiceStatusRequest) error
+	// DuplicateInvoice copies the customer, subject and lines of an invoice
+	// into a new draft.
+	DuplicateInvoice(ctx context.Context, id uint, req dto.DuplicateInvoiceRequest) (dto.InvoiceDetailResponse, error)
+	// VoidInvoice cancels an issued invoice while keeping it on record.
+	VoidInvoice(ctx context.Context, id uint) error
+	// DeleteInvoice removes a draft for good and soft-deletes any other
+	// invoice.
+	DeleteInvoice(ctx context.Context, id uint) error
+	// MarkOverdueInvoices flags the unpaid invoices that were due before the
+	// day of now as overdue and returns how many were flagged.
+	MarkOverdueInvoices(ctx context.Context, now time.Time) (int64, error)
 }
@@ -0,0 +1,10 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type InvoicePDFService interface {
+	RenderInvoicePDF(ctx context.Context, id uint) (dto.DocumentResponse, error)
+}
@@ -0,0 +1,8 @@
+package services
+
+import "invoice-system/internal/domain"
+
+// InvoiceRenderer turns an invoice into a printable document.
+type InvoiceRenderer interface {
+	RenderInvoice(invoice domain.Invoice) ([]byte, error)
+}
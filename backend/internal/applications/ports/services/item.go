@@ -1,11 +1,19 @@
 package services
 
 import (
+	"context"
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/domain"
+	"time"
 )
 
 type ItemService interface {
-	GetAllItems(nameOrType string, limit uint) ([]domain.Item, error)
-	AddItem(item dto.DTOAddItemRequest) error
+	GetAllItems(ctx context.Context, nameOrType string, limit uint) ([]domain.Item, error)
+	GetItemByID(ctx context.Context, id uint) (domain.Item, error)
+	AddItem(ctx context.Context, item dto.DTOAddItemRequest) error
+	UpdateItem(ctx context.Context, req dto.DTOUpdateItemRequest) error
+	// ResolvePrice returns the price of an item for a customer on a date.
+	ResolvePrice(ctx context.Context, itemID, customerID uint, date time.Time) (domain.ItemPrice, error)
+	// ResolvePrices is ResolvePrice for several items at once, keyed by item id.
+	ResolvePrices(ctx context.Context, itemIDs []uint, customerID uint, date time.Time) (map[uint]domain.ItemPrice, error)
 }
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type PaymentService interface {
+	GetInvoicePayments(ctx context.Context, invoiceID uint) (dto.InvoicePaymentsResponse, error)
+	CreatePayment(ctx context.Context, invoiceID uint, req dto.CreatePaymentRequest) (dto.PaymentResponse, error)
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type PriceListService interface {
+	GetAllPriceLists(ctx context.Context) ([]dto.PriceListResponse, error)
+	GetPriceListByID(ctx context.Context, id uint) (dto.PriceListResponse, error)
+	CreatePriceList(ctx context.Context, req dto.PriceListRequest) (dto.PriceListResponse, error)
+	UpdatePriceList(ctx context.Context, id uint, req dto.PriceListRequest) error
+	SetItemPrice(ctx context.Context, priceListID uint, req dto.PriceListItemRequest) error
+	RemoveItemPrice(ctx context.Context, priceListID, itemID uint) error
+}
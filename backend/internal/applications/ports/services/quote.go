@@ -0,0 +1,17 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type QuoteService interface {
+	GetAllQuotes(ctx context.Context, filter dto.GetQuoteFilterRequest) (dto.QuoteListResponse, error)
+	GetQuoteByID(ctx context.Context, id uint) (dto.QuoteDetailResponse, error)
+	CreateQuote(ctx context.Context, req dto.QuoteRequest) (dto.QuoteDetailResponse, error)
+	UpdateQuote(ctx context.Context, id uint, req dto.QuoteRequest) error
+	UpdateQuoteStatus(ctx context.Context, id uint, req dto.UpdateQuoteStatusRequest) error
+	// ConvertQuote creates an invoice from the quote's lines and links the
+	// two together.
+	ConvertQuote(ctx context.Context, id uint, req dto.ConvertQuoteRequest) (dto.InvoiceDetailResponse, error)
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"time"
+)
+
+type RecurringInvoiceService interface {
+	GetAllRecurringInvoices(ctx context.Context, filter dto.GetRecurringInvoiceFilterRequest) (dto.RecurringInvoiceListResponse, error)
+	GetRecurringInvoiceByID(ctx context.Context, id uint) (dto.RecurringInvoiceDetailResponse, error)
+	CreateRecurringInvoice(ctx context.Context, req dto.CreateRecurringInvoiceRequest) (dto.RecurringInvoiceDetailResponse, error)
+	UpdateRecurringInvoice(ctx context.Context, id uint, req dto.UpdateRecurringInvoiceRequest) error
+	UpdateRecurringInvoiceStatus(ctx context.Context, id uint, req dto.UpdateRecurringInvoiceStatusRequest) error
+	DeleteRecurringInvoice(ctx context.Context, id uint) error
+	// GenerateDueInvoices creates the invoices of every run due on or before
+	// date, catching up on runs missed while nothing was generating them. It
+	// returns how many invoices were created.
+	GenerateDueInvoices(ctx context.Context, date time.Time) (int, error)
+}
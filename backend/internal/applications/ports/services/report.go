@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type ReportService interface {
+	GetInvoiceTotals(ctx context.Context, req dto.ReportFilterRequest) (dto.InvoiceTotalsResponse, error)
+	GetAgingReport(ctx context.Context, req dto.AgingReportRequest) (dto.AgingReportResponse, error)
+	// ExportAgingReportCSV renders the aging report as a CSV file with one
+	// line per customer and currency, followed by the totals per currency.
+	ExportAgingReportCSV(ctx context.Context, req dto.AgingReportRequest) (dto.DocumentResponse, error)
+	GetRevenueReport(ctx context.Context, req dto.PeriodReportRequest) (dto.RevenueReportResponse, error)
+	GetTaxReport(ctx context.Context, req dto.PeriodReportRequest) (dto.TaxReportResponse, error)
+	GetTopCustomers(ctx context.Context, req dto.TopSalesRequest) (dto.TopCustomersResponse, error)
+	GetTopItems(ctx context.Context, req dto.TopSalesRequest) (dto.TopItemsResponse, error)
+}
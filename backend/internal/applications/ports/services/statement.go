@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type StatementService interface {
+	GetCustomerStatement(ctx context.Context, customerID uint, req dto.StatementRequest) (dto.StatementResponse, error)
+	ExportStatementCSV(ctx context.Context, customerID uint, req dto.StatementRequest) (dto.DocumentResponse, error)
+	RenderStatementPDF(ctx context.Context, customerID uint, req dto.StatementRequest) (dto.DocumentResponse, error)
+}
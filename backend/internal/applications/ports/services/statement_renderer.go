@@ -0,0 +1,8 @@
+package services
+
+import "invoice-system/internal/domain"
+
+// StatementRenderer turns a customer statement into a printable document.
+type StatementRenderer interface {
+	RenderStatement(statement domain.Statement) ([]byte, error)
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+)
+
+type TaxService interface {
+	GetAllTaxRates(ctx context.Context) ([]dto.TaxRateResponse, error)
+	GetTaxRateByID(ctx context.Context, id uint) (dto.TaxRateResponse, error)
+	CreateTaxRate(ctx context.Context, req dto.TaxRateRequest) (dto.TaxRateResponse, error)
+	UpdateTaxRate(ctx context.Context, id uint, req dto.TaxRateRequest) error
+	ApplyTaxes(ctx context.Context, customerID uint, items []domain.InvoiceItem) error
+}
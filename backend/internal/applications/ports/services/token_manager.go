@@ -0,0 +1,17 @@
+package services
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+// TokenManager issues and checks the signed tokens users authenticate with.
+type TokenManager interface {
+	// IssueToken returns a token of tokenType for the user and when it
+	// expires.
+	IssueToken(user domain.User, tokenType string) (string, time.Time, error)
+	// ParseToken checks the signature, expiry and type of token and returns
+	// the ID of the user it was issued to. It fails with
+	// utils.ErrInvalidToken for any token that doesn't pass.
+	ParseToken(token, tokenType string) (uint, error)
+}
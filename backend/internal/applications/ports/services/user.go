@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+)
+
+type UserService interface {
+	GetAllUsers(ctx context.Context) ([]dto.UserResponse, error)
+	CreateUser(ctx context.Context, req dto.CreateUserRequest) (dto.UserResponse, error)
+	// UpdateUser changes the user. It fails with utils.ErrLastAdmin rather
+	// than leave nobody able to manage users.
+	UpdateUser(ctx context.Context, id uint, req dto.UpdateUserRequest) (dto.UserResponse, error)
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+)
+
+type auditService struct {
+	repo repository.AuditRepository
+}
+
+func NewAuditService(repo repository.AuditRepository) services.AuditService {
+	return &auditService{repo: repo}
+}
+
+// GetAuditLog implements services.AuditService. IDs are only unique per kind
+// of record, so filtering by one needs the entity as well.
+func (s *auditService) GetAuditLog(ctx context.Context, req dto.GetAuditLogRequest) (dto.AuditLogResponse, error) {
+	if req.Entity != "" && !domain.IsValidAuditEntity(req.Entity) {
+		return dto.AuditLogResponse{}, utils.ErrInvalidAuditEntity
+	}
+	if req.ID != nil && req.Entity == "" {
+		return dto.AuditLogResponse{}, utils.ErrAuditEntityRequired
+	}
+
+	entries, pagination, err := s.repo.GetAuditEntries(ctx, mapper.ToDomainAuditFilter(req))
+	if err != nil {
+		return dto.AuditLogResponse{}, err
+	}
+
+	return mapper.ToAuditLogResponse(entries, pagination), nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAuditRepository adalah mock untuk AuditRepository
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) GetAuditEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, domain.Pagination, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.AuditEntry), args.Get(1).(domain.Pagination), args.Error(2)
+}
+
+func TestGetAuditLog(t *testing.T) {
+	id := uint(3)
+	actor := uint(1)
+
+	repo := new(MockAuditRepository)
+	repo.On("GetAuditEntries", domain.AuditFilter{EntityType: domain.AuditEntityInvoice, EntityID: &id, Limit: 10, Page: 1}).Return([]domain.AuditEntry{
+		{
+			ID:         5,
+			EntityType: domain.AuditEntityInvoice,
+			EntityID:   id,
+			Action:     domain.AuditActionUpdate,
+			ActorID:    &actor,
+			ActorEmail: "admin@example.com",
+			Changes:    map[string]domain.AuditChange{"status": {Before: "draft", After: "issued"}},
+		},
+	}, domain.NewPagination(1, 1, 10), nil)
+
+	svc := NewAuditService(repo)
+	resp, err := svc.GetAuditLog(context.Background(), dto.GetAuditLogRequest{Entity: domain.AuditEntityInvoice, ID: &id, Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Entries, 1) {
+		assert.Equal(t, domain.AuditEntityInvoice, resp.Entries[0].Entity)
+		assert.Equal(t, "admin@example.com", resp.Entries[0].ActorEmail)
+		assert.Equal(t, "issued", resp.Entries[0].Changes["status"].After)
+	}
+	assert.Equal(t, int64(1), resp.Pagination.TotalItems)
+	repo.AssertExpectations(t)
+}
+
+func TestGetAuditLog_InvalidFilter(t *testing.T) {
+	id := uint(3)
+	svc := NewAuditService(new(MockAuditRepository))
+
+	_, err := svc.GetAuditLog(context.Background(), dto.GetAuditLogRequest{Entity: "payment"})
+	assert.Equal(t, utils.ErrInvalidAuditEntity, err)
+
+	// ids repeat across kinds of records
+	_, err = svc.GetAuditLog(context.Background(), dto.GetAuditLogRequest{ID: &id})
+	assert.Equal(t, utils.ErrAuditEntityRequired, err)
+}
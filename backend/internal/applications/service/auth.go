@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// unknownUserHash is compared against when nobody has the email a login was
+// attempted with, so that case takes as long as a wrong password and doesn't
+// give away which emails exist.
+var unknownUserHash = []byte("$2a$10$/izZ5F4.7uScemq.eeD1UOciQFA5hK.vrNnM0OfSYD5jfJtgQSYHG")
+
+type authService struct {
+	users  repository.UserRepository
+	tokens services.TokenManager
+}
+
+func NewAuthService(users repository.UserRepository, tokens services.TokenManager) services.AuthService {
+	return &authService{users: users, tokens: tokens}
+}
+
+// Login implements services.AuthService. Unknown emails, wrong passwords and
+// deactivated users all fail the same way. Emails are unique per
+// organization, so a login naming no organization is for the default one.
+func (s *authService) Login(ctx context.Context, req dto.LoginRequest) (dto.TokenResponse, error) {
+	if _, ok := domain.TenantFromContext(ctx); !ok {
+		ctx = domain.ContextWithTenant(ctx, domain.DefaultOrganizationID)
+	}
+
+	user, err := s.users.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, utils.ErrUserNotFound) {
+			_ = bcrypt.CompareHashAndPassword(unknownUserHash, []byte(req.Password))
+			return dto.TokenResponse{}, utils.ErrInvalidCredentials
+		}
+		return dto.TokenResponse{}, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		return dto.TokenResponse{}, utils.ErrInvalidCredentials
+	}
+
+	if !user.IsActive {
+		return dto.TokenResponse{}, utils.ErrInvalidCredentials
+	}
+
+	return s.issueTokens(user)
+}
+
+// Refresh implements services.AuthService. The user is loaded again, so a
+// user deactivated since logging in can't refresh.
+func (s *authService) Refresh(ctx context.Context, req dto.RefreshTokenRequest) (dto.TokenResponse, error) {
+	user, err := s.userOfToken(ctx, req.RefreshToken, domain.TokenTypeRefresh)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	return s.issueTokens(user)
+}
+
+// Authenticate implements services.AuthService.
+func (s *authService) Authenticate(ctx context.Context, accessToken string) (domain.User, error) {
+	return s.userOfToken(ctx, accessToken, domain.TokenTypeAccess)
+}
+
+// BootstrapUser implements services.AuthService. The first user is an
+// admin, who can then add everyone else.
+func (s *authService) BootstrapUser(ctx context.Context, name, email, password string) error {
+	count, err := s.users.CountUsers(ctx)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	email = domain.NormalizeEmail(email)
+	if email == "" {
+		return fmt.Errorf("bootstrap user needs an email")
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = email
+	}
+
+	return s.users.CreateUser(ctx, &domain.User{
+		TenantID:     domain.DefaultOrganizationID,
+		Name:         name,
+		Email:        email,
+		PasswordHash: hash,
+		Role:         domain.RoleAdmin,
+		IsActive:     true,
+	})
+}
+
+// userOfToken returns the active user a token of tokenType was issued to.
+func (s *authService) userOfToken(ctx context.Context, token, tokenType string) (domain.User, error) {
+	userID, err := s.tokens.ParseToken(token, tokenType)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	user, err := s.users.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, utils.ErrUserNotFound) {
+			return domain.User{}, utils.ErrInvalidToken
+		}
+		return domain.User{}, err
+	}
+
+	if !user.IsActive {
+		return domain.User{}, utils.ErrInvalidToken
+	}
+
+	return user, nil
+}
+
+func (s *authService) issueTokens(user domain.User) (dto.TokenResponse, error) {
+	access, accessExpiresAt, err := s.tokens.IssueToken(user, domain.TokenTypeAccess)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	refresh, refreshExpiresAt, err := s.tokens.IssueToken(user, domain.TokenTypeRefresh)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	return dto.TokenResponse{
+		AccessToken:      access,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refresh,
+		RefreshExpiresAt: refreshExpiresAt,
+		TokenType:        "Bearer",
+		User:             mapper.ToUserResponse(user),
+	}, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MockUserRepository adalah mock untuk UserRepository
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) CreateUser(ctx context.Context, user *domain.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetUserByID(ctx context.Context, id uint) (domain.User, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetUserByEmail(ctx context.Context, email string) (domain.User, error) {
+	args := m.Called(email)
+	return args.Get(0).(domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetAllUsers(ctx context.Context) ([]domain.User, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.User), args.Error(1)
+}
+
+func (m *MockUserRepository) UpdateUser(ctx context.Context, user domain.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) CountUsers(ctx context.Context) (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepository) CountActiveAdmins(ctx context.Context) (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockTokenManager adalah mock untuk TokenManager
+type MockTokenManager struct {
+	mock.Mock
+}
+
+func (m *MockTokenManager) IssueToken(user domain.User, tokenType string) (string, time.Time, error) {
+	args := m.Called(user, tokenType)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+func (m *MockTokenManager) ParseToken(token, tokenType string) (uint, error) {
+	args := m.Called(token, tokenType)
+	return args.Get(0).(uint), args.Error(1)
+}
+
+// newIssuingTokenManager hands out "access" and "refresh" tokens to anyone.
+func newIssuingTokenManager() *MockTokenManager {
+	m := &MockTokenManager{}
+	m.On("IssueToken", mock.Anything, domain.TokenTypeAccess).Return("access", time.Now().Add(time.Minute), nil).Maybe()
+	m.On("IssueToken", mock.Anything, domain.TokenTypeRefresh).Return("refresh", time.Now().Add(time.Hour), nil).Maybe()
+	return m
+}
+
+func mustHashPassword(t *testing.T, password string) string {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	return string(hash)
+}
+
+func TestAuthService_Login(t *testing.T) {
+	active := domain.User{ID: 1, Name: "Admin", Email: "admin@example.com", PasswordHash: mustHashPassword(t, "s3cret-pass"), IsActive: true}
+	inactive := active
+	inactive.IsActive = false
+
+	tests := []struct {
+		name        string
+		password    string
+		setupMock   func(*MockUserRepository)
+		expectError error
+	}{
+		{
+			name:     "valid credentials",
+			password: "s3cret-pass",
+			setupMock: func(m *MockUserRepository) {
+				m.On("GetUserByEmail", "admin@example.com").Return(active, nil)
+			},
+		},
+		{
+			name:     "wrong password",
+			password: "guess",
+			setupMock: func(m *MockUserRepository) {
+				m.On("GetUserByEmail", "admin@example.com").Return(active, nil)
+			},
+			expectError: utils.ErrInvalidCredentials,
+		},
+		{
+			name:     "unknown email",
+			password: "s3cret-pass",
+			setupMock: func(m *MockUserRepository) {
+				m.On("GetUserByEmail", "admin@example.com").Return(domain.User{}, utils.ErrUserNotFound)
+			},
+			expectError: utils.ErrInvalidCredentials,
+		},
+		{
+			name:     "deactivated user",
+			password: "s3cret-pass",
+			setupMock: func(m *MockUserRepository) {
+				m.On("GetUserByEmail", "admin@example.com").Return(inactive, nil)
+			},
+			expectError: utils.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &MockUserRepository{}
+			tt.setupMock(users)
+
+			authService := NewAuthService(users, newIssuingTokenManager())
+
+			resp, err := authService.Login(context.Background(), dto.LoginRequest{Email: "admin@example.com", Password: tt.password})
+
+			assert.Equal(t, tt.expectError, err)
+			if tt.expectError == nil {
+				assert.Equal(t, "access", resp.AccessToken)
+				assert.Equal(t, "refresh", resp.RefreshToken)
+				assert.Equal(t, "Bearer", resp.TokenType)
+				assert.Equal(t, uint(1), resp.User.ID)
+			}
+			users.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	users := &MockUserRepository{}
+	users.On("GetUserByID", uint(1)).Return(domain.User{ID: 1, IsActive: true}, nil)
+	users.On("GetUserByID", uint(2)).Return(domain.User{ID: 2, IsActive: false}, nil)
+
+	tokens := newIssuingTokenManager()
+	tokens.On("ParseToken", "refresh-1", domain.TokenTypeRefresh).Return(uint(1), nil)
+	tokens.On("ParseToken", "refresh-2", domain.TokenTypeRefresh).Return(uint(2), nil)
+	tokens.On("ParseToken", "access-1", domain.TokenTypeRefresh).Return(uint(0), utils.ErrInvalidToken)
+
+	authService := NewAuthService(users, tokens)
+
+	resp, err := authService.Refresh(context.Background(), dto.RefreshTokenRequest{RefreshToken: "refresh-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "access", resp.AccessToken)
+
+	_, err = authService.Refresh(context.Background(), dto.RefreshTokenRequest{RefreshToken: "refresh-2"})
+	assert.Equal(t, utils.ErrInvalidToken, err, "deactivated since login")
+
+	_, err = authService.Refresh(context.Background(), dto.RefreshTokenRequest{RefreshToken: "access-1"})
+	assert.Equal(t, utils.ErrInvalidToken, err)
+}
+
+func TestAuthService_Authenticate(t *testing.T) {
+	users := &MockUserRepository{}
+	users.On("GetUserByID", uint(1)).Return(domain.User{ID: 1, IsActive: true}, nil)
+	users.On("GetUserByID", uint(3)).Return(domain.User{}, utils.ErrUserNotFound)
+
+	tokens := &MockTokenManager{}
+	tokens.On("ParseToken", "access-1", domain.TokenTypeAccess).Return(uint(1), nil)
+	tokens.On("ParseToken", "access-3", domain.TokenTypeAccess).Return(uint(3), nil)
+
+	authService := NewAuthService(users, tokens)
+
+	user, err := authService.Authenticate(context.Background(), "access-1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), user.ID)
+
+	_, err = authService.Authenticate(context.Background(), "access-3")
+	assert.Equal(t, utils.ErrInvalidToken, err, "user removed since login")
+}
+
+func TestAuthService_BootstrapUser(t *testing.T) {
+	t.Run("creates the first user", func(t *testing.T) {
+		users := &MockUserRepository{}
+		users.On("CountUsers").Return(int64(0), nil)
+		users.On("CreateUser", mock.MatchedBy(func(u *domain.User) bool {
+			return u.Email == "admin@example.com" && u.IsActive && u.Role == domain.RoleAdmin &&
+				bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte("s3cret-pass")) == nil
+		})).Return(nil)
+
+		err := NewAuthService(users, &MockTokenManager{}).BootstrapUser(context.Background(), "Admin", " Admin@Example.com", "s3cret-pass")
+
+		assert.NoError(t, err)
+		users.AssertExpectations(t)
+	})
+
+	t.Run("leaves existing users alone", func(t *testing.T) {
+		users := &MockUserRepository{}
+		users.On("CountUsers").Return(int64(2), nil)
+
+		err := NewAuthService(users, &MockTokenManager{}).BootstrapUser(context.Background(), "Admin", "admin@example.com", "s3cret-pass")
+
+		assert.NoError(t, err)
+		users.AssertNotCalled(t, "CreateUser", mock.Anything)
+	})
+
+	t.Run("short password", func(t *testing.T) {
+		users := &MockUserRepository{}
+		users.On("CountUsers").Return(int64(0), nil)
+
+		err := NewAuthService(users, &MockTokenManager{}).BootstrapUser(context.Background(), "Admin", "admin@example.com", "short")
+
+		assert.Error(t, err)
+		users.AssertNotCalled(t, "CreateUser", mock.Anything)
+	})
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"time"
+)
+
+type creditNoteService struct {
+	repo     repository.CreditNoteRepository
+	invoices repository.InvoiceRepository
+	items    services.ItemService
+	tax      services.TaxService
+	renderer services.CreditNoteRenderer
+}
+
+func NewCreditNoteService(repo repository.CreditNoteRepository, invoices repository.InvoiceRepository, items services.ItemService, tax services.TaxService, renderer services.CreditNoteRenderer) services.CreditNoteService {
+	return &creditNoteService{repo: repo, invoices: invoices, items: items, tax: tax, renderer: renderer}
+}
+
+// GetAllCreditNotes implements services.CreditNoteService.
+func (s *creditNoteService) GetAllCreditNotes(ctx context.Context, filter dto.GetCreditNoteFilterRequest) (dto.CreditNoteListResponse, error) {
+	creditNotes, pagination, err := s.repo.GetAllCreditNotes(ctx, mapper.ToDomainCreditNoteFilter(filter))
+	if err != nil {
+		return dto.CreditNoteListResponse{}, err
+	}
+
+	return mapper.ToCreditNoteListResponse(creditNotes, pagination), nil
+}
+
+// GetCreditNoteByID implements services.CreditNoteService.
+func (s *creditNoteService) GetCreditNoteByID(ctx context.Context, id uint) (dto.CreditNoteDetailResponse, error) {
+	creditNote, err := s.repo.GetCreditNoteByID(ctx, id)
+	if err != nil {
+		return dto.CreditNoteDetailResponse{}, err
+	}
+
+	return mapper.ToCreditNoteDetailResponse(creditNote), nil
+}
+
+// CreateCreditNote implements services.CreditNoteService. The customer and
+// currency always come from the invoice being credited.
+func (s *creditNoteService) CreateCreditNote(ctx context.Context, req dto.CreateCreditNoteRequest) (dto.CreditNoteDetailResponse, error) {
+	status := req.Status
+	if status == "" {
+		status = domain.CreditNoteStatusDraft
+	}
+	if !domain.IsValidCreditNoteStatus(status) {
+		return dto.CreditNoteDetailResponse{}, utils.ErrInvalidCreditNoteStatus
+	}
+
+	invoice, err := s.creditableInvoice(ctx, req.InvoiceID)
+	if err != nil {
+		return dto.CreditNoteDetailResponse{}, err
+	}
+
+	issueDate := req.IssueDate
+	if issueDate.IsZero() {
+		issueDate = utils.DateOnly(time.Now())
+	}
+
+	items, err := s.buildCreditNoteItems(ctx, invoice, 0, issueDate, req.Items)
+	if err != nil {
+		return dto.CreditNoteDetailResponse{}, err
+	}
+
+	creditNote := domain.CreditNote{
+		InvoiceID:  invoice.ID,
+		CustomerID: invoice.CustomerID,
+		IssueDate:  issueDate,
+		Reason:     req.Reason,
+		Currency:   invoice.Currency,
+		Status:     status,
+		Items:      items,
+	}
+	creditNote.CalculateTotals()
+
+	if err := checkCreditAmount(invoice, creditNote.TotalAmount); err != nil {
+		return dto.CreditNoteDetailResponse{}, err
+	}
+
+	if err := s.repo.CreateCreditNote(ctx, &creditNote); err != nil {
+		return dto.CreditNoteDetailResponse{}, err
+	}
+
+	return s.GetCreditNoteByID(ctx, creditNote.ID)
+}
+
+// UpdateCreditNote implements services.CreditNoteService.
+func (s *creditNoteService) UpdateCreditNote(ctx context.Context, id uint, req dto.UpdateCreditNoteRequest) error {
+	existing, err := s.repo.GetCreditNoteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !existing.IsEditable() {
+		return utils.ErrCreditNoteLocked
+	}
+
+	invoice, err := s.creditableInvoice(ctx, existing.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	issueDate := req.IssueDate
+	if issueDate.IsZero() {
+		issueDate = existing.IssueDate
+	}
+
+	items, err := s.buildCreditNoteItems(ctx, invoice, id, issueDate, req.Items)
+	if err != nil {
+		return err
+	}
+
+	creditNote := domain.CreditNote{
+		IssueDate: issueDate,
+		Reason:    req.Reason,
+		Items:     items,
+	}
+	creditNote.CalculateTotals()
+
+	if err := checkCreditAmount(invoice, creditNote.TotalAmount); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateCreditNote(ctx, id, creditNote)
+}
+
+// IssueCreditNote implements services.CreditNoteService. The balance of the
+// invoice is checked again by the repository with the invoice locked.
+func (s *creditNoteService) IssueCreditNote(ctx context.Context, id uint) error {
+	return s.repo.IssueCreditNote(ctx, id)
+}
+
+// DeleteCreditNote implements services.CreditNoteService.
+func (s *creditNoteService) DeleteCreditNote(ctx context.Context, id uint) error {
+	return s.repo.DeleteCreditNote(ctx, id)
+}
+
+// RenderCreditNotePDF implements services.CreditNoteService.
+func (s *creditNoteService) RenderCreditNotePDF(ctx context.Context, id uint) (dto.DocumentResponse, error) {
+	creditNote, err := s.repo.GetCreditNoteByID(ctx, id)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	content, err := s.renderer.RenderCreditNote(creditNote)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	return dto.DocumentResponse{
+		FileName:    documentFileName(creditNote.CreditNoteNumber, "credit-note") + ".pdf",
+		ContentType: "application/pdf",
+		Content:     content,
+	}, nil
+}
+
+func (s *creditNoteService) creditableInvoice(ctx context.Context, id uint) (domain.Invoice, error) {
+	invoice, err := s.invoices.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	if !invoice.IsPayable() {
+		return domain.Invoice{}, domain.ErrInvoiceNotCreditable
+	}
+
+	return invoice, nil
+}
+
+// buildCreditNoteItems turns the requested lines into credit note lines. A
+// line reversing an invoice line defaults to its item, price and tax rate and
+// may not credit more than is left of it after the other credit notes of the
+// invoice; excludeID is the credit note being edited, if any. Such a line
+// reverses the tax the invoice charged for the credited part, even if its
+// rate has been edited since; other lines, and lines given a tax rate, are
+// taxed exactly as invoices are.
+func (s *creditNoteService) buildCreditNoteItems(ctx context.Context, invoice domain.Invoice, excludeID uint, issueDate time.Time, lines []dto.CreditNoteItemRequest) ([]domain.CreditNoteItem, error) {
+	credited, err := s.repo.GetCreditedQuantities(ctx, invoice.ID, excludeID)
+	if err != nil {
+		return nil, err
+	}
+
+	originals := make(map[uint]domain.InvoiceItem, len(invoice.Items))
+	for _, it := range invoice.Items {
+		originals[it.ID] = it
+	}
+
+	var adHoc []uint
+	for _, line := range lines {
+		if line.InvoiceItemID == nil {
+			if line.ItemID == 0 || line.Price == nil {
+				return nil, utils.ErrInvalidCreditNoteLine
+			}
+			adHoc = append(adHoc, line.ItemID)
+		}
+	}
+
+	var catalog map[uint]domain.ItemPrice
+	if len(adHoc) > 0 {
+		catalog, err = s.items.ResolvePrices(ctx, adHoc, invoice.CustomerID, issueDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	invoiceItems := make([]domain.InvoiceItem, len(lines))
+	reversed := make(map[int]domain.InvoiceItem)
+	for idx, line := range lines {
+		var it domain.InvoiceItem
+
+		if line.InvoiceItemID != nil {
+			original, ok := originals[*line.InvoiceItemID]
+			if !ok || (line.ItemID != 0 && line.ItemID != original.ItemID) {
+				return nil, utils.ErrInvalidCreditNoteLine
+			}
+
+			credited[original.ID] += line.Quantity
+			if credited[original.ID] > original.Quantity {
+				return nil, utils.ErrCreditExceedsInvoice
+			}
+
+			it = domain.InvoiceItem{
+				ItemID:    original.ItemID,
+				Unit:      original.Unit,
+				Price:     original.Price,
+				TaxRateID: original.TaxRateID,
+			}
+		} else {
+			it = domain.InvoiceItem{
+				ItemID: line.ItemID,
+				Unit:   catalog[line.ItemID].Item.Unit,
+			}
+		}
+
+		if line.Price != nil {
+			it.Price = *line.Price
+		}
+		if line.TaxRateID != nil {
+			it.TaxRateID = line.TaxRateID
+		} else if line.InvoiceItemID != nil {
+			reversed[idx] = originals[*line.InvoiceItemID]
+		}
+
+		if it.Price.IsNegative() {
+			return nil, domain.ErrInvalidMoney
+		}
+
+		it.Quantity = line.Quantity
+		it.TotalPrice = it.Price.Mul(line.Quantity)
+		invoiceItems[idx] = it
+	}
+
+	var retaxed []domain.InvoiceItem
+	for idx, it := range invoiceItems {
+		if original, ok := reversed[idx]; ok {
+			invoiceItems[idx].TaxCode = original.TaxCode
+			invoiceItems[idx].TaxRate = original.TaxRate
+			invoiceItems[idx].TaxAmount = original.TaxAmount.Prorate(it.TotalPrice, original.TotalPrice, domain.RoundHalfUp)
+			continue
+		}
+		retaxed = append(retaxed, it)
+	}
+
+	if err := s.tax.ApplyTaxes(ctx, invoice.CustomerID, retaxed); err != nil {
+		return nil, err
+	}
+
+	for idx := range invoiceItems {
+		if _, ok := reversed[idx]; !ok {
+			invoiceItems[idx], retaxed = retaxed[0], retaxed[1:]
+		}
+	}
+
+	items := make([]domain.CreditNoteItem, len(lines))
+	for idx, it := range invoiceItems {
+		items[idx] = domain.CreditNoteItem{
+			InvoiceItemID: lines[idx].InvoiceItemID,
+			ItemID:        it.ItemID,
+			Quantity:      it.Quantity,
+			Unit:          it.Unit,
+			Price:         it.Price,
+			TotalPrice:    it.TotalPrice,
+			TaxRateID:     it.TaxRateID,
+			TaxCode:       it.TaxCode,
+			TaxRate:       it.TaxRate,
+			TaxAmount:     it.TaxAmount,
+		}
+	}
+
+	return items, nil
+}
+
+// checkCreditAmount makes sure a credit note total can be applied to the
+// invoice. Drafts are checked too so they don't fail only once issued.
+func checkCreditAmount(invoice domain.Invoice, total domain.Money) error {
+	if total.Cmp(domain.Money{}) <= 0 {
+		return domain.ErrNonPositiveCredit
+	}
+
+	if total.Cmp(invoice.BalanceDue()) > 0 {
+		return domain.ErrOverCredit
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCreditNoteRepository adalah mock untuk CreditNoteRepository
+type MockCreditNoteRepository struct {
+	mock.Mock
+}
+
+func (m *MockCreditNoteRepository) GetAllCreditNotes(ctx context.Context, filter domain.CreditNoteFilter) ([]domain.CreditNote, domain.Pagination, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.CreditNote), args.Get(1).(domain.Pagination), args.Error(2)
+}
+
+func (m *MockCreditNoteRepository) GetCreditNoteByID(ctx context.Context, id uint) (domain.CreditNote, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.CreditNote), args.Error(1)
+}
+
+func (m *MockCreditNoteRepository) CreateCreditNote(ctx context.Context, creditNote *domain.CreditNote) error {
+	args := m.Called(creditNote)
+	return args.Error(0)
+}
+
+func (m *MockCreditNoteRepository) UpdateCreditNote(ctx context.Context, id uint, creditNote domain.CreditNote) error {
+	args := m.Called(id, creditNote)
+	return args.Error(0)
+}
+
+func (m *MockCreditNoteRepository) IssueCreditNote(ctx context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCreditNoteRepository) DeleteCreditNote(ctx context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCreditNoteRepository) GetCreditedQuantities(ctx context.Context, invoiceID, excludeID uint) (map[uint]int, error) {
+	args := m.Called(invoiceID, excludeID)
+	return args.Get(0).(map[uint]int), args.Error(1)
+}
+
+// creditableInvoice is an issued invoice for 4 hours of consulting at 250
+// plus 10% tax.
+func creditableInvoice() domain.Invoice {
+	rateID := standardRate.ID
+	invoice := domain.Invoice{
+		ID:         1,
+		CustomerID: 1,
+		Currency:   "USD",
+		Status:     domain.InvoiceStatusIssued,
+		Items: []domain.InvoiceItem{{
+			ID:         11,
+			ItemID:     1,
+			Quantity:   4,
+			Unit:       "hour",
+			Price:      domain.NewMoney(250),
+			TotalPrice: domain.NewMoney(1000),
+			TaxRateID:  &rateID,
+			TaxCode:    standardRate.Code,
+			TaxRate:    standardRate.Rate,
+			TaxAmount:  domain.NewMoney(100),
+		}},
+	}
+	invoice.CalculateTotals()
+	return invoice
+}
+
+// repricedRateInvoice is creditableInvoice taxed at 11%, the standard rate
+// before it was lowered to 10%.
+func repricedRateInvoice() domain.Invoice {
+	invoice := creditableInvoice()
+	invoice.Items[0].TaxRate = 11
+	invoice.Items[0].TaxAmount = domain.NewMoney(110)
+	invoice.CalculateTotals()
+	return invoice
+}
+
+func TestCreditNoteService_CreateCreditNote(t *testing.T) {
+	invoiceItemID := uint(11)
+	unknownLine := uint(99)
+
+	tests := []struct {
+		name        string
+		invoice     domain.Invoice
+		credited    map[uint]int
+		request     dto.CreateCreditNoteRequest
+		expectError error
+		expectTotal domain.Money
+	}{
+		{
+			name:    "reverses part of an invoice line at its original price and tax",
+			invoice: creditableInvoice(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{InvoiceItemID: &invoiceItemID, Quantity: 1}},
+			},
+			expectTotal: domain.NewMoney(275),
+		},
+		{
+			name:    "reverses the tax charged even after the rate was edited",
+			invoice: repricedRateInvoice(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{InvoiceItemID: &invoiceItemID, Quantity: 1}},
+			},
+			expectTotal: domain.MustParseMoney("277.50"),
+		},
+		{
+			name:    "reverses the charged tax in proportion to a lower price",
+			invoice: repricedRateInvoice(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{InvoiceItemID: &invoiceItemID, Quantity: 1, Price: moneyPtr(domain.NewMoney(200))}},
+			},
+			expectTotal: domain.NewMoney(222),
+		},
+		{
+			name:    "ad hoc line with its own price",
+			invoice: creditableInvoice(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{ItemID: 1, Quantity: 1, Price: moneyPtr(domain.NewMoney(50))}},
+			},
+			expectTotal: domain.NewMoney(55),
+		},
+		{
+			name:     "can't credit more than is left of the line",
+			invoice:  creditableInvoice(),
+			credited: map[uint]int{11: 3},
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{InvoiceItemID: &invoiceItemID, Quantity: 2}},
+			},
+			expectError: utils.ErrCreditExceedsInvoice,
+		},
+		{
+			name:    "line of another invoice",
+			invoice: creditableInvoice(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{InvoiceItemID: &unknownLine, Quantity: 1}},
+			},
+			expectError: utils.ErrInvalidCreditNoteLine,
+		},
+		{
+			name:    "ad hoc line without a price",
+			invoice: creditableInvoice(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{ItemID: 1, Quantity: 1}},
+			},
+			expectError: utils.ErrInvalidCreditNoteLine,
+		},
+		{
+			name: "credit above the balance due",
+			invoice: func() domain.Invoice {
+				inv := creditableInvoice()
+				inv.AmountPaid = domain.NewMoney(1000)
+				return inv
+			}(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{InvoiceItemID: &invoiceItemID, Quantity: 1}},
+			},
+			expectError: domain.ErrOverCredit,
+		},
+		{
+			name: "draft invoice",
+			invoice: func() domain.Invoice {
+				inv := creditableInvoice()
+				inv.Status = domain.InvoiceStatusDraft
+				return inv
+			}(),
+			request: dto.CreateCreditNoteRequest{
+				InvoiceID: 1,
+				Items:     []dto.CreditNoteItemRequest{{InvoiceItemID: &invoiceItemID, Quantity: 1}},
+			},
+			expectError: domain.ErrInvoiceNotCreditable,
+		},
+		{
+			name:        "unknown status",
+			invoice:     creditableInvoice(),
+			request:     dto.CreateCreditNoteRequest{InvoiceID: 1, Status: "void"},
+			expectError: utils.ErrInvalidCreditNoteStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credited := tt.credited
+			if credited == nil {
+				credited = map[uint]int{}
+			}
+
+			invoiceRepo := &MockInvoiceRepo{}
+			invoiceRepo.On("GetInvoiceByID", uint(1)).Return(tt.invoice, nil)
+
+			taxRepo := newDefaultTaxRepo()
+			taxRepo.On("GetTaxRateByID", standardRate.ID).Return(standardRate, nil)
+
+			repo := &MockCreditNoteRepository{}
+			repo.On("GetCreditedQuantities", uint(1), uint(0)).Return(credited, nil)
+			repo.On("CreateCreditNote", mock.AnythingOfType("*domain.CreditNote")).Run(func(args mock.Arguments) {
+				args.Get(0).(*domain.CreditNote).ID = 5
+			}).Return(nil)
+			repo.On("GetCreditNoteByID", uint(5)).Return(domain.CreditNote{ID: 5}, nil)
+
+			s := NewCreditNoteService(repo, invoiceRepo, newCatalogItemService(), NewTaxService(taxRepo), nil)
+
+			resp, err := s.CreateCreditNote(context.Background(), tt.request)
+
+			if tt.expectError != nil {
+				assert.Equal(t, tt.expectError, err)
+				repo.AssertNotCalled(t, "CreateCreditNote", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint(5), resp.ID)
+			repo.AssertCalled(t, "CreateCreditNote", mock.MatchedBy(func(cn *domain.CreditNote) bool {
+				return cn.InvoiceID == 1 &&
+					cn.CustomerID == 1 &&
+					cn.Currency == "USD" &&
+					cn.Status == domain.CreditNoteStatusDraft &&
+					!cn.IssueDate.IsZero() &&
+					cn.TotalAmount == tt.expectTotal &&
+					cn.Items[0].Unit == "hour"
+			}))
+		})
+	}
+}
+
+func TestCreditNoteService_UpdateCreditNote_Locked(t *testing.T) {
+	repo := &MockCreditNoteRepository{}
+	repo.On("GetCreditNoteByID", uint(5)).Return(domain.CreditNote{ID: 5, Status: domain.CreditNoteStatusIssued}, nil)
+
+	s := NewCreditNoteService(repo, &MockInvoiceRepo{}, nil, nil, nil)
+
+	err := s.UpdateCreditNote(context.Background(), 5, dto.UpdateCreditNoteRequest{})
+
+	assert.Equal(t, utils.ErrCreditNoteLocked, err)
+	repo.AssertNotCalled(t, "UpdateCreditNote", mock.Anything, mock.Anything)
+}
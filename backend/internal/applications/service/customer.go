@@ -1,28 +1,42 @@
 package service
 
 import (
+	"context"
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/applications/mapper"
 	"invoice-system/internal/applications/ports/repository"
 	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/logger"
+	"invoice-system/internal/utils"
 
 	"go.uber.org/zap"
 )
 
 type customerService struct {
-	repo repository.CustomerRepository
+	repo         repository.CustomerRepository
+	baseCurrency string
 }
 
-func NewCustomerService(repo repository.CustomerRepository) services.CustomerService {
-	return &customerService{repo: repo}
+func NewCustomerService(repo repository.CustomerRepository, baseCurrency string) services.CustomerService {
+	return &customerService{repo: repo, baseCurrency: baseCurrency}
 }
 
 // Create implements services.CustomerService.
-func (c *customerService) Create(req dto.CreateCustomerRequest) error {
+func (c *customerService) Create(ctx context.Context, req dto.CreateCustomerRequest) error {
 	customer := mapper.ToDomainCustomerCreate(req)
 
-	err := c.repo.CreateCustomer(&customer)
+	if customer.Currency == "" {
+		customer.Currency = c.baseCurrency
+	}
+
+	currency, ok := domain.NormalizeCurrency(customer.Currency)
+	if !ok {
+		return utils.ErrInvalidCurrency
+	}
+	customer.Currency = currency
+
+	err := c.repo.CreateCustomer(ctx, &customer)
 	if err != nil {
 		logger.Error("error create customer", zap.Error(err))
 		return err
@@ -32,11 +46,93 @@ func (c *customerService) Create(req dto.CreateCustomerRequest) error {
 }
 
 // FindCustomers implements services.CustomerService.
-func (c *customerService) FindCustomers() ([]dto.CustomerResponse, error) {
-	customers, err := c.repo.FindCustomers()
+func (c *customerService) FindCustomers(ctx context.Context, filter dto.GetCustomerFilterRequest) (dto.CustomerListResponse, error) {
+	domainFilter := mapper.ToDomainCustomerFilter(filter)
+	if !domain.IsValidCustomerSort(domainFilter.SortBy) {
+		return dto.CustomerListResponse{}, utils.ErrInvalidSortField
+	}
+
+	customers, pagination, err := c.repo.FindCustomers(ctx, domainFilter)
+	if err != nil {
+		return dto.CustomerListResponse{}, err
+	}
+
+	return mapper.ToCustomerListResponse(customers, pagination), nil
+}
+
+// GetCustomerByID implements services.CustomerService.
+func (c *customerService) GetCustomerByID(ctx context.Context, id uint) (dto.CustomerResponse, error) {
+	customer, err := c.repo.GetCustomerByID(ctx, id)
 	if err != nil {
-		return nil, err
+		return dto.CustomerResponse{}, err
+	}
+
+	return mapper.ToCustomerResponse(customer), nil
+}
+
+// Update implements services.CustomerService.
+func (c *customerService) Update(ctx context.Context, req dto.UpdateCustomerRequest) error {
+	customer, err := c.repo.GetCustomerByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	changes := mapper.ToDomainCustomerUpdate(req)
+
+	if changes.Name != "" {
+		customer.Name = changes.Name
+	}
+	if changes.Email != "" {
+		customer.Email = changes.Email
+	}
+	if changes.Phone != "" {
+		customer.Phone = changes.Phone
 	}
+	if changes.Address != "" {
+		customer.Address = changes.Address
+	}
+	if changes.TaxRateID != nil {
+		customer.TaxRateID = changes.TaxRateID
+	}
+	if changes.PriceListID != nil {
+		customer.PriceListID = changes.PriceListID
+	}
+	if changes.Currency != "" {
+		currency, ok := domain.NormalizeCurrency(changes.Currency)
+		if !ok {
+			return utils.ErrInvalidCurrency
+		}
+		customer.Currency = currency
+	}
+
+	if err := c.repo.UpdateCustomer(ctx, customer); err != nil {
+		logger.Error("error update customer", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Delete implements services.CustomerService. Customers who still owe money
+// can't be deleted.
+func (c *customerService) Delete(ctx context.Context, id uint) error {
+	if _, err := c.repo.GetCustomerByID(ctx, id); err != nil {
+		return err
+	}
+
+	outstanding, err := c.repo.CountOutstandingInvoices(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if outstanding > 0 {
+		return utils.ErrCustomerHasUnpaidInvoices
+	}
+
+	return c.repo.DeleteCustomer(ctx, id)
+}
 
-	return mapper.ToCustomerResponseList(customers), nil
+// Restore implements services.CustomerService.
+func (c *customerService) Restore(ctx context.Context, id uint) error {
+	return c.repo.RestoreCustomer(ctx, id)
 }
@@ -1,11 +1,13 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,20 +18,45 @@ type MockCustomerRepository struct {
 	mock.Mock
 }
 
-func (m *MockCustomerRepository) CreateCustomer(customer *domain.Customer) error {
+func (m *MockCustomerRepository) CreateCustomer(ctx context.Context, customer *domain.Customer) error {
 	args := m.Called(customer)
 	return args.Error(0)
 }
 
-func (m *MockCustomerRepository) FindCustomers() ([]domain.Customer, error) {
-	args := m.Called()
-	return args.Get(0).([]domain.Customer), args.Error(1)
+func (m *MockCustomerRepository) FindCustomers(ctx context.Context, filter domain.CustomerFilter) ([]domain.Customer, domain.Pagination, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.Customer), args.Get(1).(domain.Pagination), args.Error(2)
+}
+
+func (m *MockCustomerRepository) GetCustomerByID(ctx context.Context, id uint) (domain.Customer, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Customer), args.Error(1)
+}
+
+func (m *MockCustomerRepository) UpdateCustomer(ctx context.Context, customer domain.Customer) error {
+	args := m.Called(customer)
+	return args.Error(0)
+}
+
+func (m *MockCustomerRepository) DeleteCustomer(ctx context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCustomerRepository) RestoreCustomer(ctx context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCustomerRepository) CountOutstandingInvoices(ctx context.Context, customerID uint) (int64, error) {
+	args := m.Called(customerID)
+	return args.Get(0).(int64), args.Error(1)
 }
 
 func TestNewCustomerService(t *testing.T) {
 	mockRepo := &MockCustomerRepository{}
 
-	customerService := NewCustomerService(mockRepo)
+	customerService := NewCustomerService(mockRepo, "IDR")
 
 	assert.NotNil(t, customerService)
 }
@@ -87,9 +114,9 @@ func TestCustomerService_Create(t *testing.T) {
 			mockRepo := &MockCustomerRepository{}
 			tt.setupMock(mockRepo)
 
-			customerService := NewCustomerService(mockRepo)
+			customerService := NewCustomerService(mockRepo, "IDR")
 
-			err := customerService.Create(tt.request)
+			err := customerService.Create(context.Background(), tt.request)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -116,7 +143,7 @@ func TestCustomerService_FindCustomers(t *testing.T) {
 					{ID: 1, Name: "John Doe", Email: "john@example.com", Phone: "123456789", Address: "123 Main St"},
 					{ID: 2, Name: "Jane Smith", Email: "jane@example.com", Phone: "987654321", Address: "456 Oak Ave"},
 				}
-				m.On("FindCustomers").Return(customers, nil)
+				m.On("FindCustomers", mock.AnythingOfType("domain.CustomerFilter")).Return(customers, domain.Pagination{TotalItems: 2, TotalPages: 1, CurrentPage: 1, Limit: 10}, nil)
 			},
 			expectError: false,
 			expectedLen: 2,
@@ -124,7 +151,7 @@ func TestCustomerService_FindCustomers(t *testing.T) {
 		{
 			name: "empty result set",
 			setupMock: func(m *MockCustomerRepository) {
-				m.On("FindCustomers").Return([]domain.Customer{}, nil)
+				m.On("FindCustomers", mock.AnythingOfType("domain.CustomerFilter")).Return([]domain.Customer{}, domain.Pagination{CurrentPage: 1, Limit: 10}, nil)
 			},
 			expectError: false,
 			expectedLen: 0,
@@ -132,7 +159,7 @@ func TestCustomerService_FindCustomers(t *testing.T) {
 		{
 			name: "repository error during retrieval",
 			setupMock: func(m *MockCustomerRepository) {
-				m.On("FindCustomers").Return([]domain.Customer{}, errors.New("database connection error"))
+				m.On("FindCustomers", mock.AnythingOfType("domain.CustomerFilter")).Return([]domain.Customer{}, domain.Pagination{}, errors.New("database connection error"))
 			},
 			expectError: true,
 		},
@@ -143,19 +170,159 @@ func TestCustomerService_FindCustomers(t *testing.T) {
 			mockRepo := &MockCustomerRepository{}
 			tt.setupMock(mockRepo)
 
-			customerService := NewCustomerService(mockRepo)
+			customerService := NewCustomerService(mockRepo, "IDR")
 
-			customers, err := customerService.FindCustomers()
+			result, err := customerService.FindCustomers(context.Background(), dto.GetCustomerFilterRequest{})
 
 			if tt.expectError {
 				assert.Error(t, err)
-				assert.Nil(t, customers)
+				assert.Empty(t, result.Customers)
 			} else {
 				assert.NoError(t, err)
-				assert.Len(t, customers, tt.expectedLen)
+				assert.Len(t, result.Customers, tt.expectedLen)
 			}
 
 			mockRepo.AssertExpectations(t)
 		})
 	}
 }
+
+func TestCustomerService_FindCustomersFilter(t *testing.T) {
+	mockRepo := &MockCustomerRepository{}
+	mockRepo.On("FindCustomers", domain.CustomerFilter{
+		Search:   "john",
+		SortBy:   domain.CustomerSortOutstandingBalance,
+		SortDesc: true,
+		Page:     2,
+		Limit:    5,
+	}).Return([]domain.Customer{{ID: 1, Stats: &domain.CustomerStats{InvoiceCount: 3, OutstandingBalance: domain.NewMoney(50)}}}, domain.NewPagination(6, 2, 5), nil)
+
+	customerService := NewCustomerService(mockRepo, "IDR")
+
+	result, err := customerService.FindCustomers(context.Background(), dto.GetCustomerFilterRequest{
+		Search: " john ",
+		Sort:   "outstanding_balance",
+		Order:  "desc",
+		Page:   2,
+		Limit:  5,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), result.Customers[0].Stats.InvoiceCount)
+	assert.Equal(t, 2, result.Pagination.TotalPages)
+	assert.NotNil(t, result.Pagination.PrevPage)
+	assert.Nil(t, result.Pagination.NextPage)
+
+	_, err = customerService.FindCustomers(context.Background(), dto.GetCustomerFilterRequest{Sort: "password"})
+	assert.Equal(t, utils.ErrInvalidSortField, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCustomerService_Update(t *testing.T) {
+	existing := domain.Customer{
+		ID:       1,
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Phone:    "123456789",
+		Address:  "123 Main St",
+		Currency: "IDR",
+	}
+
+	tests := []struct {
+		name        string
+		request     dto.UpdateCustomerRequest
+		setupMock   func(*MockCustomerRepository)
+		expectError error
+	}{
+		{
+			name:    "only given fields change",
+			request: dto.UpdateCustomerRequest{ID: 1, Address: "456 Oak Ave", Currency: "usd"},
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("GetCustomerByID", uint(1)).Return(existing, nil)
+				m.On("UpdateCustomer", mock.MatchedBy(func(c domain.Customer) bool {
+					return c.Name == "John Doe" &&
+						c.Email == "john@example.com" &&
+						c.Address == "456 Oak Ave" &&
+						c.Currency == "USD"
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "invalid currency",
+			request: dto.UpdateCustomerRequest{ID: 1, Currency: "U$D"},
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("GetCustomerByID", uint(1)).Return(existing, nil)
+			},
+			expectError: utils.ErrInvalidCurrency,
+		},
+		{
+			name:    "customer not found",
+			request: dto.UpdateCustomerRequest{ID: 99, Name: "Nobody"},
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("GetCustomerByID", uint(99)).Return(domain.Customer{}, utils.ErrCustomerNotFound)
+			},
+			expectError: utils.ErrCustomerNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockCustomerRepository{}
+			tt.setupMock(mockRepo)
+
+			customerService := NewCustomerService(mockRepo, "IDR")
+
+			err := customerService.Update(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectError, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCustomerService_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func(*MockCustomerRepository)
+		expectError error
+	}{
+		{
+			name: "customer without unpaid invoices",
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("GetCustomerByID", uint(1)).Return(domain.Customer{ID: 1}, nil)
+				m.On("CountOutstandingInvoices", uint(1)).Return(int64(0), nil)
+				m.On("DeleteCustomer", uint(1)).Return(nil)
+			},
+		},
+		{
+			name: "customer with unpaid invoices",
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("GetCustomerByID", uint(1)).Return(domain.Customer{ID: 1}, nil)
+				m.On("CountOutstandingInvoices", uint(1)).Return(int64(2), nil)
+			},
+			expectError: utils.ErrCustomerHasUnpaidInvoices,
+		},
+		{
+			name: "customer not found",
+			setupMock: func(m *MockCustomerRepository) {
+				m.On("GetCustomerByID", uint(1)).Return(domain.Customer{}, utils.ErrCustomerNotFound)
+			},
+			expectError: utils.ErrCustomerNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockCustomerRepository{}
+			tt.setupMock(mockRepo)
+
+			customerService := NewCustomerService(mockRepo, "IDR")
+
+			err := customerService.Delete(context.Background(), 1)
+
+			assert.Equal(t, tt.expectError, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type exchangeRateService struct {
+	repo repository.ExchangeRateRepository
+	base string
+}
+
+func NewExchangeRateService(repo repository.ExchangeRateRepository, baseCurrency string) services.ExchangeRateService {
+	base, _ := domain.NormalizeCurrency(baseCurrency)
+	return &exchangeRateService{repo: repo, base: base}
+}
+
+// BaseCurrency implements services.ExchangeRateService.
+func (s *exchangeRateService) BaseCurrency(ctx context.Context) string {
+	return s.base
+}
+
+// GetRates implements services.ExchangeRateService.
+func (s *exchangeRateService) GetRates(ctx context.Context, currency string) ([]dto.ExchangeRateResponse, error) {
+	if currency != "" {
+		code, ok := domain.NormalizeCurrency(currency)
+		if !ok {
+			return nil, utils.ErrInvalidCurrency
+		}
+		currency = code
+	}
+
+	rates, err := s.repo.GetRates(ctx, s.base, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToExchangeRateResponseList(rates), nil
+}
+
+// CreateRate implements services.ExchangeRateService.
+func (s *exchangeRateService) CreateRate(ctx context.Context, req dto.ExchangeRateRequest) error {
+	currency, ok := domain.NormalizeCurrency(req.Currency)
+	if !ok || currency == s.base {
+		return utils.ErrInvalidCurrency
+	}
+
+	return s.repo.UpsertRates(ctx, []domain.ExchangeRate{{
+		BaseCurrency: s.base,
+		Currency:     currency,
+		Rate:         req.Rate,
+		RateDate:     req.RateDate,
+	}})
+}
+
+// ImportRates reads a CSV of "date,currency,rate" rows, e.g.
+//
+//	date,currency,rate
+//	2025-10-30,USD,16250.50
+//
+// and upserts them against the base currency. The header row is optional.
+// Nothing is stored if any row is invalid.
+func (s *exchangeRateService) ImportRates(ctx context.Context, r io.Reader) (dto.ExchangeRateImportResponse, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	var rates []domain.ExchangeRate
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return dto.ExchangeRateImportResponse{}, fmt.Errorf("%w: %v", utils.ErrInvalidRatesImport, err)
+		}
+
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+
+		rate, err := s.parseRateRecord(record)
+		if err != nil {
+			return dto.ExchangeRateImportResponse{}, fmt.Errorf("%w: line %d: %v", utils.ErrInvalidRatesImport, line, err)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	if err := s.repo.UpsertRates(ctx, rates); err != nil {
+		return dto.ExchangeRateImportResponse{}, err
+	}
+
+	return dto.ExchangeRateImportResponse{Imported: len(rates)}, nil
+}
+
+func (s *exchangeRateService) parseRateRecord(record []string) (domain.ExchangeRate, error) {
+	date, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(record[0]), time.Local)
+	if err != nil {
+		return domain.ExchangeRate{}, fmt.Errorf("invalid date %q", record[0])
+	}
+
+	currency, ok := domain.NormalizeCurrency(record[1])
+	if !ok || currency == s.base {
+		return domain.ExchangeRate{}, fmt.Errorf("invalid currency %q", record[1])
+	}
+
+	rate, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+	if err != nil || rate <= 0 {
+		return domain.ExchangeRate{}, fmt.Errorf("invalid rate %q", record[2])
+	}
+
+	return domain.ExchangeRate{
+		BaseCurrency: s.base,
+		Currency:     currency,
+		Rate:         rate,
+		RateDate:     date,
+	}, nil
+}
+
+// Convert turns an amount in currency into the base currency using the rate
+// in effect on date.
+func (s *exchangeRateService) Convert(ctx context.Context, amount domain.Money, currency string, date time.Time) (domain.Money, error) {
+	if currency == "" || currency == s.base {
+		return amount, nil
+	}
+
+	rate, err := s.repo.GetRateOn(ctx, s.base, currency, date)
+	if err != nil {
+		if errors.Is(err, utils.ErrExchangeRateNotFound) {
+			return domain.Money{}, fmt.Errorf("%w: %s on %s", utils.ErrExchangeRateNotFound, currency, date.Format("2006-01-02"))
+		}
+		return domain.Money{}, err
+	}
+
+	return amount.MulRate(rate.Rate, domain.RoundHalfUp), nil
+}
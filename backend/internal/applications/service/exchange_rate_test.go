@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockExchangeRateRepository adalah mock untuk ExchangeRateRepository
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) GetRates(ctx context.Context, baseCurrency, currency string) ([]domain.ExchangeRate, error) {
+	args := m.Called(baseCurrency, currency)
+	return args.Get(0).([]domain.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) GetRateOn(ctx context.Context, baseCurrency, currency string, date time.Time) (domain.ExchangeRate, error) {
+	args := m.Called(baseCurrency, currency, date)
+	return args.Get(0).(domain.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) UpsertRates(ctx context.Context, rates []domain.ExchangeRate) error {
+	args := m.Called(rates)
+	return args.Error(0)
+}
+
+// MockReportRepository adalah mock untuk ReportRepository
+type MockReportRepository struct {
+	mock.Mock
+}
+
+func (m *MockReportRepository) GetInvoiceTotalsByCurrencyDay(ctx context.Context, filter domain.ReportFilter) ([]domain.CurrencyDayTotal, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.CurrencyDayTotal), args.Error(1)
+}
+
+func (m *MockReportRepository) GetAgingRows(ctx context.Context, filter domain.AgingFilter) ([]domain.AgingRow, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.AgingRow), args.Error(1)
+}
+
+func (m *MockReportRepository) GetRevenueByPeriod(ctx context.Context, filter domain.ReportFilter, period string) ([]domain.RevenueTotal, error) {
+	args := m.Called(filter, period)
+	return args.Get(0).([]domain.RevenueTotal), args.Error(1)
+}
+
+func (m *MockReportRepository) GetTaxByPeriod(ctx context.Context, filter domain.ReportFilter, period string) ([]domain.TaxTotal, error) {
+	args := m.Called(filter, period)
+	return args.Get(0).([]domain.TaxTotal), args.Error(1)
+}
+
+func (m *MockReportRepository) GetTopCustomers(ctx context.Context, filter domain.ReportFilter, limit int) ([]domain.CustomerSales, error) {
+	args := m.Called(filter, limit)
+	return args.Get(0).([]domain.CustomerSales), args.Error(1)
+}
+
+func (m *MockReportRepository) GetTopItems(ctx context.Context, filter domain.ReportFilter, orderBy string, limit int) ([]domain.ItemSales, error) {
+	args := m.Called(filter, orderBy, limit)
+	return args.Get(0).([]domain.ItemSales), args.Error(1)
+}
+
+func TestExchangeRateService_ImportRates(t *testing.T) {
+	tests := []struct {
+		name          string
+		csv           string
+		setupMock     func(*MockExchangeRateRepository)
+		expectError   error
+		expectedCount int
+	}{
+		{
+			name: "rows with header",
+			csv:  "date,currency,rate\n2025-10-30,usd,16250.50\n2025-10-30,EUR,17500\n",
+			setupMock: func(m *MockExchangeRateRepository) {
+				m.On("UpsertRates", mock.MatchedBy(func(rates []domain.ExchangeRate) bool {
+					return len(rates) == 2 && rates[0].Currency == "USD" && rates[0].BaseCurrency == "IDR" && rates[0].Rate == 16250.50
+				})).Return(nil)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "rows without header",
+			csv:  "2025-10-30,USD,16250.50\n",
+			setupMock: func(m *MockExchangeRateRepository) {
+				m.On("UpsertRates", mock.Anything).Return(nil)
+			},
+			expectedCount: 1,
+		},
+		{
+			name:        "invalid rate stores nothing",
+			csv:         "2025-10-30,USD,16250.50\n2025-10-31,USD,abc\n",
+			setupMock:   func(m *MockExchangeRateRepository) {},
+			expectError: utils.ErrInvalidRatesImport,
+		},
+		{
+			name:        "base currency is rejected",
+			csv:         "2025-10-30,IDR,1\n",
+			setupMock:   func(m *MockExchangeRateRepository) {},
+			expectError: utils.ErrInvalidRatesImport,
+		},
+		{
+			name:        "wrong number of columns",
+			csv:         "2025-10-30,USD\n",
+			setupMock:   func(m *MockExchangeRateRepository) {},
+			expectError: utils.ErrInvalidRatesImport,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockExchangeRateRepository{}
+			tt.setupMock(mockRepo)
+
+			s := NewExchangeRateService(mockRepo, "IDR")
+
+			resp, err := s.ImportRates(context.Background(), strings.NewReader(tt.csv))
+
+			if tt.expectError != nil {
+				assert.True(t, errors.Is(err, tt.expectError))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, resp.Imported)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExchangeRateService_Convert(t *testing.T) {
+	date := time.Date(2025, 10, 30, 0, 0, 0, 0, time.Local)
+
+	mockRepo := &MockExchangeRateRepository{}
+	mockRepo.On("GetRateOn", "IDR", "USD", date).Return(domain.ExchangeRate{Rate: 16250.5}, nil)
+	mockRepo.On("GetRateOn", "IDR", "EUR", date).Return(domain.ExchangeRate{}, utils.ErrExchangeRateNotFound)
+
+	s := NewExchangeRateService(mockRepo, "IDR")
+
+	converted, err := s.Convert(context.Background(), domain.MustParseMoney("10.01"), "USD", date)
+	assert.NoError(t, err)
+	// 10.01 * 16250.5 = 162667.505, rounded half up
+	assert.Equal(t, domain.MustParseMoney("162667.51"), converted)
+
+	same, err := s.Convert(context.Background(), domain.NewMoney(500), "IDR", date)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewMoney(500), same)
+
+	_, err = s.Convert(context.Background(), domain.NewMoney(1), "EUR", date)
+	assert.True(t, errors.Is(err, utils.ErrExchangeRateNotFound))
+}
+
+func TestReportService_GetInvoiceTotals(t *testing.T) {
+	day1 := time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local)
+	day2 := time.Date(2025, 10, 2, 0, 0, 0, 0, time.Local)
+
+	reportRepo := &MockReportRepository{}
+	reportRepo.On("GetInvoiceTotalsByCurrencyDay", domain.ReportFilter{}).Return([]domain.CurrencyDayTotal{
+		{Currency: "IDR", IssueDate: day1, InvoiceCount: 2, Subtotal: domain.NewMoney(1000), Tax: domain.NewMoney(100), TotalAmount: domain.NewMoney(1100)},
+		{Currency: "USD", IssueDate: day1, InvoiceCount: 1, Subtotal: domain.NewMoney(10), Tax: domain.NewMoney(1), TotalAmount: domain.NewMoney(11)},
+		{Currency: "USD", IssueDate: day2, InvoiceCount: 1, Subtotal: domain.NewMoney(20), Tax: domain.NewMoney(2), TotalAmount: domain.NewMoney(22)},
+	}, nil)
+
+	rateRepo := &MockExchangeRateRepository{}
+	rateRepo.On("GetRateOn", "IDR", "USD", day1).Return(domain.ExchangeRate{Rate: 16000}, nil)
+	rateRepo.On("GetRateOn", "IDR", "USD", day2).Return(domain.ExchangeRate{Rate: 16100}, nil)
+
+	s := NewReportService(reportRepo, NewExchangeRateService(rateRepo, "IDR"))
+
+	resp, err := s.GetInvoiceTotals(context.Background(), dto.ReportFilterRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "IDR", resp.BaseCurrency)
+	assert.Equal(t, int64(4), resp.InvoiceCount)
+	// 1100 + 11 * 16000 + 22 * 16100
+	assert.Equal(t, domain.NewMoney(1100+176000+354200), resp.TotalAmount)
+	assert.Equal(t, resp.Subtotal.Add(resp.Tax), resp.TotalAmount)
+
+	assert.Len(t, resp.ByCurrency, 2)
+	assert.Equal(t, "USD", resp.ByCurrency[1].Currency)
+	assert.Equal(t, domain.NewMoney(33), resp.ByCurrency[1].TotalAmount)
+	assert.Equal(t, domain.NewMoney(530200), resp.ByCurrency[1].ConvertedTotal)
+}
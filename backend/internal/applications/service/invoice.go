@@ -1,26 +1,34 @@
 package service
 
 import (
+	"context"
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/applications/mapper"
 	"invoice-system/internal/applications/ports/repository"
 	"invoice-system/internal/applications/ports/services"
 	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"time"
 )
 
 type InvoiceService struct {
-	repo repository.InvoiceRepository
+	repo         repository.InvoiceRepository
+	customers    repository.CustomerRepository
+	items        services.ItemService
+	tax          services.TaxService
+	baseCurrency string
 }
 
-func NewInvoiceService(repo repository.InvoiceRepository) services.InvoiceService {
-	return &InvoiceService{repo: repo}
+func NewInvoiceService(repo repository.InvoiceRepository, customers repository.CustomerRepository, items services.ItemService, tax services.TaxService, baseCurrency string) services.InvoiceService {
+	return &InvoiceService{repo: repo, customers: customers, items: items, tax: tax, baseCurrency: baseCurrency}
 }
 
 // GetAllInvoices implements services.InvoiceService.
-func (i *InvoiceService) GetAllInvoices(filters dto.GetInvoiceFilterRequest) (dto.InvoiceListResponse, error) {
+func (i *InvoiceService) GetAllInvoices(ctx context.Context, filters dto.GetInvoiceFilterRequest) (dto.InvoiceListResponse, error) {
 	filter := mapper.ToDomainInvoiceFilter(filters)
+	filter.AsOf = time.Now()
 
-	invoice, pagination, err := i.repo.GetAllInvoices(filter)
+	invoice, pagination, err := i.repo.GetAllInvoices(ctx, filter)
 
 	if err != nil {
 		return dto.InvoiceListResponse{}, err
@@ -30,45 +38,96 @@ func (i *InvoiceService) GetAllInvoices(filters dto.GetInvoiceFilterRequest) (dt
 }
 
 // CreateInvoice implements services.InvoiceService.
-func (i *InvoiceService) CreateInvoice(req dto.CreateInvoiceRequest) error {
-	items := make([]domain.InvoiceItem, len(req.Items))
+func (i *InvoiceService) CreateInvoice(ctx context.Context, req dto.CreateInvoiceRequest) (dto.InvoiceDetailResponse, error) {
+	status, err := initialInvoiceStatus(req.Status)
+	if err != nil {
+		return dto.InvoiceDetailResponse{}, err
+	}
 
-	var subtotal float64
+	currency, err := resolveCurrency(ctx, i.customers, i.baseCurrency, req.Currency, req.CustomerID)
+	if err != nil {
+		return dto.InvoiceDetailResponse{}, err
+	}
 
-	for i, item := range req.Items {
-		items[i] = domain.InvoiceItem{
-			ItemID:     item.ItemID,
-			Quantity:   item.Quantity,
-			Price:      item.Price,
-			TotalPrice: item.Price * float64(item.Quantity),
-		}
+	lines := make([]dto.InvoiceItemInput, len(req.Items))
+	for idx, item := range req.Items {
+		lines[idx] = dto.InvoiceItemInput(item)
+	}
+
+	items, err := buildInvoiceItems(ctx, i.items, req.CustomerID, req.IssueDate, lines)
+	if err != nil {
+		return dto.InvoiceDetailResponse{}, err
+	}
 
-		subtotal += items[i].TotalPrice
+	if err := i.tax.ApplyTaxes(ctx, req.CustomerID, items); err != nil {
+		return dto.InvoiceDetailResponse{}, err
 	}
 
 	invoice := domain.Invoice{
-		IssueDate:   req.IssueDate,
-		DueDate:     req.DueDate,
-		Subject:     req.Subject,
-		CustomerID:  req.CustomerID,
-		Subtotal:    subtotal,
-		Tax:         subtotal * (10.0 / 100.0),
-		TotalAmount: subtotal + (subtotal * (10.0 / 100.0)),
-		Status:      req.Status,
-		TotalItems:  len(items),
-		Items:       items,
-	}
-
-	err := i.repo.CreateInvoice(invoice)
+		IssueDate:    req.IssueDate,
+		DueDate:      req.DueDate,
+		Subject:      req.Subject,
+		CustomerID:   req.CustomerID,
+		Currency:     currency,
+		Status:       status,
+		QuoteID:      req.QuoteID,
+		RecurringID:  req.RecurringID,
+		RecurringRun: req.RecurringRun,
+		Items:        items,
+	}
+	invoice.CalculateTotals()
+
+	err = i.repo.CreateInvoice(ctx, &invoice)
 	if err != nil {
-		return err
+		return dto.InvoiceDetailResponse{}, err
 	}
 
-	return nil
+	return i.GetInvoiceByID(ctx, invoice.ID)
+}
+
+// DuplicateInvoice implements services.InvoiceService. The copy goes through
+// the regular invoice creation, so it gets the next number and its lines are
+// taxed again with the rates that apply now; it is a draft whatever the
+// status of the original, and is not linked to the quote or schedule the
+// original came from.
+func (i *InvoiceService) DuplicateInvoice(ctx context.Context, id uint, req dto.DuplicateInvoiceRequest) (dto.InvoiceDetailResponse, error) {
+	original, err := i.repo.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return dto.InvoiceDetailResponse{}, err
+	}
+
+	issueDate := utils.DateOnly(time.Now())
+	if !req.IssueDate.IsZero() {
+		issueDate = req.IssueDate
+	}
+
+	// the lines keep the rate they were resolved to, not whether it was
+	// chosen for them, so it isn't copied and is resolved again instead
+	lines := make([]dto.CreateInvoiceItemRequest, len(original.Items))
+	for idx, it := range original.Items {
+		lines[idx] = dto.CreateInvoiceItemRequest{
+			ItemID:   it.ItemID,
+			Quantity: it.Quantity,
+		}
+		if !req.RefreshPrices {
+			price := it.Price
+			lines[idx].Price = &price
+		}
+	}
+
+	return i.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		IssueDate:  issueDate,
+		DueDate:    issueDate.AddDate(0, 0, original.PaymentTermDays()),
+		Subject:    original.Subject,
+		CustomerID: original.CustomerID,
+		Currency:   original.Currency,
+		Status:     domain.InvoiceStatusDraft,
+		Items:      lines,
+	})
 }
 
-func (i *InvoiceService) GetInvoiceByID(id uint) (dto.InvoiceDetailResponse, error) {
-	invoice, err := i.repo.GetInvoiceByID(id)
+func (i *InvoiceService) GetInvoiceByID(ctx context.Context, id uint) (dto.InvoiceDetailResponse, error) {
+	invoice, err := i.repo.GetInvoiceByID(ctx, id)
 	if err != nil {
 		return dto.InvoiceDetailResponse{}, err
 	}
@@ -76,38 +135,205 @@ func (i *InvoiceService) GetInvoiceByID(id uint) (dto.InvoiceDetailResponse, err
 	return mapper.ToInvoiceDetailResponse(invoice), nil
 }
 
-func (i *InvoiceService) UpdateInvoice(id uint, req dto.UpdateInvoiceRequest) error {
-	items := make([]domain.InvoiceItem, len(req.Items))
-	var subtotal float64
+func (i *InvoiceService) UpdateInvoice(ctx context.Context, id uint, req dto.UpdateInvoiceRequest) error {
+	existing, err := i.repo.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !existing.IsEditable() {
+		return utils.ErrInvoiceLocked
+	}
+
+	// edits must say which version they were made to, so one made to a stale
+	// copy doesn't silently overwrite a newer change
+	if req.Version == nil {
+		return utils.ErrInvoiceVersionRequired
+	}
+	if *req.Version != existing.Version {
+		return utils.ErrInvoiceVersionConflict
+	}
 
-	for i, item := range req.Items {
-		items[i] = domain.InvoiceItem{
-			ItemID:     item.ItemID,
-			Quantity:   item.Quantity,
-			Price:      item.Price,
-			TotalPrice: item.Price * float64(item.Quantity),
+	// an empty status keeps the current one, anything else must be a legal
+	// move out of draft
+	status := existing.Status
+	if req.Status != "" {
+		status = domain.NormalizeInvoiceStatus(req.Status)
+		if !domain.CanTransitionInvoiceStatus(existing.Status, status) {
+			return utils.ErrInvalidStatusTransition
 		}
+	}
+
+	// an empty currency keeps the one the invoice already has
+	currency := req.Currency
+	if currency != "" {
+		code, ok := domain.NormalizeCurrency(currency)
+		if !ok {
+			return utils.ErrInvalidCurrency
+		}
+		currency = code
+	}
+
+	// a customer or issue date left out keeps the one the invoice has, and
+	// the lines are priced and taxed for it
+	customerID := req.CustomerID
+	if customerID == 0 {
+		customerID = existing.CustomerID
+	}
+	issueDate := req.IssueDate
+	if issueDate.IsZero() {
+		issueDate = existing.IssueDate
+	}
+
+	items, err := buildInvoiceItems(ctx, i.items, customerID, issueDate, req.Items)
+	if err != nil {
+		return err
+	}
 
-		subtotal += items[i].TotalPrice
+	if err := i.tax.ApplyTaxes(ctx, customerID, items); err != nil {
+		return err
 	}
 
 	invoice := domain.Invoice{
-		IssueDate:   req.IssueDate,
-		DueDate:     req.DueDate,
-		Subject:     req.Subject,
-		CustomerID:  req.CustomerID,
-		Subtotal:    subtotal,
-		Tax:         subtotal * (10.0 / 100.0),
-		TotalAmount: subtotal + (subtotal * (10.0 / 100.0)),
-		Status:      req.Status,
-		TotalItems:  len(items),
-		Items:       items,
-	}
-
-	err := i.repo.UpdateInvoice(id, invoice)
+		IssueDate:  issueDate,
+		DueDate:    req.DueDate,
+		Subject:    req.Subject,
+		CustomerID: customerID,
+		Currency:   currency,
+		Status:     status,
+		Items:      items,
+		Version:    *req.Version,
+	}
+	invoice.CalculateTotals()
+
+	err = i.repo.UpdateInvoice(ctx, id, invoice)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// UpdateInvoiceStatus implements services.InvoiceService.
+func (i *InvoiceService) UpdateInvoiceStatus(ctx context.Context, id uint, req dto.UpdateInvoiceStatusRequest) error {
+	status := domain.NormalizeInvoiceStatus(req.Status)
+	if !domain.IsValidInvoiceStatus(status) {
+		return utils.ErrInvalidInvoiceStatus
+	}
+
+	invoice, err := i.repo.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if invoice.Status == status {
+		return nil
+	}
+
+	// partially paid and paid follow from the recorded payments
+	if domain.IsPaymentStatus(status) || !domain.CanTransitionInvoiceStatus(invoice.Status, status) {
+		return utils.ErrInvalidStatusTransition
+	}
+
+	return i.repo.UpdateInvoiceStatus(ctx, id, invoice.Status, status)
+}
+
+// VoidInvoice implements services.InvoiceService. The invoice keeps its
+// number and lines but no longer counts towards what the customer owes.
+func (i *InvoiceService) VoidInvoice(ctx context.Context, id uint) error {
+	return i.UpdateInvoiceStatus(ctx, id, dto.UpdateInvoiceStatusRequest{Status: domain.InvoiceStatusVoid})
+}
+
+// DeleteInvoice implements services.InvoiceService.
+func (i *InvoiceService) DeleteInvoice(ctx context.Context, id uint) error {
+	return i.repo.DeleteInvoice(ctx, id)
+}
+
+// MarkOverdueInvoices implements services.InvoiceService.
+func (i *InvoiceService) MarkOverdueInvoices(ctx context.Context, now time.Time) (int64, error) {
+	return i.repo.MarkOverdueInvoices(ctx, utils.DateOnly(now), now)
+}
+
+// buildInvoiceItems turns the requested lines into invoice items. A line
+// without a price takes the item's effective price for the customer on the
+// issue date, and fails with utils.ErrItemPriceMissing when the item has
+// neither a catalog nor a price list price; either way the price and unit
+// are copied onto the line so later catalog and price list changes don't
+// alter the document. Quotes are priced the same way.
+func buildInvoiceItems(ctx context.Context, itemService services.ItemService, customerID uint, issueDate time.Time, lines []dto.InvoiceItemInput) ([]domain.InvoiceItem, error) {
+	ids := make([]uint, len(lines))
+	for idx, line := range lines {
+		ids[idx] = line.ItemID
+	}
+
+	prices, err := itemService.ResolvePrices(ctx, ids, customerID, issueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.InvoiceItem, len(lines))
+	for idx, line := range lines {
+		resolved := prices[line.ItemID]
+
+		price := resolved.UnitPrice
+		if line.Price != nil {
+			price = *line.Price
+		} else if resolved.PriceListID == nil && price.IsZero() {
+			return nil, utils.ErrItemPriceMissing
+		}
+
+		if price.IsNegative() {
+			return nil, domain.ErrInvalidMoney
+		}
+
+		items[idx] = domain.InvoiceItem{
+			ItemID:     line.ItemID,
+			Quantity:   line.Quantity,
+			Unit:       resolved.Item.Unit,
+			Price:      price,
+			TotalPrice: price.Mul(line.Quantity),
+			TaxRateID:  line.TaxRateID,
+		}
+	}
+
+	return items, nil
+}
+
+// initialInvoiceStatus validates the status a new invoice is created with.
+// Invoices start as drafts unless they are issued straight away.
+func initialInvoiceStatus(status string) (string, error) {
+	status = domain.NormalizeInvoiceStatus(status)
+
+	switch status {
+	case "":
+		return domain.InvoiceStatusDraft, nil
+	case domain.InvoiceStatusDraft, domain.InvoiceStatusIssued:
+		return status, nil
+	}
+
+	return "", utils.ErrInvalidInvoiceStatus
+}
+
+// resolveCurrency picks the document currency: the requested one, otherwise
+// the customer's default, otherwise the base currency.
+func resolveCurrency(ctx context.Context, customers repository.CustomerRepository, baseCurrency, requested string, customerID uint) (string, error) {
+	currency := requested
+	if currency == "" {
+		customer, err := customers.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return "", err
+		}
+		currency = customer.Currency
+	}
+
+	if currency == "" {
+		currency = baseCurrency
+	}
+
+	code, ok := domain.NormalizeCurrency(currency)
+	if !ok {
+		return "", utils.ErrInvalidCurrency
+	}
+
+	return code, nil
+}
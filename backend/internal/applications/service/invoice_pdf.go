@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"strings"
+)
+
+type invoicePDFService struct {
+	repo     repository.InvoiceRepository
+	renderer services.InvoiceRenderer
+}
+
+func NewInvoicePDFService(repo repository.InvoiceRepository, renderer services.InvoiceRenderer) services.InvoicePDFService {
+	return &invoicePDFService{repo: repo, renderer: renderer}
+}
+
+// RenderInvoicePDF implements services.InvoicePDFService.
+func (s *invoicePDFService) RenderInvoicePDF(ctx context.Context, id uint) (dto.DocumentResponse, error) {
+	invoice, err := s.repo.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	content, err := s.renderer.RenderInvoice(invoice)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	return dto.DocumentResponse{
+		FileName:    documentFileName(invoice.InvoiceNumber, "invoice") + ".pdf",
+		ContentType: "application/pdf",
+		Content:     content,
+	}, nil
+}
+
+// documentFileName makes a document number safe to use as a file name,
+// falling back to fallback for documents without a number.
+func documentFileName(number, fallback string) string {
+	if number == "" {
+		return fallback
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '"', ' ':
+			return '-'
+		}
+		return r
+	}, number)
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockInvoiceRenderer adalah mock untuk InvoiceRenderer
+type MockInvoiceRenderer struct {
+	mock.Mock
+}
+
+func (m *MockInvoiceRenderer) RenderInvoice(invoice domain.Invoice) ([]byte, error) {
+	args := m.Called(invoice)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func TestInvoicePDFService_RenderInvoicePDF(t *testing.T) {
+	invoice := domain.Invoice{ID: 1, InvoiceNumber: "INV/2025/0001"}
+
+	repo := &MockInvoiceRepo{}
+	repo.On("GetInvoiceByID", uint(1)).Return(invoice, nil)
+	repo.On("GetInvoiceByID", uint(2)).Return(domain.Invoice{}, utils.ErrInvoiceNotFound)
+
+	renderer := &MockInvoiceRenderer{}
+	renderer.On("RenderInvoice", invoice).Return([]byte("%PDF-1.3"), nil)
+
+	s := NewInvoicePDFService(repo, renderer)
+
+	doc, err := s.RenderInvoicePDF(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "INV-2025-0001.pdf", doc.FileName)
+	assert.Equal(t, "application/pdf", doc.ContentType)
+	assert.Equal(t, []byte("%PDF-1.3"), doc.Content)
+
+	_, err = s.RenderInvoicePDF(context.Background(), 2)
+	assert.Equal(t, utils.ErrInvoiceNotFound, err)
+
+	renderer.AssertExpectations(t)
+}
@@ -1,12 +1,15 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
 	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
 	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,30 +20,76 @@ type MockInvoiceRepo struct {
 	mock.Mock
 }
 
-func (m *MockInvoiceRepo) GetAllInvoices(filters domain.InvoiceFilter) ([]domain.Invoice, domain.Pagination, error) {
+func (m *MockInvoiceRepo) GetAllInvoices(ctx context.Context, filters domain.InvoiceFilter) ([]domain.Invoice, domain.Pagination, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]domain.Invoice), args.Get(1).(domain.Pagination), args.Error(2)
 }
 
-func (m *MockInvoiceRepo) CreateInvoice(invoice domain.Invoice) error {
+func (m *MockInvoiceRepo) CreateInvoice(ctx context.Context, invoice *domain.Invoice) error {
 	args := m.Called(invoice)
 	return args.Error(0)
 }
 
-func (m *MockInvoiceRepo) GetInvoiceByID(id uint) (domain.Invoice, error) {
+func (m *MockInvoiceRepo) GetInvoiceByID(ctx context.Context, id uint) (domain.Invoice, error) {
 	args := m.Called(id)
 	return args.Get(0).(domain.Invoice), args.Error(1)
 }
 
-func (m *MockInvoiceRepo) UpdateInvoice(id uint, invoice domain.Invoice) error {
+func (m *MockInvoiceRepo) UpdateInvoice(ctx context.Context, id uint, invoice domain.Invoice) error {
 	args := m.Called(id, invoice)
 	return args.Error(0)
 }
 
+func (m *MockInvoiceRepo) UpdateInvoiceStatus(ctx context.Context, id uint, from, to string) error {
+	args := m.Called(id, from, to)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepo) MarkOverdueInvoices(ctx context.Context, asOf, now time.Time) (int64, error) {
+	args := m.Called(asOf, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) DeleteInvoice(ctx context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// newIDRCustomerRepo returns a customer repository whose customers bill in IDR.
+func newIDRCustomerRepo() *MockCustomerRepository {
+	m := &MockCustomerRepository{}
+	m.On("GetCustomerByID", mock.AnythingOfType("uint")).Return(domain.Customer{ID: 1, Currency: "IDR"}, nil)
+	return m
+}
+
+// newCatalogItemRepo returns an item repository with a single catalog item
+// priced at 250 per hour.
+func newCatalogItemRepo() *MockItemRepository {
+	m := &MockItemRepository{}
+	m.On("GetItemsByIDs", mock.Anything).Return(map[uint]domain.Item{
+		1: {ID: 1, Name: "Consulting", UnitPrice: domain.NewMoney(250), Unit: "hour", IsActive: true},
+		// added before items had prices
+		2: {ID: 2, Name: "Support", Unit: "hour", IsActive: true},
+	}, nil)
+	return m
+}
+
+// newCatalogItemService resolves prices from newCatalogItemRepo without any
+// price list in effect.
+func newCatalogItemService() services.ItemService {
+	priceLists := &MockPriceListRepository{}
+	priceLists.On("GetEffectivePrices", mock.Anything, mock.Anything, mock.Anything).Return([]domain.PriceListPrice(nil), nil)
+	return NewItemService(newCatalogItemRepo(), priceLists, newIDRCustomerRepo())
+}
+
+func moneyPtr(m domain.Money) *domain.Money {
+	return &m
+}
+
 func TestNewInvoiceService(t *testing.T) {
 	mockRepo := &MockInvoiceRepo{}
 
-	invoiceService := NewInvoiceService(mockRepo)
+	invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
 
 	assert.NotNil(t, invoiceService)
 }
@@ -50,31 +99,104 @@ func TestInvoiceService_CreateInvoice_Simple(t *testing.T) {
 	mockRepo := &MockInvoiceRepo{}
 
 	// Simple test tanpa validasi calculation yang kompleks
-	mockRepo.On("CreateInvoice", mock.AnythingOfType("domain.Invoice")).Return(nil)
+	mockRepo.On("CreateInvoice", mock.AnythingOfType("*domain.Invoice")).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.Invoice).ID = 7
+	}).Return(nil)
+	mockRepo.On("GetInvoiceByID", uint(7)).Return(domain.Invoice{ID: 7, InvoiceNumber: "INV/2025/10/00001"}, nil)
 
-	invoiceService := NewInvoiceService(mockRepo)
+	invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
 
 	request := dto.CreateInvoiceRequest{
 		IssueDate:  testTime,
 		DueDate:    testTime.AddDate(0, 0, 30),
 		Subject:    "Simple Test",
 		CustomerID: 1,
-		Status:     "pending",
+		Status:     "draft",
 		Items: []dto.CreateInvoiceItemRequest{
 			{
 				ItemID:   1,
 				Quantity: 1,
-				Price:    100.0,
+				Price:    moneyPtr(domain.NewMoney(100)),
 			},
 		},
 	}
 
-	err := invoiceService.CreateInvoice(request)
+	resp, err := invoiceService.CreateInvoice(context.Background(), request)
 
 	assert.NoError(t, err)
+	assert.Equal(t, "INV/2025/10/00001", resp.InvoiceNumber)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestInvoiceService_CreateInvoice_CatalogPrice(t *testing.T) {
+	testTime := time.Now()
+
+	tests := []struct {
+		name          string
+		items         []dto.CreateInvoiceItemRequest
+		expectedPrice domain.Money
+		expectedError error
+	}{
+		{
+			name:          "price omitted falls back to catalog",
+			items:         []dto.CreateInvoiceItemRequest{{ItemID: 1, Quantity: 2}},
+			expectedPrice: domain.NewMoney(250),
+		},
+		{
+			name:          "explicit price overrides catalog",
+			items:         []dto.CreateInvoiceItemRequest{{ItemID: 1, Quantity: 2, Price: moneyPtr(domain.NewMoney(200))}},
+			expectedPrice: domain.NewMoney(200),
+		},
+		{
+			name:          "item without a price",
+			items:         []dto.CreateInvoiceItemRequest{{ItemID: 2, Quantity: 2}},
+			expectedError: utils.ErrItemPriceMissing,
+		},
+		{
+			name:          "explicit price for an item without one",
+			items:         []dto.CreateInvoiceItemRequest{{ItemID: 2, Quantity: 2, Price: moneyPtr(domain.NewMoney(300))}},
+			expectedPrice: domain.NewMoney(300),
+		},
+		{
+			name:          "unknown item",
+			items:         []dto.CreateInvoiceItemRequest{{ItemID: 42, Quantity: 1}},
+			expectedError: utils.ErrItemNotFound,
+		},
+		{
+			name:          "negative price",
+			items:         []dto.CreateInvoiceItemRequest{{ItemID: 1, Quantity: 1, Price: moneyPtr(domain.NewMoney(-5))}},
+			expectedError: domain.ErrInvalidMoney,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockInvoiceRepo{}
+			if tt.expectedError == nil {
+				mockRepo.On("CreateInvoice", mock.MatchedBy(func(invoice *domain.Invoice) bool {
+					line := invoice.Items[0]
+					return line.Price == tt.expectedPrice &&
+						line.TotalPrice == tt.expectedPrice.Mul(2) &&
+						line.Unit == "hour"
+				})).Return(nil)
+				mockRepo.On("GetInvoiceByID", uint(0)).Return(domain.Invoice{}, nil)
+			}
+
+			invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
+
+			_, err := invoiceService.CreateInvoice(context.Background(), dto.CreateInvoiceRequest{
+				IssueDate:  testTime,
+				DueDate:    testTime.AddDate(0, 0, 30),
+				CustomerID: 1,
+				Items:      tt.items,
+			})
+
+			assert.Equal(t, tt.expectedError, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestInvoiceService_GetInvoiceByID(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -91,11 +213,11 @@ func TestInvoiceService_GetInvoiceByID(t *testing.T) {
 					ID:            1,
 					InvoiceNumber: "INV-001",
 					Subject:       "Test Invoice",
-					Status:        "pending",
+					Status:        "issued",
 					CustomerID:    1,
-					Subtotal:      100.0,
-					Tax:           10.0,
-					TotalAmount:   110.0,
+					Subtotal:      domain.NewMoney(100),
+					Tax:           domain.NewMoney(10),
+					TotalAmount:   domain.NewMoney(110),
 				}
 				m.On("GetInvoiceByID", uint(1)).Return(invoice, nil)
 			},
@@ -125,9 +247,9 @@ func TestInvoiceService_GetInvoiceByID(t *testing.T) {
 			mockRepo := &MockInvoiceRepo{}
 			tt.setupMock(mockRepo)
 
-			invoiceService := NewInvoiceService(mockRepo)
+			invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
 
-			result, err := invoiceService.GetInvoiceByID(tt.id)
+			result, err := invoiceService.GetInvoiceByID(context.Background(), tt.id)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -144,6 +266,7 @@ func TestInvoiceService_GetInvoiceByID(t *testing.T) {
 
 func TestInvoiceService_UpdateInvoice(t *testing.T) {
 	testTime := time.Now()
+	version := func(v uint) *uint { return &v }
 
 	tests := []struct {
 		name        string
@@ -151,6 +274,7 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 		request     dto.UpdateInvoiceRequest
 		setupMock   func(*MockInvoiceRepo)
 		expectError bool
+		expectedErr error
 	}{
 		{
 			name: "successful invoice update",
@@ -160,27 +284,30 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 				DueDate:    testTime.AddDate(0, 0, 30),
 				Subject:    "Updated Invoice",
 				CustomerID: 1,
-				Status:     "paid",
+				Status:     "issued",
 				Items: []dto.InvoiceItemInput{
 					{
 						ItemID:   1,
 						Quantity: 3,
-						Price:    150.0,
+						Price:    moneyPtr(domain.NewMoney(150)),
 					},
 				},
+				Version: version(2),
 			},
 			setupMock: func(m *MockInvoiceRepo) {
 				// Expected calculations:
 				// Item 1: 3 * 150.0 = 450.0
-				// Subtotal: 450.0
+				// Subtotal: domain.NewMoney(450)
 				// Tax (10%): 45.0
 				// Total: 495.0
+				m.On("GetInvoiceByID", uint(1)).Return(domain.Invoice{ID: 1, Status: domain.InvoiceStatusDraft, Version: 2}, nil)
 				m.On("UpdateInvoice", uint(1), mock.MatchedBy(func(invoice domain.Invoice) bool {
-					return invoice.Subject == "Updated Invoice" &&
-						invoice.Status == "paid" &&
-						invoice.Subtotal == 450.0 &&
-						invoice.Tax == 45.0 &&
-						invoice.TotalAmount == 495.0
+					return invoice.Version == 2 &&
+						invoice.Subject == "Updated Invoice" &&
+						invoice.Status == domain.InvoiceStatusIssued &&
+						invoice.Subtotal == domain.NewMoney(450) &&
+						invoice.Tax == domain.NewMoney(45) &&
+						invoice.TotalAmount == domain.NewMoney(495)
 				})).Return(nil)
 			},
 			expectError: false,
@@ -193,20 +320,90 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 				DueDate:    testTime.AddDate(0, 0, 30),
 				Subject:    "Non-existent Invoice",
 				CustomerID: 1,
-				Status:     "pending",
 				Items: []dto.InvoiceItemInput{
 					{
 						ItemID:   1,
 						Quantity: 1,
-						Price:    100.0,
+						Price:    moneyPtr(domain.NewMoney(100)),
+					},
+				},
+			},
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("GetInvoiceByID", uint(999)).Return(domain.Invoice{}, utils.ErrInvoiceNotFound)
+			},
+			expectError: true,
+		},
+		{
+			name: "issued invoice is locked",
+			id:   2,
+			request: dto.UpdateInvoiceRequest{
+				Subject:    "Too late",
+				CustomerID: 1,
+				Items: []dto.InvoiceItemInput{
+					{
+						ItemID:   1,
+						Quantity: 1,
+						Price:    moneyPtr(domain.NewMoney(100)),
 					},
 				},
 			},
 			setupMock: func(m *MockInvoiceRepo) {
-				m.On("UpdateInvoice", uint(999), mock.AnythingOfType("domain.Invoice")).Return(errors.New("invoice not found"))
+				m.On("GetInvoiceByID", uint(2)).Return(domain.Invoice{ID: 2, Status: domain.InvoiceStatusIssued}, nil)
 			},
 			expectError: true,
 		},
+		{
+			name: "draft cannot jump to paid",
+			id:   3,
+			request: dto.UpdateInvoiceRequest{
+				CustomerID: 1,
+				Status:     "paid",
+				Version:    version(1),
+			},
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("GetInvoiceByID", uint(3)).Return(domain.Invoice{ID: 3, Status: domain.InvoiceStatusDraft, Version: 1}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "version is required",
+			id:   4,
+			request: dto.UpdateInvoiceRequest{
+				Subject:    "Blind edit",
+				CustomerID: 1,
+			},
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("GetInvoiceByID", uint(4)).Return(domain.Invoice{ID: 4, Status: domain.InvoiceStatusDraft, Version: 1}, nil)
+			},
+			expectedErr: utils.ErrInvoiceVersionRequired,
+		},
+		{
+			name: "edit of a stale version",
+			id:   5,
+			request: dto.UpdateInvoiceRequest{
+				Subject:    "Stale edit",
+				CustomerID: 1,
+				Version:    version(1),
+			},
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("GetInvoiceByID", uint(5)).Return(domain.Invoice{ID: 5, Status: domain.InvoiceStatusDraft, Version: 2}, nil)
+			},
+			expectedErr: utils.ErrInvoiceVersionConflict,
+		},
+		{
+			name: "concurrent edit of the same version",
+			id:   6,
+			request: dto.UpdateInvoiceRequest{
+				Subject:    "Second edit",
+				CustomerID: 1,
+				Version:    version(1),
+			},
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("GetInvoiceByID", uint(6)).Return(domain.Invoice{ID: 6, Status: domain.InvoiceStatusDraft, Version: 1}, nil)
+				m.On("UpdateInvoice", uint(6), mock.Anything).Return(utils.ErrInvoiceVersionConflict)
+			},
+			expectedErr: utils.ErrInvoiceVersionConflict,
+		},
 	}
 
 	for _, tt := range tests {
@@ -214,11 +411,13 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 			mockRepo := &MockInvoiceRepo{}
 			tt.setupMock(mockRepo)
 
-			invoiceService := NewInvoiceService(mockRepo)
+			invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
 
-			err := invoiceService.UpdateInvoice(tt.id, tt.request)
+			err := invoiceService.UpdateInvoice(context.Background(), tt.id, tt.request)
 
-			if tt.expectError {
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+			} else if tt.expectError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
@@ -229,6 +428,46 @@ func TestInvoiceService_UpdateInvoice(t *testing.T) {
 	}
 }
 
+func TestInvoiceService_UpdateInvoice_KeepsCustomerAndDate(t *testing.T) {
+	issueDate := time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local)
+	priceList := uint(4)
+	version := uint(1)
+
+	// the customer buys from their own price list and at a reduced rate
+	customers := &MockCustomerRepository{}
+	customers.On("GetCustomerByID", uint(7)).Return(domain.Customer{ID: 7, Currency: "IDR", PriceListID: &priceList}, nil)
+	priceLists := &MockPriceListRepository{}
+	priceLists.On("GetEffectivePrices", []uint{1}, &priceList, issueDate).Return([]domain.PriceListPrice{
+		{PriceListID: priceList, ItemID: 1, ValidFrom: issueDate, UnitPrice: domain.NewMoney(200)},
+	}, nil)
+	taxRepo := &MockTaxRateRepository{}
+	taxRepo.On("GetCustomerTaxRate", uint(7)).Return(&reducedRate, nil)
+	taxRepo.On("GetItemTaxRates", mock.Anything).Return(map[uint]domain.TaxRate{}, nil)
+
+	mockRepo := &MockInvoiceRepo{}
+	mockRepo.On("GetInvoiceByID", uint(1)).Return(domain.Invoice{ID: 1, CustomerID: 7, IssueDate: issueDate, Status: domain.InvoiceStatusDraft, Version: version}, nil)
+	mockRepo.On("UpdateInvoice", uint(1), mock.MatchedBy(func(invoice domain.Invoice) bool {
+		return invoice.CustomerID == 7 &&
+			invoice.IssueDate.Equal(issueDate) &&
+			invoice.Subtotal == domain.NewMoney(400) &&
+			invoice.Tax == domain.NewMoney(20)
+	})).Return(nil)
+
+	invoiceService := NewInvoiceService(mockRepo, customers, NewItemService(newCatalogItemRepo(), priceLists, customers), NewTaxService(taxRepo), "IDR")
+
+	// neither the customer nor the issue date is sent
+	err := invoiceService.UpdateInvoice(context.Background(), 1, dto.UpdateInvoiceRequest{
+		Subject: "More hours",
+		Items:   []dto.InvoiceItemInput{{ItemID: 1, Quantity: 2}},
+		Version: &version,
+	})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	priceLists.AssertExpectations(t)
+	taxRepo.AssertExpectations(t)
+}
+
 func TestInvoiceService_GetAllInvoices(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -249,7 +488,7 @@ func TestInvoiceService_GetAllInvoices(t *testing.T) {
 						ID:            1,
 						InvoiceNumber: "INV-001",
 						Subject:       "Test Invoice 1",
-						Status:        "pending",
+						Status:        "issued",
 					},
 					{
 						ID:            2,
@@ -287,9 +526,9 @@ func TestInvoiceService_GetAllInvoices(t *testing.T) {
 			mockRepo := &MockInvoiceRepo{}
 			tt.setupMock(mockRepo)
 
-			invoiceService := NewInvoiceService(mockRepo)
+			invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
 
-			result, err := invoiceService.GetAllInvoices(tt.filters)
+			result, err := invoiceService.GetAllInvoices(context.Background(), tt.filters)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -303,3 +542,287 @@ func TestInvoiceService_GetAllInvoices(t *testing.T) {
 		})
 	}
 }
+
+func TestInvoiceService_UpdateInvoiceStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     string
+		status      string
+		setupMock   func(*MockInvoiceRepo)
+		expectError error
+	}{
+		{
+			name:    "draft to issued",
+			current: domain.InvoiceStatusDraft,
+			status:  "issued",
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("UpdateInvoiceStatus", uint(1), domain.InvoiceStatusDraft, domain.InvoiceStatusIssued).Return(nil)
+			},
+		},
+		{
+			name:    "legacy sent alias",
+			current: domain.InvoiceStatusDraft,
+			status:  "sent",
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("UpdateInvoiceStatus", uint(1), domain.InvoiceStatusDraft, domain.InvoiceStatusIssued).Return(nil)
+			},
+		},
+		{
+			name:    "issued to void",
+			current: domain.InvoiceStatusIssued,
+			status:  "void",
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("UpdateInvoiceStatus", uint(1), domain.InvoiceStatusIssued, domain.InvoiceStatusVoid).Return(nil)
+			},
+		},
+		{
+			name:        "void is final",
+			current:     domain.InvoiceStatusVoid,
+			status:      "issued",
+			setupMock:   func(m *MockInvoiceRepo) {},
+			expectError: utils.ErrInvalidStatusTransition,
+		},
+		{
+			name:        "issued cannot go back to draft",
+			current:     domain.InvoiceStatusIssued,
+			status:      "draft",
+			setupMock:   func(m *MockInvoiceRepo) {},
+			expectError: utils.ErrInvalidStatusTransition,
+		},
+		{
+			name:        "paid is derived from payments",
+			current:     domain.InvoiceStatusIssued,
+			status:      "paid",
+			setupMock:   func(m *MockInvoiceRepo) {},
+			expectError: utils.ErrInvalidStatusTransition,
+		},
+		{
+			name:        "unknown status",
+			current:     domain.InvoiceStatusDraft,
+			status:      "archived",
+			setupMock:   func(m *MockInvoiceRepo) {},
+			expectError: utils.ErrInvalidInvoiceStatus,
+		},
+		{
+			name:      "same status is a no-op",
+			current:   domain.InvoiceStatusIssued,
+			status:    "issued",
+			setupMock: func(m *MockInvoiceRepo) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockInvoiceRepo{}
+			mockRepo.On("GetInvoiceByID", uint(1)).Return(domain.Invoice{ID: 1, Status: tt.current}, nil).Maybe()
+			tt.setupMock(mockRepo)
+
+			invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
+
+			err := invoiceService.UpdateInvoiceStatus(context.Background(), 1, dto.UpdateInvoiceStatusRequest{Status: tt.status})
+
+			assert.Equal(t, tt.expectError, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInvoiceService_GetAllInvoices_OverdueFilters(t *testing.T) {
+	mockRepo := &MockInvoiceRepo{}
+	thirty := 30
+	mockRepo.On("GetAllInvoices", mock.MatchedBy(func(f domain.InvoiceFilter) bool {
+		return f.Overdue && f.DaysOverdueGte != nil && *f.DaysOverdueGte == 30 && !f.AsOf.IsZero()
+	})).Return([]domain.Invoice{
+		{ID: 1, Status: domain.InvoiceStatusOverdue, DueDate: time.Now().AddDate(0, 0, -45)},
+	}, domain.Pagination{TotalItems: 1, TotalPages: 1, CurrentPage: 1, Limit: 10}, nil)
+
+	invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
+
+	result, err := invoiceService.GetAllInvoices(context.Background(), dto.GetInvoiceFilterRequest{Overdue: true, DaysOverdueGte: &thirty, Page: 1, Limit: 10})
+
+	assert.NoError(t, err)
+	if assert.Len(t, result.Invoices, 1) {
+		assert.Equal(t, 45, result.Invoices[0].DaysOverdue)
+	}
+	mockRepo.AssertExpectations(t)
+}
+
+func TestInvoiceService_MarkOverdueInvoices(t *testing.T) {
+	now := time.Date(2025, 6, 10, 14, 5, 0, 0, time.Local)
+	mockRepo := &MockInvoiceRepo{}
+	mockRepo.On("MarkOverdueInvoices", time.Date(2025, 6, 10, 0, 0, 0, 0, time.Local), now).Return(int64(3), nil)
+
+	invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
+
+	flagged, err := invoiceService.MarkOverdueInvoices(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), flagged)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestInvoiceService_VoidInvoice(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     string
+		setupMock   func(*MockInvoiceRepo)
+		expectError error
+	}{
+		{
+			name:    "overdue invoice is voided",
+			current: domain.InvoiceStatusOverdue,
+			setupMock: func(m *MockInvoiceRepo) {
+				m.On("UpdateInvoiceStatus", uint(1), domain.InvoiceStatusOverdue, domain.InvoiceStatusVoid).Return(nil)
+			},
+		},
+		{
+			name:      "already void",
+			current:   domain.InvoiceStatusVoid,
+			setupMock: func(m *MockInvoiceRepo) {},
+		},
+		{
+			name:        "draft is deleted, not voided",
+			current:     domain.InvoiceStatusDraft,
+			setupMock:   func(m *MockInvoiceRepo) {},
+			expectError: utils.ErrInvalidStatusTransition,
+		},
+		{
+			name:        "paid invoice stays paid",
+			current:     domain.InvoiceStatusPaid,
+			setupMock:   func(m *MockInvoiceRepo) {},
+			expectError: utils.ErrInvalidStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockInvoiceRepo{}
+			mockRepo.On("GetInvoiceByID", uint(1)).Return(domain.Invoice{ID: 1, Status: tt.current}, nil)
+			tt.setupMock(mockRepo)
+
+			invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
+
+			err := invoiceService.VoidInvoice(context.Background(), 1)
+
+			assert.Equal(t, tt.expectError, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInvoiceService_DuplicateInvoice(t *testing.T) {
+	quoteID := uint(3)
+	original := domain.Invoice{
+		ID:            5,
+		InvoiceNumber: "INV/2025/01/00005",
+		IssueDate:     time.Date(2025, 1, 10, 0, 0, 0, 0, time.Local),
+		DueDate:       time.Date(2025, 1, 24, 0, 0, 0, 0, time.Local),
+		Subject:       "Monthly retainer",
+		CustomerID:    1,
+		Currency:      "IDR",
+		Status:        domain.InvoiceStatusPaid,
+		QuoteID:       &quoteID,
+		Items: []domain.InvoiceItem{
+			{ItemID: 1, Quantity: 2, Unit: "hour", Price: domain.NewMoney(200), TotalPrice: domain.NewMoney(400)},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		req           dto.DuplicateInvoiceRequest
+		expectedIssue time.Time
+		expectedPrice domain.Money
+	}{
+		{
+			name:          "copies prices and is issued today",
+			expectedIssue: utils.DateOnly(time.Now()),
+			expectedPrice: domain.NewMoney(200),
+		},
+		{
+			name:          "refreshes prices from the catalog",
+			req:           dto.DuplicateInvoiceRequest{IssueDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.Local), RefreshPrices: true},
+			expectedIssue: time.Date(2025, 3, 1, 0, 0, 0, 0, time.Local),
+			expectedPrice: domain.NewMoney(250),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockInvoiceRepo{}
+			mockRepo.On("GetInvoiceByID", uint(5)).Return(original, nil)
+			mockRepo.On("CreateInvoice", mock.MatchedBy(func(invoice *domain.Invoice) bool {
+				return invoice.Status == domain.InvoiceStatusDraft &&
+					invoice.Subject == original.Subject &&
+					invoice.CustomerID == original.CustomerID &&
+					invoice.QuoteID == nil &&
+					invoice.IssueDate.Equal(tt.expectedIssue) &&
+					invoice.DueDate.Equal(tt.expectedIssue.AddDate(0, 0, 14)) &&
+					len(invoice.Items) == 1 &&
+					invoice.Items[0].Quantity == 2 &&
+					invoice.Items[0].Price == tt.expectedPrice
+			})).Run(func(args mock.Arguments) {
+				args.Get(0).(*domain.Invoice).ID = 6
+			}).Return(nil)
+			mockRepo.On("GetInvoiceByID", uint(6)).Return(domain.Invoice{ID: 6, InvoiceNumber: "INV/2025/03/00001", Status: domain.InvoiceStatusDraft}, nil)
+
+			invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
+
+			resp, err := invoiceService.DuplicateInvoice(context.Background(), 5, tt.req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint(6), resp.ID)
+			assert.Equal(t, domain.InvoiceStatusDraft, resp.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInvoiceService_DuplicateInvoice_RetaxesLines(t *testing.T) {
+	original := domain.Invoice{
+		ID:         5,
+		CustomerID: 1,
+		Currency:   "IDR",
+		Status:     domain.InvoiceStatusIssued,
+		Items: []domain.InvoiceItem{
+			// taxed with the default rate when the original was created
+			{ItemID: 1, Quantity: 2, Price: domain.NewMoney(200), TotalPrice: domain.NewMoney(400), TaxRateID: &standardRate.ID, TaxCode: standardRate.Code, TaxRate: standardRate.Rate},
+		},
+	}
+
+	// the customer has been given a reduced rate since
+	taxRepo := &MockTaxRateRepository{}
+	taxRepo.On("GetCustomerTaxRate", uint(1)).Return(&reducedRate, nil)
+	taxRepo.On("GetItemTaxRates", mock.Anything).Return(map[uint]domain.TaxRate{}, nil)
+
+	mockRepo := &MockInvoiceRepo{}
+	mockRepo.On("GetInvoiceByID", uint(5)).Return(original, nil)
+	mockRepo.On("CreateInvoice", mock.MatchedBy(func(invoice *domain.Invoice) bool {
+		return len(invoice.Items) == 1 &&
+			invoice.Items[0].TaxRateID != nil && *invoice.Items[0].TaxRateID == reducedRate.ID &&
+			invoice.Items[0].TaxAmount == domain.NewMoney(20) &&
+			invoice.Tax == domain.NewMoney(20)
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.Invoice).ID = 6
+	}).Return(nil)
+	mockRepo.On("GetInvoiceByID", uint(6)).Return(domain.Invoice{ID: 6, Status: domain.InvoiceStatusDraft}, nil)
+
+	invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(taxRepo), "IDR")
+
+	_, err := invoiceService.DuplicateInvoice(context.Background(), 5, dto.DuplicateInvoiceRequest{})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	taxRepo.AssertExpectations(t)
+}
+
+func TestInvoiceService_DuplicateInvoice_NotFound(t *testing.T) {
+	mockRepo := &MockInvoiceRepo{}
+	mockRepo.On("GetInvoiceByID", uint(9)).Return(domain.Invoice{}, utils.ErrInvoiceNotFound)
+
+	invoiceService := NewInvoiceService(mockRepo, newIDRCustomerRepo(), newCatalogItemService(), NewTaxService(newDefaultTaxRepo()), "IDR")
+
+	_, err := invoiceService.DuplicateInvoice(context.Background(), 9, dto.DuplicateInvoiceRequest{})
+
+	assert.Equal(t, utils.ErrInvoiceNotFound, err)
+	mockRepo.AssertExpectations(t)
+}
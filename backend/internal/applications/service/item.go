@@ -1,30 +1,136 @@
 package service
 
 import (
+	"context"
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/applications/mapper"
 	"invoice-system/internal/applications/ports/repository"
 	"invoice-system/internal/applications/ports/services"
 	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"strings"
+	"time"
 )
 
 type itemService struct {
-	repo repository.ItemRepository
+	repo       repository.ItemRepository
+	priceLists repository.PriceListRepository
+	customers  repository.CustomerRepository
 }
 
-func NewItemService(repo repository.ItemRepository) services.ItemService {
+func NewItemService(repo repository.ItemRepository, priceLists repository.PriceListRepository, customers repository.CustomerRepository) services.ItemService {
 	return &itemService{
-		repo: repo,
+		repo:       repo,
+		priceLists: priceLists,
+		customers:  customers,
 	}
 }
 
 // GetAllItems implements services.ItemService.
-func (s *itemService) GetAllItems(NameOrType string, limit uint) ([]domain.Item, error) {
-	return s.repo.GetAllItems(NameOrType, limit)
+func (s *itemService) GetAllItems(ctx context.Context, NameOrType string, limit uint) ([]domain.Item, error) {
+	return s.repo.GetAllItems(ctx, NameOrType, limit)
 }
 
-func (s *itemService) AddItem(item dto.DTOAddItemRequest) error {
+func (s *itemService) AddItem(ctx context.Context, item dto.DTOAddItemRequest) error {
 	itemData := mapper.ToDomainAddItemRequest(item)
+	if itemData.UnitPrice.IsNegative() {
+		return domain.ErrInvalidMoney
+	}
+
+	return s.repo.AddItem(ctx, itemData)
+}
+
+// GetItemByID implements services.ItemService.
+func (s *itemService) GetItemByID(ctx context.Context, id uint) (domain.Item, error) {
+	return s.repo.GetItemByID(ctx, id)
+}
+
+// UpdateItem implements services.ItemService. Only the fields present in the
+// request are changed.
+func (s *itemService) UpdateItem(ctx context.Context, req dto.DTOUpdateItemRequest) error {
+	item, err := s.repo.GetItemByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if req.SKU != nil {
+		item.SKU = strings.TrimSpace(*req.SKU)
+	}
+	if req.Name != "" {
+		item.Name = req.Name
+	}
+	if req.Type != "" {
+		item.Type = req.Type
+	}
+	if req.Description != nil {
+		item.Description = *req.Description
+	}
+	if req.UnitPrice != nil {
+		if req.UnitPrice.IsNegative() {
+			return domain.ErrInvalidMoney
+		}
+		item.UnitPrice = *req.UnitPrice
+	}
+	if unit := strings.TrimSpace(req.Unit); unit != "" {
+		item.Unit = unit
+	}
+	if req.IsActive != nil {
+		item.IsActive = *req.IsActive
+	}
+	if req.TaxRateID != nil {
+		item.TaxRateID = req.TaxRateID
+	}
+
+	return s.repo.UpdateItem(ctx, item)
+}
+
+// ResolvePrice implements services.ItemService.
+func (s *itemService) ResolvePrice(ctx context.Context, itemID, customerID uint, date time.Time) (domain.ItemPrice, error) {
+	prices, err := s.ResolvePrices(ctx, []uint{itemID}, customerID, date)
+	if err != nil {
+		return domain.ItemPrice{}, err
+	}
+
+	return prices[itemID], nil
+}
+
+// ResolvePrices implements services.ItemService. A customerID of 0 only
+// considers default price lists, a zero date means today. Every id must
+// exist in the catalog.
+func (s *itemService) ResolvePrices(ctx context.Context, itemIDs []uint, customerID uint, date time.Time) (map[uint]domain.ItemPrice, error) {
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	items, err := s.repo.GetItemsByIDs(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range itemIDs {
+		if _, ok := items[id]; !ok {
+			return nil, utils.ErrItemNotFound
+		}
+	}
+
+	var priceListID *uint
+	if customerID != 0 {
+		customer, err := s.customers.GetCustomerByID(ctx, customerID)
+		if err != nil {
+			return nil, err
+		}
+		priceListID = customer.PriceListID
+	}
+
+	candidates, err := s.priceLists.GetEffectivePrices(ctx, itemIDs, priceListID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[uint]domain.ItemPrice, len(items))
+	for id, item := range items {
+		prices[id] = domain.ResolveItemPrice(item, priceListID, candidates)
+	}
 
-	return s.repo.AddItem(itemData)
+	return prices, nil
 }
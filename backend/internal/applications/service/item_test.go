@@ -1,12 +1,14 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,12 +19,30 @@ type MockItemRepository struct {
 	mock.Mock
 }
 
-func (m *MockItemRepository) GetAllItems(nameOrType string, limit uint) ([]domain.Item, error) {
+func (m *MockItemRepository) GetAllItems(ctx context.Context, nameOrType string, limit uint) ([]domain.Item, error) {
 	args := m.Called(nameOrType, limit)
 	return args.Get(0).([]domain.Item), args.Error(1)
 }
 
-func (m *MockItemRepository) AddItem(item domain.Item) error {
+func (m *MockItemRepository) GetItemByID(ctx context.Context, id uint) (domain.Item, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetItemsByIDs(ctx context.Context, ids []uint) (map[uint]domain.Item, error) {
+	args := m.Called(ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uint]domain.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) AddItem(ctx context.Context, item domain.Item) error {
+	args := m.Called(item)
+	return args.Error(0)
+}
+
+func (m *MockItemRepository) UpdateItem(ctx context.Context, item domain.Item) error {
 	args := m.Called(item)
 	return args.Error(0)
 }
@@ -30,7 +50,7 @@ func (m *MockItemRepository) AddItem(item domain.Item) error {
 func TestNewItemService(t *testing.T) {
 	mockRepo := &MockItemRepository{}
 
-	itemService := NewItemService(mockRepo)
+	itemService := NewItemService(mockRepo, nil, nil)
 
 	assert.NotNil(t, itemService)
 }
@@ -203,9 +223,9 @@ func TestItemService_GetAllItems(t *testing.T) {
 			mockRepo := &MockItemRepository{}
 			tt.setupMock(mockRepo)
 
-			itemService := NewItemService(mockRepo)
+			itemService := NewItemService(mockRepo, nil, nil)
 
-			items, err := itemService.GetAllItems(tt.nameOrType, tt.limit)
+			items, err := itemService.GetAllItems(context.Background(), tt.nameOrType, tt.limit)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -322,9 +342,9 @@ func TestItemService_AddItem(t *testing.T) {
 			mockRepo := &MockItemRepository{}
 			tt.setupMock(mockRepo)
 
-			itemService := NewItemService(mockRepo)
+			itemService := NewItemService(mockRepo, nil, nil)
 
-			err := itemService.AddItem(tt.request)
+			err := itemService.AddItem(context.Background(), tt.request)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -355,12 +375,13 @@ func TestItemService_AddItem_DomainMapping(t *testing.T) {
 		capturedItem = args.Get(0).(domain.Item)
 	}).Return(nil)
 
-	itemService := NewItemService(mockRepo)
-	err := itemService.AddItem(request)
+	itemService := NewItemService(mockRepo, nil, nil)
+	err := itemService.AddItem(context.Background(), request)
 
 	assert.NoError(t, err)
 	assert.Equal(t, request.Name, capturedItem.Name)
 	assert.Equal(t, request.Type, capturedItem.Type)
+	assert.Equal(t, domain.DefaultItemUnit, capturedItem.Unit)
 	assert.False(t, capturedItem.IsActive) // Default value is false, not set by mapper
 	assert.Zero(t, capturedItem.ID)        // ID should be zero for new items
 	assert.Zero(t, capturedItem.CreatedAt) // Should be zero since not set in service
@@ -375,8 +396,8 @@ func TestItemService_EdgeCases(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockRepo.On("GetAllItems", "", uint(0)).Return([]domain.Item{}, nil)
 
-		itemService := NewItemService(mockRepo)
-		items, err := itemService.GetAllItems("", 0)
+		itemService := NewItemService(mockRepo, nil, nil)
+		items, err := itemService.GetAllItems(context.Background(), "", 0)
 
 		assert.NoError(t, err)
 		assert.Empty(t, items)
@@ -388,11 +409,75 @@ func TestItemService_EdgeCases(t *testing.T) {
 		mockRepo := &MockItemRepository{}
 		mockRepo.On("GetAllItems", longName, uint(10)).Return([]domain.Item{}, nil)
 
-		itemService := NewItemService(mockRepo)
-		items, err := itemService.GetAllItems(longName, 10)
+		itemService := NewItemService(mockRepo, nil, nil)
+		items, err := itemService.GetAllItems(context.Background(), longName, 10)
 
 		assert.NoError(t, err)
 		assert.Empty(t, items)
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestItemService_UpdateItem(t *testing.T) {
+	existing := domain.Item{
+		ID:        1,
+		SKU:       "SVC-001",
+		Name:      "Consulting",
+		Type:      "Service",
+		UnitPrice: domain.NewMoney(250),
+		Unit:      "hour",
+		IsActive:  true,
+	}
+	newPrice := domain.NewMoney(300)
+	negative := domain.NewMoney(-1)
+	inactive := false
+
+	tests := []struct {
+		name          string
+		request       dto.DTOUpdateItemRequest
+		setupMock     func(*MockItemRepository)
+		expectedError error
+	}{
+		{
+			name:    "price change keeps other fields",
+			request: dto.DTOUpdateItemRequest{ID: 1, UnitPrice: &newPrice, IsActive: &inactive},
+			setupMock: func(m *MockItemRepository) {
+				m.On("GetItemByID", uint(1)).Return(existing, nil)
+				m.On("UpdateItem", mock.MatchedBy(func(item domain.Item) bool {
+					return item.UnitPrice == newPrice &&
+						item.SKU == "SVC-001" &&
+						item.Unit == "hour" &&
+						!item.IsActive
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "negative price",
+			request: dto.DTOUpdateItemRequest{ID: 1, UnitPrice: &negative},
+			setupMock: func(m *MockItemRepository) {
+				m.On("GetItemByID", uint(1)).Return(existing, nil)
+			},
+			expectedError: domain.ErrInvalidMoney,
+		},
+		{
+			name:    "item not found",
+			request: dto.DTOUpdateItemRequest{ID: 9, Name: "Missing"},
+			setupMock: func(m *MockItemRepository) {
+				m.On("GetItemByID", uint(9)).Return(domain.Item{}, utils.ErrItemNotFound)
+			},
+			expectedError: utils.ErrItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockItemRepository{}
+			tt.setupMock(mockRepo)
+
+			err := NewItemService(mockRepo, nil, nil).UpdateItem(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedError, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"time"
+)
+
+type paymentService struct {
+	repo     repository.PaymentRepository
+	invoices repository.InvoiceRepository
+}
+
+func NewPaymentService(repo repository.PaymentRepository, invoices repository.InvoiceRepository) services.PaymentService {
+	return &paymentService{repo: repo, invoices: invoices}
+}
+
+// GetInvoicePayments implements services.PaymentService.
+func (p *paymentService) GetInvoicePayments(ctx context.Context, invoiceID uint) (dto.InvoicePaymentsResponse, error) {
+	invoice, err := p.invoices.GetInvoiceByID(ctx, invoiceID)
+	if err != nil {
+		return dto.InvoicePaymentsResponse{}, err
+	}
+
+	payments, err := p.repo.GetPaymentsByInvoiceID(ctx, invoiceID)
+	if err != nil {
+		return dto.InvoicePaymentsResponse{}, err
+	}
+
+	return mapper.ToInvoicePaymentsResponse(invoice, payments), nil
+}
+
+// CreatePayment implements services.PaymentService. The invoice status and
+// balance are updated by the repository together with the payment itself.
+func (p *paymentService) CreatePayment(ctx context.Context, invoiceID uint, req dto.CreatePaymentRequest) (dto.PaymentResponse, error) {
+	payment := mapper.ToDomainPayment(invoiceID, req)
+
+	if !domain.IsValidPaymentMethod(payment.Method) {
+		return dto.PaymentResponse{}, utils.ErrInvalidPaymentMethod
+	}
+
+	if payment.Amount.Cmp(domain.Money{}) <= 0 {
+		return dto.PaymentResponse{}, domain.ErrNonPositivePayment
+	}
+
+	if payment.PaymentDate.IsZero() {
+		payment.PaymentDate = utils.DateOnly(time.Now())
+	}
+
+	if _, err := p.repo.CreatePayment(ctx, &payment); err != nil {
+		return dto.PaymentResponse{}, err
+	}
+
+	return mapper.ToPaymentResponse(payment), nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPaymentRepository adalah mock untuk PaymentRepository
+type MockPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentRepository) GetPaymentsByInvoiceID(ctx context.Context, invoiceID uint) ([]domain.Payment, error) {
+	args := m.Called(invoiceID)
+	return args.Get(0).([]domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) CreatePayment(ctx context.Context, payment *domain.Payment) (domain.Invoice, error) {
+	args := m.Called(payment)
+	return args.Get(0).(domain.Invoice), args.Error(1)
+}
+
+func TestPaymentService_CreatePayment(t *testing.T) {
+	paymentDate := time.Date(2025, 11, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		request     dto.CreatePaymentRequest
+		setupMock   func(*MockPaymentRepository)
+		expectError error
+	}{
+		{
+			name: "records a payment",
+			request: dto.CreatePaymentRequest{
+				Amount:      domain.NewMoney(50),
+				PaymentDate: paymentDate,
+				Method:      "Bank_Transfer",
+				Reference:   " TRX-1 ",
+			},
+			setupMock: func(m *MockPaymentRepository) {
+				m.On("CreatePayment", mock.MatchedBy(func(p *domain.Payment) bool {
+					return p.InvoiceID == 1 &&
+						p.Method == domain.PaymentMethodBankTransfer &&
+						p.Reference == "TRX-1" &&
+						p.Amount == domain.NewMoney(50)
+				})).Return(domain.Invoice{ID: 1, Status: domain.InvoiceStatusPartiallyPaid}, nil)
+			},
+		},
+		{
+			name:        "unknown method",
+			request:     dto.CreatePaymentRequest{Amount: domain.NewMoney(50), Method: "bitcoin"},
+			setupMock:   func(m *MockPaymentRepository) {},
+			expectError: utils.ErrInvalidPaymentMethod,
+		},
+		{
+			name:        "negative amount",
+			request:     dto.CreatePaymentRequest{Amount: domain.NewMoney(-5), Method: "cash"},
+			setupMock:   func(m *MockPaymentRepository) {},
+			expectError: domain.ErrNonPositivePayment,
+		},
+		{
+			name:    "overpayment from repository",
+			request: dto.CreatePaymentRequest{Amount: domain.NewMoney(500), Method: "cash"},
+			setupMock: func(m *MockPaymentRepository) {
+				m.On("CreatePayment", mock.AnythingOfType("*domain.Payment")).Return(domain.Invoice{}, domain.ErrOverpayment)
+			},
+			expectError: domain.ErrOverpayment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockPaymentRepository{}
+			tt.setupMock(mockRepo)
+
+			paymentService := NewPaymentService(mockRepo, &MockInvoiceRepo{})
+
+			result, err := paymentService.CreatePayment(context.Background(), 1, tt.request)
+
+			if tt.expectError != nil {
+				assert.Equal(t, tt.expectError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(1), result.InvoiceID)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPaymentService_GetInvoicePayments(t *testing.T) {
+	invoiceRepo := &MockInvoiceRepo{}
+	invoiceRepo.On("GetInvoiceByID", uint(1)).Return(domain.Invoice{
+		ID:          1,
+		Currency:    "IDR",
+		Status:      domain.InvoiceStatusPartiallyPaid,
+		TotalAmount: domain.NewMoney(110),
+		AmountPaid:  domain.NewMoney(60),
+	}, nil)
+
+	paymentRepo := &MockPaymentRepository{}
+	paymentRepo.On("GetPaymentsByInvoiceID", uint(1)).Return([]domain.Payment{
+		{ID: 1, InvoiceID: 1, Amount: domain.NewMoney(60), Method: domain.PaymentMethodCash},
+	}, nil)
+
+	paymentService := NewPaymentService(paymentRepo, invoiceRepo)
+
+	result, err := paymentService.GetInvoicePayments(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewMoney(50), result.BalanceDue)
+	assert.Len(t, result.Payments, 1)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+)
+
+type priceListService struct {
+	repo  repository.PriceListRepository
+	items repository.ItemRepository
+}
+
+func NewPriceListService(repo repository.PriceListRepository, items repository.ItemRepository) services.PriceListService {
+	return &priceListService{repo: repo, items: items}
+}
+
+// GetAllPriceLists implements services.PriceListService.
+func (s *priceListService) GetAllPriceLists(ctx context.Context) ([]dto.PriceListResponse, error) {
+	lists, err := s.repo.GetAllPriceLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToPriceListResponseList(lists), nil
+}
+
+// GetPriceListByID implements services.PriceListService.
+func (s *priceListService) GetPriceListByID(ctx context.Context, id uint) (dto.PriceListResponse, error) {
+	list, err := s.repo.GetPriceListByID(ctx, id)
+	if err != nil {
+		return dto.PriceListResponse{}, err
+	}
+
+	return mapper.ToPriceListResponse(list), nil
+}
+
+// CreatePriceList implements services.PriceListService.
+func (s *priceListService) CreatePriceList(ctx context.Context, req dto.PriceListRequest) (dto.PriceListResponse, error) {
+	list := mapper.ToDomainPriceList(req)
+	if !list.HasValidWindow() {
+		return dto.PriceListResponse{}, utils.ErrInvalidPriceListWindow
+	}
+
+	if err := s.repo.CreatePriceList(ctx, &list); err != nil {
+		return dto.PriceListResponse{}, err
+	}
+
+	return mapper.ToPriceListResponse(list), nil
+}
+
+// UpdatePriceList implements services.PriceListService.
+func (s *priceListService) UpdatePriceList(ctx context.Context, id uint, req dto.PriceListRequest) error {
+	list := mapper.ToDomainPriceList(req)
+	if !list.HasValidWindow() {
+		return utils.ErrInvalidPriceListWindow
+	}
+	list.ID = id
+
+	return s.repo.UpdatePriceList(ctx, list)
+}
+
+// SetItemPrice implements services.PriceListService.
+func (s *priceListService) SetItemPrice(ctx context.Context, priceListID uint, req dto.PriceListItemRequest) error {
+	if req.UnitPrice.IsNegative() {
+		return domain.ErrInvalidMoney
+	}
+
+	if _, err := s.repo.GetPriceListByID(ctx, priceListID); err != nil {
+		return err
+	}
+
+	if _, err := s.items.GetItemByID(ctx, req.ItemID); err != nil {
+		return err
+	}
+
+	return s.repo.UpsertPriceListItem(ctx, domain.PriceListItem{
+		PriceListID: priceListID,
+		ItemID:      req.ItemID,
+		UnitPrice:   req.UnitPrice,
+	})
+}
+
+// RemoveItemPrice implements services.PriceListService.
+func (s *priceListService) RemoveItemPrice(ctx context.Context, priceListID, itemID uint) error {
+	return s.repo.DeletePriceListItem(ctx, priceListID, itemID)
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPriceListRepository adalah mock untuk PriceListRepository
+type MockPriceListRepository struct {
+	mock.Mock
+}
+
+func (m *MockPriceListRepository) GetAllPriceLists(ctx context.Context) ([]domain.PriceList, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) GetPriceListByID(ctx context.Context, id uint) (domain.PriceList, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.PriceList), args.Error(1)
+}
+
+func (m *MockPriceListRepository) CreatePriceList(ctx context.Context, priceList *domain.PriceList) error {
+	args := m.Called(priceList)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) UpdatePriceList(ctx context.Context, priceList domain.PriceList) error {
+	args := m.Called(priceList)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) UpsertPriceListItem(ctx context.Context, item domain.PriceListItem) error {
+	args := m.Called(item)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) DeletePriceListItem(ctx context.Context, priceListID, itemID uint) error {
+	args := m.Called(priceListID, itemID)
+	return args.Error(0)
+}
+
+func (m *MockPriceListRepository) GetEffectivePrices(ctx context.Context, itemIDs []uint, priceListID *uint, date time.Time) ([]domain.PriceListPrice, error) {
+	args := m.Called(itemIDs, priceListID, date)
+	return args.Get(0).([]domain.PriceListPrice), args.Error(1)
+}
+
+func TestPriceListService_CreatePriceList(t *testing.T) {
+	from := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(0, 0, -1)
+
+	t.Run("window ending before it starts", func(t *testing.T) {
+		repo := &MockPriceListRepository{}
+
+		_, err := NewPriceListService(repo, nil).CreatePriceList(context.Background(), dto.PriceListRequest{
+			Name:      "Broken",
+			ValidFrom: from,
+			ValidTo:   &before,
+		})
+
+		assert.Equal(t, utils.ErrInvalidPriceListWindow, err)
+		repo.AssertNotCalled(t, "CreatePriceList", mock.Anything)
+	})
+
+	t.Run("created", func(t *testing.T) {
+		repo := &MockPriceListRepository{}
+		repo.On("CreatePriceList", mock.AnythingOfType("*domain.PriceList")).Run(func(args mock.Arguments) {
+			args.Get(0).(*domain.PriceList).ID = 3
+		}).Return(nil)
+
+		resp, err := NewPriceListService(repo, nil).CreatePriceList(context.Background(), dto.PriceListRequest{
+			Name:      "2025 H2",
+			IsDefault: true,
+			ValidFrom: from,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), resp.ID)
+		assert.Equal(t, "2025-07-01", resp.ValidFrom)
+		assert.Nil(t, resp.ValidTo)
+	})
+}
+
+func TestPriceListService_SetItemPrice(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       dto.PriceListItemRequest
+		setupMock     func(*MockPriceListRepository, *MockItemRepository)
+		expectedError error
+	}{
+		{
+			name:    "price saved",
+			request: dto.PriceListItemRequest{ItemID: 1, UnitPrice: domain.NewMoney(90)},
+			setupMock: func(p *MockPriceListRepository, i *MockItemRepository) {
+				p.On("GetPriceListByID", uint(2)).Return(domain.PriceList{ID: 2}, nil)
+				i.On("GetItemByID", uint(1)).Return(domain.Item{ID: 1}, nil)
+				p.On("UpsertPriceListItem", domain.PriceListItem{PriceListID: 2, ItemID: 1, UnitPrice: domain.NewMoney(90)}).Return(nil)
+			},
+		},
+		{
+			name:    "unknown item",
+			request: dto.PriceListItemRequest{ItemID: 9, UnitPrice: domain.NewMoney(90)},
+			setupMock: func(p *MockPriceListRepository, i *MockItemRepository) {
+				p.On("GetPriceListByID", uint(2)).Return(domain.PriceList{ID: 2}, nil)
+				i.On("GetItemByID", uint(9)).Return(domain.Item{}, utils.ErrItemNotFound)
+			},
+			expectedError: utils.ErrItemNotFound,
+		},
+		{
+			name:          "negative price",
+			request:       dto.PriceListItemRequest{ItemID: 1, UnitPrice: domain.NewMoney(-1)},
+			setupMock:     func(p *MockPriceListRepository, i *MockItemRepository) {},
+			expectedError: domain.ErrInvalidMoney,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priceLists := &MockPriceListRepository{}
+			items := &MockItemRepository{}
+			tt.setupMock(priceLists, items)
+
+			err := NewPriceListService(priceLists, items).SetItemPrice(context.Background(), 2, tt.request)
+
+			assert.Equal(t, tt.expectedError, err)
+			priceLists.AssertExpectations(t)
+			items.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemService_ResolvePrices(t *testing.T) {
+	date := time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC)
+	wholesale := uint(5)
+
+	catalog := map[uint]domain.Item{
+		1: {ID: 1, UnitPrice: domain.NewMoney(100), Unit: "pcs"},
+		2: {ID: 2, UnitPrice: domain.NewMoney(40), Unit: "hour"},
+	}
+
+	items := &MockItemRepository{}
+	items.On("GetItemsByIDs", []uint{1, 2}).Return(catalog, nil)
+	items.On("GetItemsByIDs", []uint{3}).Return(map[uint]domain.Item{}, nil)
+
+	customers := &MockCustomerRepository{}
+	customers.On("GetCustomerByID", uint(1)).Return(domain.Customer{ID: 1, PriceListID: &wholesale}, nil)
+
+	priceLists := &MockPriceListRepository{}
+	priceLists.On("GetEffectivePrices", []uint{1, 2}, &wholesale, date).Return([]domain.PriceListPrice{
+		{PriceListID: 1, ItemID: 1, IsDefault: true, UnitPrice: domain.NewMoney(110)},
+		{PriceListID: 5, ItemID: 1, UnitPrice: domain.NewMoney(85)},
+	}, nil)
+	priceLists.On("GetEffectivePrices", []uint{1, 2}, (*uint)(nil), date).Return([]domain.PriceListPrice{
+		{PriceListID: 1, ItemID: 1, IsDefault: true, UnitPrice: domain.NewMoney(110)},
+	}, nil)
+
+	svc := NewItemService(items, priceLists, customers)
+
+	t.Run("customer price list", func(t *testing.T) {
+		prices, err := svc.ResolvePrices(context.Background(), []uint{1, 2}, 1, date)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NewMoney(85), prices[1].UnitPrice)
+		assert.Equal(t, &wholesale, prices[1].PriceListID)
+		assert.Equal(t, domain.NewMoney(40), prices[2].UnitPrice)
+		assert.Nil(t, prices[2].PriceListID)
+	})
+
+	t.Run("without customer only defaults apply", func(t *testing.T) {
+		prices, err := svc.ResolvePrices(context.Background(), []uint{1, 2}, 0, date)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NewMoney(110), prices[1].UnitPrice)
+		assert.Equal(t, domain.NewMoney(40), prices[2].UnitPrice)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		_, err := svc.ResolvePrices(context.Background(), []uint{3}, 0, date)
+
+		assert.Equal(t, utils.ErrItemNotFound, err)
+	})
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultQuoteValidityDays is how long a quote without an expiry date
+	// stays valid.
+	defaultQuoteValidityDays = 30
+	// defaultPaymentTermDays is when an invoice converted from a quote falls
+	// due unless a due date is given.
+	defaultPaymentTermDays = 30
+)
+
+type quoteService struct {
+	repo         repository.QuoteRepository
+	customers    repository.CustomerRepository
+	items        services.ItemService
+	tax          services.TaxService
+	invoices     services.InvoiceService
+	baseCurrency string
+}
+
+func NewQuoteService(repo repository.QuoteRepository, customers repository.CustomerRepository, items services.ItemService, tax services.TaxService, invoices services.InvoiceService, baseCurrency string) services.QuoteService {
+	return &quoteService{repo: repo, customers: customers, items: items, tax: tax, invoices: invoices, baseCurrency: baseCurrency}
+}
+
+// GetAllQuotes implements services.QuoteService.
+func (s *quoteService) GetAllQuotes(ctx context.Context, filter dto.GetQuoteFilterRequest) (dto.QuoteListResponse, error) {
+	quotes, pagination, err := s.repo.GetAllQuotes(ctx, mapper.ToDomainQuoteFilter(filter))
+	if err != nil {
+		return dto.QuoteListResponse{}, err
+	}
+
+	return mapper.ToQuoteListResponse(quotes, pagination), nil
+}
+
+// GetQuoteByID implements services.QuoteService.
+func (s *quoteService) GetQuoteByID(ctx context.Context, id uint) (dto.QuoteDetailResponse, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, id)
+	if err != nil {
+		return dto.QuoteDetailResponse{}, err
+	}
+
+	return mapper.ToQuoteDetailResponse(quote), nil
+}
+
+// CreateQuote implements services.QuoteService. Quotes always start as
+// drafts.
+func (s *quoteService) CreateQuote(ctx context.Context, req dto.QuoteRequest) (dto.QuoteDetailResponse, error) {
+	quote, err := s.buildQuote(ctx, req)
+	if err != nil {
+		return dto.QuoteDetailResponse{}, err
+	}
+	quote.Status = domain.QuoteStatusDraft
+
+	if err := s.repo.CreateQuote(ctx, &quote); err != nil {
+		return dto.QuoteDetailResponse{}, err
+	}
+
+	return s.GetQuoteByID(ctx, quote.ID)
+}
+
+// UpdateQuote implements services.QuoteService.
+func (s *quoteService) UpdateQuote(ctx context.Context, id uint, req dto.QuoteRequest) error {
+	existing, err := s.repo.GetQuoteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !existing.IsEditable() {
+		return utils.ErrQuoteLocked
+	}
+
+	quote, err := s.buildQuote(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateQuote(ctx, id, quote)
+}
+
+// UpdateQuoteStatus implements services.QuoteService. A quote can't be
+// accepted once it is past its expiry date.
+func (s *quoteService) UpdateQuoteStatus(ctx context.Context, id uint, req dto.UpdateQuoteStatusRequest) error {
+	status := strings.ToLower(strings.TrimSpace(req.Status))
+	if !domain.IsValidQuoteStatus(status) {
+		return utils.ErrInvalidQuoteStatus
+	}
+
+	quote, err := s.repo.GetQuoteByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if quote.Status == status {
+		return nil
+	}
+
+	if !domain.CanTransitionQuoteStatus(quote.Status, status) {
+		return utils.ErrInvalidQuoteTransition
+	}
+
+	if status == domain.QuoteStatusAccepted && quote.IsExpiredOn(time.Now()) {
+		return utils.ErrQuoteExpired
+	}
+
+	return s.repo.UpdateQuoteStatus(ctx, id, quote.Status, status)
+}
+
+// ConvertQuote implements services.QuoteService. The invoice goes through
+// the regular invoice creation with the quoted prices and tax rates, so it is
+// numbered and validated like any other invoice, and the quote is marked
+// converted in the same transaction that stores it. The unique quote link on
+// invoices keeps a quote from being converted twice.
+func (s *quoteService) ConvertQuote(ctx context.Context, id uint, req dto.ConvertQuoteRequest) (dto.InvoiceDetailResponse, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, id)
+	if err != nil {
+		return dto.InvoiceDetailResponse{}, err
+	}
+
+	if quote.InvoiceID != nil {
+		return dto.InvoiceDetailResponse{}, utils.ErrQuoteAlreadyConverted
+	}
+
+	today := utils.DateOnly(time.Now())
+	if !quote.IsConvertible(today) {
+		if quote.Status == domain.QuoteStatusSent {
+			return dto.InvoiceDetailResponse{}, utils.ErrQuoteExpired
+		}
+		return dto.InvoiceDetailResponse{}, utils.ErrQuoteNotConvertible
+	}
+
+	issueDate := req.IssueDate
+	if issueDate.IsZero() {
+		issueDate = today
+	}
+
+	dueDate := req.DueDate
+	if dueDate.IsZero() {
+		dueDate = issueDate.AddDate(0, 0, defaultPaymentTermDays)
+	}
+
+	lines := make([]dto.CreateInvoiceItemRequest, len(quote.Items))
+	for idx, it := range quote.Items {
+		price := it.Price
+		lines[idx] = dto.CreateInvoiceItemRequest{
+			ItemID:    it.ItemID,
+			Quantity:  it.Quantity,
+			Price:     &price,
+			TaxRateID: it.TaxRateID,
+		}
+	}
+
+	return s.invoices.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		IssueDate:  issueDate,
+		DueDate:    dueDate,
+		Subject:    quote.Subject,
+		CustomerID: quote.CustomerID,
+		Currency:   quote.Currency,
+		Status:     req.Status,
+		Items:      lines,
+		QuoteID:    &quote.ID,
+	})
+}
+
+// buildQuote prices and taxes the requested lines the way invoices are.
+func (s *quoteService) buildQuote(ctx context.Context, req dto.QuoteRequest) (domain.Quote, error) {
+	issueDate := req.IssueDate
+	if issueDate.IsZero() {
+		issueDate = utils.DateOnly(time.Now())
+	}
+
+	expiryDate := req.ExpiryDate
+	if expiryDate.IsZero() {
+		expiryDate = issueDate.AddDate(0, 0, defaultQuoteValidityDays)
+	}
+
+	if utils.DateOnly(expiryDate).Before(utils.DateOnly(issueDate)) {
+		return domain.Quote{}, utils.ErrInvalidQuoteExpiry
+	}
+
+	currency, err := resolveCurrency(ctx, s.customers, s.baseCurrency, req.Currency, req.CustomerID)
+	if err != nil {
+		return domain.Quote{}, err
+	}
+
+	lines := make([]dto.InvoiceItemInput, len(req.Items))
+	for idx, item := range req.Items {
+		lines[idx] = dto.InvoiceItemInput(item)
+	}
+
+	invoiceItems, err := buildInvoiceItems(ctx, s.items, req.CustomerID, issueDate, lines)
+	if err != nil {
+		return domain.Quote{}, err
+	}
+
+	if err := s.tax.ApplyTaxes(ctx, req.CustomerID, invoiceItems); err != nil {
+		return domain.Quote{}, err
+	}
+
+	items := make([]domain.QuoteItem, len(invoiceItems))
+	for idx, it := range invoiceItems {
+		items[idx] = domain.QuoteItem{
+			ItemID:     it.ItemID,
+			Quantity:   it.Quantity,
+			Unit:       it.Unit,
+			Price:      it.Price,
+			TotalPrice: it.TotalPrice,
+			TaxRateID:  it.TaxRateID,
+			TaxCode:    it.TaxCode,
+			TaxRate:    it.TaxRate,
+			TaxAmount:  it.TaxAmount,
+		}
+	}
+
+	quote := domain.Quote{
+		CustomerID: req.CustomerID,
+		IssueDate:  issueDate,
+		ExpiryDate: expiryDate,
+		Subject:    req.Subject,
+		Currency:   currency,
+		Items:      items,
+	}
+	quote.CalculateTotals()
+
+	return quote, nil
+}
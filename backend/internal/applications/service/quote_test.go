@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockQuoteRepository adalah mock untuk QuoteRepository
+type MockQuoteRepository struct {
+	mock.Mock
+}
+
+func (m *MockQuoteRepository) GetAllQuotes(ctx context.Context, filter domain.QuoteFilter) ([]domain.Quote, domain.Pagination, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.Quote), args.Get(1).(domain.Pagination), args.Error(2)
+}
+
+func (m *MockQuoteRepository) GetQuoteByID(ctx context.Context, id uint) (domain.Quote, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.Quote), args.Error(1)
+}
+
+func (m *MockQuoteRepository) CreateQuote(ctx context.Context, quote *domain.Quote) error {
+	args := m.Called(quote)
+	return args.Error(0)
+}
+
+func (m *MockQuoteRepository) UpdateQuote(ctx context.Context, id uint, quote domain.Quote) error {
+	args := m.Called(id, quote)
+	return args.Error(0)
+}
+
+func (m *MockQuoteRepository) UpdateQuoteStatus(ctx context.Context, id uint, from, to string) error {
+	args := m.Called(id, from, to)
+	return args.Error(0)
+}
+
+// sentQuote is a sent quote for 4 hours of consulting at 200, below the
+// catalog price, plus 10% tax.
+func sentQuote(expiry time.Time) domain.Quote {
+	rateID := standardRate.ID
+	quote := domain.Quote{
+		ID:          5,
+		QuoteNumber: "QUO/2025/00005",
+		CustomerID:  1,
+		IssueDate:   expiry.AddDate(0, 0, -30),
+		ExpiryDate:  expiry,
+		Subject:     "Website audit",
+		Currency:    "IDR",
+		Status:      domain.QuoteStatusSent,
+		Items: []domain.QuoteItem{{
+			ItemID:     1,
+			Quantity:   4,
+			Unit:       "hour",
+			Price:      domain.NewMoney(200),
+			TotalPrice: domain.NewMoney(800),
+			TaxRateID:  &rateID,
+			TaxCode:    standardRate.Code,
+			TaxRate:    standardRate.Rate,
+			TaxAmount:  domain.NewMoney(80),
+		}},
+	}
+	quote.CalculateTotals()
+	return quote
+}
+
+func newTestQuoteService(repo *MockQuoteRepository, invoiceRepo *MockInvoiceRepo) *quoteService {
+	taxRepo := newDefaultTaxRepo()
+	taxRepo.On("GetTaxRateByID", standardRate.ID).Return(standardRate, nil)
+	tax := NewTaxService(taxRepo)
+	invoices := NewInvoiceService(invoiceRepo, newIDRCustomerRepo(), newCatalogItemService(), tax, "IDR")
+	return NewQuoteService(repo, newIDRCustomerRepo(), newCatalogItemService(), tax, invoices, "IDR").(*quoteService)
+}
+
+func TestQuoteService_CreateQuote(t *testing.T) {
+	issueDate := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		req           dto.QuoteRequest
+		expectedError error
+	}{
+		{
+			name: "catalog price and default expiry",
+			req: dto.QuoteRequest{
+				CustomerID: 1,
+				IssueDate:  issueDate,
+				Items:      []dto.QuoteItemRequest{{ItemID: 1, Quantity: 2}},
+			},
+		},
+		{
+			name: "expiry before issue date",
+			req: dto.QuoteRequest{
+				CustomerID: 1,
+				IssueDate:  issueDate,
+				ExpiryDate: issueDate.AddDate(0, 0, -1),
+				Items:      []dto.QuoteItemRequest{{ItemID: 1, Quantity: 2}},
+			},
+			expectedError: utils.ErrInvalidQuoteExpiry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockQuoteRepository{}
+			var created domain.Quote
+			if tt.expectedError == nil {
+				repo.On("CreateQuote", mock.AnythingOfType("*domain.Quote")).Run(func(args mock.Arguments) {
+					q := args.Get(0).(*domain.Quote)
+					q.ID = 9
+					created = *q
+				}).Return(nil)
+				repo.On("GetQuoteByID", uint(9)).Return(domain.Quote{ID: 9, QuoteNumber: "QUO/2025/00001"}, nil)
+			}
+
+			resp, err := newTestQuoteService(repo, &MockInvoiceRepo{}).CreateQuote(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "QUO/2025/00001", resp.QuoteNumber)
+			assert.Equal(t, domain.QuoteStatusDraft, created.Status)
+			assert.Equal(t, issueDate.AddDate(0, 0, defaultQuoteValidityDays), created.ExpiryDate)
+			assert.Equal(t, domain.NewMoney(500), created.Subtotal)
+			assert.Equal(t, domain.NewMoney(50), created.Tax)
+			assert.Equal(t, "IDR", created.Currency)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQuoteService_UpdateQuote_Locked(t *testing.T) {
+	repo := &MockQuoteRepository{}
+	repo.On("GetQuoteByID", uint(5)).Return(sentQuote(time.Now().AddDate(0, 0, 10)), nil)
+
+	err := newTestQuoteService(repo, &MockInvoiceRepo{}).UpdateQuote(context.Background(), 5, dto.QuoteRequest{CustomerID: 1})
+
+	assert.Equal(t, utils.ErrQuoteLocked, err)
+	repo.AssertNotCalled(t, "UpdateQuote", mock.Anything, mock.Anything)
+}
+
+func TestQuoteService_UpdateQuoteStatus(t *testing.T) {
+	valid := sentQuote(time.Now().AddDate(0, 0, 10))
+	expired := sentQuote(time.Now().AddDate(0, 0, -1))
+	draft := sentQuote(time.Now().AddDate(0, 0, 10))
+	draft.Status = domain.QuoteStatusDraft
+
+	tests := []struct {
+		name          string
+		quote         domain.Quote
+		status        string
+		expectUpdate  bool
+		expectedError error
+	}{
+		{name: "send draft", quote: draft, status: "Sent", expectUpdate: true},
+		{name: "accept valid quote", quote: valid, status: "accepted", expectUpdate: true},
+		{name: "accept expired quote", quote: expired, status: "accepted", expectedError: utils.ErrQuoteExpired},
+		{name: "decline expired quote", quote: expired, status: "declined", expectUpdate: true},
+		{name: "accept draft", quote: draft, status: "accepted", expectedError: utils.ErrInvalidQuoteTransition},
+		{name: "unknown status", quote: draft, status: "issued", expectedError: utils.ErrInvalidQuoteStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockQuoteRepository{}
+			repo.On("GetQuoteByID", uint(5)).Return(tt.quote, nil).Maybe()
+			if tt.expectUpdate {
+				repo.On("UpdateQuoteStatus", uint(5), tt.quote.Status, mock.AnythingOfType("string")).Return(nil)
+			}
+
+			err := newTestQuoteService(repo, &MockInvoiceRepo{}).UpdateQuoteStatus(context.Background(), 5, dto.UpdateQuoteStatusRequest{Status: tt.status})
+
+			assert.Equal(t, tt.expectedError, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQuoteService_ConvertQuote(t *testing.T) {
+	quote := sentQuote(time.Now().AddDate(0, 0, 10))
+
+	repo := &MockQuoteRepository{}
+	repo.On("GetQuoteByID", uint(5)).Return(quote, nil)
+
+	var created domain.Invoice
+	invoiceRepo := &MockInvoiceRepo{}
+	invoiceRepo.On("CreateInvoice", mock.AnythingOfType("*domain.Invoice")).Run(func(args mock.Arguments) {
+		inv := args.Get(0).(*domain.Invoice)
+		inv.ID = 42
+		created = *inv
+	}).Return(nil)
+	invoiceRepo.On("GetInvoiceByID", uint(42)).Return(domain.Invoice{ID: 42, InvoiceNumber: "INV/2025/11/00001", QuoteID: &quote.ID}, nil)
+
+	resp, err := newTestQuoteService(repo, invoiceRepo).ConvertQuote(context.Background(), 5, dto.ConvertQuoteRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(42), resp.ID)
+	if assert.NotNil(t, created.QuoteID) {
+		assert.Equal(t, uint(5), *created.QuoteID)
+	}
+	assert.Equal(t, quote.Subject, created.Subject)
+	assert.Equal(t, created.IssueDate.AddDate(0, 0, defaultPaymentTermDays), created.DueDate)
+	if assert.Len(t, created.Items, 1) {
+		assert.Equal(t, domain.NewMoney(200), created.Items[0].Price, "quoted price is kept")
+		assert.Equal(t, 4, created.Items[0].Quantity)
+	}
+	assert.Equal(t, quote.TotalAmount, created.TotalAmount)
+	repo.AssertExpectations(t)
+	invoiceRepo.AssertExpectations(t)
+}
+
+func TestQuoteService_ConvertQuote_Rejected(t *testing.T) {
+	invoiceID := uint(42)
+	converted := sentQuote(time.Now().AddDate(0, 0, 10))
+	converted.Status = domain.QuoteStatusAccepted
+	converted.InvoiceID = &invoiceID
+	declined := sentQuote(time.Now().AddDate(0, 0, 10))
+	declined.Status = domain.QuoteStatusDeclined
+
+	tests := []struct {
+		name          string
+		quote         domain.Quote
+		expectedError error
+	}{
+		{name: "already converted", quote: converted, expectedError: utils.ErrQuoteAlreadyConverted},
+		{name: "expired", quote: sentQuote(time.Now().AddDate(0, 0, -1)), expectedError: utils.ErrQuoteExpired},
+		{name: "declined", quote: declined, expectedError: utils.ErrQuoteNotConvertible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockQuoteRepository{}
+			repo.On("GetQuoteByID", uint(5)).Return(tt.quote, nil)
+			invoiceRepo := &MockInvoiceRepo{}
+
+			_, err := newTestQuoteService(repo, invoiceRepo).ConvertQuote(context.Background(), 5, dto.ConvertQuoteRequest{})
+
+			assert.Equal(t, tt.expectedError, err)
+			invoiceRepo.AssertNotCalled(t, "CreateInvoice", mock.Anything)
+		})
+	}
+}
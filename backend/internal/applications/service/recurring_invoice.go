@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"strings"
+	"time"
+)
+
+type recurringInvoiceService struct {
+	repo         repository.RecurringInvoiceRepository
+	customers    repository.CustomerRepository
+	items        services.ItemService
+	tax          services.TaxService
+	invoices     services.InvoiceService
+	baseCurrency string
+}
+
+func NewRecurringInvoiceService(repo repository.RecurringInvoiceRepository, customers repository.CustomerRepository, items services.ItemService, tax services.TaxService, invoices services.InvoiceService, baseCurrency string) services.RecurringInvoiceService {
+	return &recurringInvoiceService{repo: repo, customers: customers, items: items, tax: tax, invoices: invoices, baseCurrency: baseCurrency}
+}
+
+// GetAllRecurringInvoices implements services.RecurringInvoiceService.
+func (s *recurringInvoiceService) GetAllRecurringInvoices(ctx context.Context, filter dto.GetRecurringInvoiceFilterRequest) (dto.RecurringInvoiceListResponse, error) {
+	recurring, pagination, err := s.repo.GetAllRecurringInvoices(ctx, mapper.ToDomainRecurringInvoiceFilter(filter))
+	if err != nil {
+		return dto.RecurringInvoiceListResponse{}, err
+	}
+
+	return mapper.ToRecurringInvoiceListResponse(recurring, pagination), nil
+}
+
+// GetRecurringInvoiceByID implements services.RecurringInvoiceService.
+func (s *recurringInvoiceService) GetRecurringInvoiceByID(ctx context.Context, id uint) (dto.RecurringInvoiceDetailResponse, error) {
+	recurring, err := s.repo.GetRecurringInvoiceByID(ctx, id)
+	if err != nil {
+		return dto.RecurringInvoiceDetailResponse{}, err
+	}
+
+	return mapper.ToRecurringInvoiceDetailResponse(recurring), nil
+}
+
+// CreateRecurringInvoice implements services.RecurringInvoiceService.
+func (s *recurringInvoiceService) CreateRecurringInvoice(ctx context.Context, req dto.CreateRecurringInvoiceRequest) (dto.RecurringInvoiceDetailResponse, error) {
+	recurring := domain.RecurringInvoice{
+		CustomerID:     req.CustomerID,
+		Subject:        req.Subject,
+		Interval:       strings.ToLower(strings.TrimSpace(req.Interval)),
+		StartDate:      utils.DateOnly(req.StartDate),
+		NextRunDate:    utils.DateOnly(req.StartDate),
+		EndDate:        req.EndDate,
+		MaxOccurrences: req.MaxOccurrences,
+		Status:         domain.RecurringStatusActive,
+	}
+
+	if !recurring.HasValidSchedule() {
+		return dto.RecurringInvoiceDetailResponse{}, utils.ErrInvalidRecurringSchedule
+	}
+
+	if err := s.applySettings(ctx, &recurring, req.Currency, req.PaymentTermDays, req.InvoiceStatus, req.Items); err != nil {
+		return dto.RecurringInvoiceDetailResponse{}, err
+	}
+
+	if err := s.repo.CreateRecurringInvoice(ctx, &recurring); err != nil {
+		return dto.RecurringInvoiceDetailResponse{}, err
+	}
+
+	return s.GetRecurringInvoiceByID(ctx, recurring.ID)
+}
+
+// UpdateRecurringInvoice implements services.RecurringInvoiceService.
+func (s *recurringInvoiceService) UpdateRecurringInvoice(ctx context.Context, id uint, req dto.UpdateRecurringInvoiceRequest) error {
+	recurring, err := s.repo.GetRecurringInvoiceByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if recurring.Status == domain.RecurringStatusCompleted {
+		return utils.ErrRecurringInvoiceCompleted
+	}
+
+	recurring.CustomerID = req.CustomerID
+	recurring.Subject = req.Subject
+	recurring.EndDate = req.EndDate
+	recurring.MaxOccurrences = req.MaxOccurrences
+
+	if !recurring.HasValidSchedule() {
+		return utils.ErrInvalidRecurringSchedule
+	}
+
+	if err := s.applySettings(ctx, &recurring, req.Currency, req.PaymentTermDays, req.InvoiceStatus, req.Items); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateRecurringInvoice(ctx, id, recurring)
+}
+
+// UpdateRecurringInvoiceStatus implements services.RecurringInvoiceService.
+// Only active and paused can be requested; runs missed while paused are
+// skipped on resume.
+func (s *recurringInvoiceService) UpdateRecurringInvoiceStatus(ctx context.Context, id uint, req dto.UpdateRecurringInvoiceStatusRequest) error {
+	status := strings.ToLower(strings.TrimSpace(req.Status))
+	if status != domain.RecurringStatusActive && status != domain.RecurringStatusPaused {
+		return utils.ErrInvalidRecurringStatus
+	}
+
+	recurring, err := s.repo.GetRecurringInvoiceByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if recurring.Status == domain.RecurringStatusCompleted {
+		return utils.ErrRecurringInvoiceCompleted
+	}
+
+	if recurring.Status == status {
+		return nil
+	}
+
+	if status == domain.RecurringStatusActive {
+		recurring.Resume(utils.DateOnly(time.Now()))
+	} else {
+		recurring.Status = domain.RecurringStatusPaused
+	}
+
+	return s.repo.UpdateRecurringSchedule(ctx, recurring, recurring.Occurrences)
+}
+
+// DeleteRecurringInvoice implements services.RecurringInvoiceService.
+func (s *recurringInvoiceService) DeleteRecurringInvoice(ctx context.Context, id uint) error {
+	return s.repo.DeleteRecurringInvoice(ctx, id)
+}
+
+// GenerateDueInvoices implements services.RecurringInvoiceService. A failing
+// template doesn't hold up the others; its error is returned once all
+// templates have been processed. Run without an organization, as the
+// scheduler does, it goes through every organization's templates, each
+// generating invoices in its own organization.
+func (s *recurringInvoiceService) GenerateDueInvoices(ctx context.Context, date time.Time) (int, error) {
+	today := utils.DateOnly(date)
+
+	due, err := s.repo.GetDueRecurringInvoices(ctx, today)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	var errs []error
+	for _, recurring := range due {
+		runCtx := ctx
+		if _, ok := domain.TenantFromContext(ctx); !ok {
+			runCtx = domain.ContextWithTenant(ctx, recurring.TenantID)
+		}
+
+		n, err := s.generateRuns(runCtx, recurring, today)
+		generated += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurring invoice %d: %w", recurring.ID, err))
+		}
+	}
+
+	return generated, errors.Join(errs...)
+}
+
+// generateRuns creates one invoice per run due on or before today, oldest
+// first, each dated on its own run date. Every invoice carries its run
+// number, so a run that was invoiced before the schedule could be advanced
+// is not invoiced again.
+func (s *recurringInvoiceService) generateRuns(ctx context.Context, recurring domain.RecurringInvoice, today time.Time) (int, error) {
+	generated := 0
+
+	for recurring.IsDueOn(today) {
+		from := recurring.Occurrences
+
+		if recurring.HasEnded() {
+			recurring.Status = domain.RecurringStatusCompleted
+			return generated, s.saveSchedule(ctx, recurring, from)
+		}
+
+		err := s.createRunInvoice(ctx, recurring)
+		switch {
+		case err == nil:
+			generated++
+		case errors.Is(err, utils.ErrRecurringRunExists):
+		default:
+			return generated, err
+		}
+
+		recurring.Advance()
+		if err := s.saveSchedule(ctx, recurring, from); err != nil {
+			return generated, err
+		}
+	}
+
+	return generated, nil
+}
+
+func (s *recurringInvoiceService) createRunInvoice(ctx context.Context, recurring domain.RecurringInvoice) error {
+	issueDate := utils.DateOnly(recurring.NextRunDate)
+
+	lines := make([]dto.CreateInvoiceItemRequest, len(recurring.Items))
+	for idx, it := range recurring.Items {
+		lines[idx] = dto.CreateInvoiceItemRequest{
+			ItemID:    it.ItemID,
+			Quantity:  it.Quantity,
+			Price:     it.Price,
+			TaxRateID: it.TaxRateID,
+		}
+	}
+
+	id := recurring.ID
+	_, err := s.invoices.CreateInvoice(ctx, dto.CreateInvoiceRequest{
+		IssueDate:    issueDate,
+		DueDate:      issueDate.AddDate(0, 0, recurring.PaymentTermDays),
+		Subject:      recurring.Subject,
+		CustomerID:   recurring.CustomerID,
+		Currency:     recurring.Currency,
+		Status:       recurring.InvoiceStatus,
+		Items:        lines,
+		RecurringID:  &id,
+		RecurringRun: recurring.Occurrences + 1,
+	})
+
+	return err
+}
+
+// saveSchedule stores the advanced schedule. Losing the race to another
+// scheduler is not an error: that scheduler carries on with the template.
+func (s *recurringInvoiceService) saveSchedule(ctx context.Context, recurring domain.RecurringInvoice, from int) error {
+	err := s.repo.UpdateRecurringSchedule(ctx, recurring, from)
+	if errors.Is(err, utils.ErrRecurringRunConflict) {
+		return nil
+	}
+
+	return err
+}
+
+// applySettings validates and copies the invoice settings shared by create
+// and update onto the template. Lines are priced and taxed once, as of the
+// next run, so a template that can't be invoiced is rejected up front.
+func (s *recurringInvoiceService) applySettings(ctx context.Context, recurring *domain.RecurringInvoice, currency string, paymentTermDays *int, invoiceStatus string, lines []dto.RecurringInvoiceItemRequest) error {
+	status, err := initialInvoiceStatus(invoiceStatus)
+	if err != nil {
+		return err
+	}
+
+	terms := defaultPaymentTermDays
+	if paymentTermDays != nil {
+		if *paymentTermDays < 0 {
+			return utils.ErrInvalidRecurringSchedule
+		}
+		terms = *paymentTermDays
+	}
+
+	code, err := resolveCurrency(ctx, s.customers, s.baseCurrency, currency, recurring.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	inputs := make([]dto.InvoiceItemInput, len(lines))
+	for idx, line := range lines {
+		inputs[idx] = dto.InvoiceItemInput(line)
+	}
+
+	invoiceItems, err := buildInvoiceItems(ctx, s.items, recurring.CustomerID, recurring.NextRunDate, inputs)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tax.ApplyTaxes(ctx, recurring.CustomerID, invoiceItems); err != nil {
+		return err
+	}
+
+	items := make([]domain.RecurringInvoiceItem, len(lines))
+	for idx, line := range lines {
+		items[idx] = domain.RecurringInvoiceItem{
+			ItemID:    line.ItemID,
+			Quantity:  line.Quantity,
+			Price:     line.Price,
+			TaxRateID: line.TaxRateID,
+		}
+	}
+
+	recurring.Currency = code
+	recurring.PaymentTermDays = terms
+	recurring.InvoiceStatus = status
+	recurring.Items = items
+
+	return nil
+}
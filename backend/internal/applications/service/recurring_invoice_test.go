@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRecurringInvoiceRepository adalah mock untuk RecurringInvoiceRepository
+type MockRecurringInvoiceRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecurringInvoiceRepository) GetAllRecurringInvoices(ctx context.Context, filter domain.RecurringInvoiceFilter) ([]domain.RecurringInvoice, domain.Pagination, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]domain.RecurringInvoice), args.Get(1).(domain.Pagination), args.Error(2)
+}
+
+func (m *MockRecurringInvoiceRepository) GetRecurringInvoiceByID(ctx context.Context, id uint) (domain.RecurringInvoice, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.RecurringInvoice), args.Error(1)
+}
+
+func (m *MockRecurringInvoiceRepository) CreateRecurringInvoice(ctx context.Context, recurring *domain.RecurringInvoice) error {
+	args := m.Called(recurring)
+	return args.Error(0)
+}
+
+func (m *MockRecurringInvoiceRepository) UpdateRecurringInvoice(ctx context.Context, id uint, recurring domain.RecurringInvoice) error {
+	args := m.Called(id, recurring)
+	return args.Error(0)
+}
+
+func (m *MockRecurringInvoiceRepository) DeleteRecurringInvoice(ctx context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRecurringInvoiceRepository) GetDueRecurringInvoices(ctx context.Context, date time.Time) ([]domain.RecurringInvoice, error) {
+	args := m.Called(date)
+	return args.Get(0).([]domain.RecurringInvoice), args.Error(1)
+}
+
+func (m *MockRecurringInvoiceRepository) UpdateRecurringSchedule(ctx context.Context, recurring domain.RecurringInvoice, fromOccurrences int) error {
+	args := m.Called(recurring, fromOccurrences)
+	return args.Error(0)
+}
+
+func newTestRecurringInvoiceService(repo *MockRecurringInvoiceRepository, invoiceRepo *MockInvoiceRepo) *recurringInvoiceService {
+	tax := NewTaxService(newDefaultTaxRepo())
+	invoices := NewInvoiceService(invoiceRepo, newIDRCustomerRepo(), newCatalogItemService(), tax, "IDR")
+	return NewRecurringInvoiceService(repo, newIDRCustomerRepo(), newCatalogItemService(), tax, invoices, "IDR").(*recurringInvoiceService)
+}
+
+// monthlyRetainer bills one hour of consulting at the catalog price on the
+// 15th of every month from January 2025.
+func monthlyRetainer() domain.RecurringInvoice {
+	start := time.Date(2025, 1, 15, 0, 0, 0, 0, time.Local)
+	return domain.RecurringInvoice{
+		ID:              3,
+		CustomerID:      1,
+		Subject:         "Monthly retainer",
+		Currency:        "IDR",
+		Interval:        domain.RecurringIntervalMonthly,
+		StartDate:       start,
+		NextRunDate:     start,
+		PaymentTermDays: 14,
+		InvoiceStatus:   domain.InvoiceStatusIssued,
+		Status:          domain.RecurringStatusActive,
+		Items:           []domain.RecurringInvoiceItem{{ItemID: 1, Quantity: 1}},
+	}
+}
+
+func TestRecurringInvoiceService_CreateRecurringInvoice(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.Local)
+	before := start.AddDate(0, 0, -1)
+	negative := -1
+
+	tests := []struct {
+		name          string
+		req           dto.CreateRecurringInvoiceRequest
+		expectedError error
+	}{
+		{
+			name: "monthly with defaults",
+			req: dto.CreateRecurringInvoiceRequest{
+				CustomerID: 1,
+				Interval:   "Monthly",
+				StartDate:  start,
+				Items:      []dto.RecurringInvoiceItemRequest{{ItemID: 1, Quantity: 1}},
+			},
+		},
+		{
+			name: "unknown interval",
+			req: dto.CreateRecurringInvoiceRequest{
+				CustomerID: 1,
+				Interval:   "daily",
+				StartDate:  start,
+				Items:      []dto.RecurringInvoiceItemRequest{{ItemID: 1, Quantity: 1}},
+			},
+			expectedError: utils.ErrInvalidRecurringSchedule,
+		},
+		{
+			name: "ends before it starts",
+			req: dto.CreateRecurringInvoiceRequest{
+				CustomerID: 1,
+				Interval:   "monthly",
+				StartDate:  start,
+				EndDate:    &before,
+				Items:      []dto.RecurringInvoiceItemRequest{{ItemID: 1, Quantity: 1}},
+			},
+			expectedError: utils.ErrInvalidRecurringSchedule,
+		},
+		{
+			name: "negative payment terms",
+			req: dto.CreateRecurringInvoiceRequest{
+				CustomerID:      1,
+				Interval:        "monthly",
+				StartDate:       start,
+				PaymentTermDays: &negative,
+				Items:           []dto.RecurringInvoiceItemRequest{{ItemID: 1, Quantity: 1}},
+			},
+			expectedError: utils.ErrInvalidRecurringSchedule,
+		},
+		{
+			name: "invalid invoice status",
+			req: dto.CreateRecurringInvoiceRequest{
+				CustomerID:    1,
+				Interval:      "monthly",
+				StartDate:     start,
+				InvoiceStatus: "paid",
+				Items:         []dto.RecurringInvoiceItemRequest{{ItemID: 1, Quantity: 1}},
+			},
+			expectedError: utils.ErrInvalidInvoiceStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockRecurringInvoiceRepository{}
+			var created domain.RecurringInvoice
+			if tt.expectedError == nil {
+				repo.On("CreateRecurringInvoice", mock.AnythingOfType("*domain.RecurringInvoice")).Run(func(args mock.Arguments) {
+					r := args.Get(0).(*domain.RecurringInvoice)
+					r.ID = 3
+					created = *r
+				}).Return(nil)
+				repo.On("GetRecurringInvoiceByID", uint(3)).Return(domain.RecurringInvoice{ID: 3}, nil)
+			}
+
+			resp, err := newTestRecurringInvoiceService(repo, &MockInvoiceRepo{}).CreateRecurringInvoice(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				repo.AssertNotCalled(t, "CreateRecurringInvoice", mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint(3), resp.ID)
+			assert.Equal(t, domain.RecurringIntervalMonthly, created.Interval)
+			assert.Equal(t, utils.DateOnly(start), created.NextRunDate)
+			assert.Equal(t, domain.RecurringStatusActive, created.Status)
+			assert.Equal(t, domain.InvoiceStatusDraft, created.InvoiceStatus)
+			assert.Equal(t, defaultPaymentTermDays, created.PaymentTermDays)
+			assert.Equal(t, "IDR", created.Currency)
+			if assert.Len(t, created.Items, 1) {
+				assert.Nil(t, created.Items[0].Price, "catalog price is resolved on every run")
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRecurringInvoiceService_GenerateDueInvoices_CatchesUp(t *testing.T) {
+	today := time.Date(2025, 4, 20, 8, 30, 0, 0, time.Local)
+
+	repo := &MockRecurringInvoiceRepository{}
+	repo.On("GetDueRecurringInvoices", utils.DateOnly(today)).Return([]domain.RecurringInvoice{monthlyRetainer()}, nil)
+
+	var schedules []domain.RecurringInvoice
+	repo.On("UpdateRecurringSchedule", mock.AnythingOfType("domain.RecurringInvoice"), mock.AnythingOfType("int")).Run(func(args mock.Arguments) {
+		r := args.Get(0).(domain.RecurringInvoice)
+		assert.Equal(t, r.Occurrences-1, args.Int(1), "advanced from the previous count")
+		schedules = append(schedules, r)
+	}).Return(nil)
+
+	var invoices []domain.Invoice
+	invoiceRepo := &MockInvoiceRepo{}
+	invoiceRepo.On("CreateInvoice", mock.AnythingOfType("*domain.Invoice")).Run(func(args mock.Arguments) {
+		inv := args.Get(0).(*domain.Invoice)
+		inv.ID = uint(100 + len(invoices))
+		invoices = append(invoices, *inv)
+	}).Return(nil)
+	invoiceRepo.On("GetInvoiceByID", mock.AnythingOfType("uint")).Return(domain.Invoice{}, nil)
+
+	generated, err := newTestRecurringInvoiceService(repo, invoiceRepo).GenerateDueInvoices(context.Background(), today)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, generated)
+	if assert.Len(t, invoices, 4) {
+		for idx, inv := range invoices {
+			runDate := time.Date(2025, time.Month(idx+1), 15, 0, 0, 0, 0, time.Local)
+			assert.Equal(t, runDate, inv.IssueDate, "each invoice is dated on its own run")
+			assert.Equal(t, runDate.AddDate(0, 0, 14), inv.DueDate)
+			assert.Equal(t, idx+1, inv.RecurringRun)
+			assert.Equal(t, domain.InvoiceStatusIssued, inv.Status)
+			assert.Equal(t, domain.NewMoney(250), inv.Items[0].Price)
+			if assert.NotNil(t, inv.RecurringID) {
+				assert.Equal(t, uint(3), *inv.RecurringID)
+			}
+		}
+	}
+
+	last := schedules[len(schedules)-1]
+	assert.Equal(t, 4, last.Occurrences)
+	assert.Equal(t, time.Date(2025, 5, 15, 0, 0, 0, 0, time.Local), last.NextRunDate)
+	assert.Equal(t, domain.RecurringStatusActive, last.Status)
+}
+
+func TestRecurringInvoiceService_GenerateDueInvoices_Idempotent(t *testing.T) {
+	today := time.Date(2025, 2, 20, 0, 0, 0, 0, time.Local)
+
+	repo := &MockRecurringInvoiceRepository{}
+	repo.On("GetDueRecurringInvoices", today).Return([]domain.RecurringInvoice{monthlyRetainer()}, nil)
+	repo.On("UpdateRecurringSchedule", mock.AnythingOfType("domain.RecurringInvoice"), mock.AnythingOfType("int")).Return(nil)
+
+	// the January run was invoiced before the schedule could be advanced
+	invoiceRepo := &MockInvoiceRepo{}
+	invoiceRepo.On("CreateInvoice", mock.MatchedBy(func(inv *domain.Invoice) bool { return inv.RecurringRun == 1 })).Return(utils.ErrRecurringRunExists)
+	invoiceRepo.On("CreateInvoice", mock.MatchedBy(func(inv *domain.Invoice) bool { return inv.RecurringRun == 2 })).Run(func(args mock.Arguments) {
+		args.Get(0).(*domain.Invoice).ID = 101
+	}).Return(nil)
+	invoiceRepo.On("GetInvoiceByID", uint(101)).Return(domain.Invoice{ID: 101}, nil)
+
+	generated, err := newTestRecurringInvoiceService(repo, invoiceRepo).GenerateDueInvoices(context.Background(), today)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, generated)
+	repo.AssertNumberOfCalls(t, "UpdateRecurringSchedule", 2)
+	invoiceRepo.AssertExpectations(t)
+}
+
+func TestRecurringInvoiceService_GenerateDueInvoices_EndConditions(t *testing.T) {
+	today := time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local)
+	two := 2
+	limited := monthlyRetainer()
+	limited.MaxOccurrences = &two
+
+	repo := &MockRecurringInvoiceRepository{}
+	repo.On("GetDueRecurringInvoices", today).Return([]domain.RecurringInvoice{limited}, nil)
+
+	var last domain.RecurringInvoice
+	repo.On("UpdateRecurringSchedule", mock.AnythingOfType("domain.RecurringInvoice"), mock.AnythingOfType("int")).Run(func(args mock.Arguments) {
+		last = args.Get(0).(domain.RecurringInvoice)
+	}).Return(nil)
+
+	invoiceRepo := &MockInvoiceRepo{}
+	invoiceRepo.On("CreateInvoice", mock.AnythingOfType("*domain.Invoice")).Return(nil)
+	invoiceRepo.On("GetInvoiceByID", mock.AnythingOfType("uint")).Return(domain.Invoice{}, nil)
+
+	generated, err := newTestRecurringInvoiceService(repo, invoiceRepo).GenerateDueInvoices(context.Background(), today)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, generated)
+	assert.Equal(t, domain.RecurringStatusCompleted, last.Status)
+	assert.Equal(t, 2, last.Occurrences)
+}
+
+func TestRecurringInvoiceService_GenerateDueInvoices_FailureIsolated(t *testing.T) {
+	today := time.Date(2025, 1, 20, 0, 0, 0, 0, time.Local)
+	broken := monthlyRetainer()
+	broken.ID = 4
+	broken.Items = []domain.RecurringInvoiceItem{{ItemID: 99, Quantity: 1}}
+
+	repo := &MockRecurringInvoiceRepository{}
+	repo.On("GetDueRecurringInvoices", today).Return([]domain.RecurringInvoice{broken, monthlyRetainer()}, nil)
+	repo.On("UpdateRecurringSchedule", mock.MatchedBy(func(r domain.RecurringInvoice) bool { return r.ID == 3 }), 0).Return(nil)
+
+	invoiceRepo := &MockInvoiceRepo{}
+	invoiceRepo.On("CreateInvoice", mock.AnythingOfType("*domain.Invoice")).Return(nil)
+	invoiceRepo.On("GetInvoiceByID", mock.AnythingOfType("uint")).Return(domain.Invoice{}, nil)
+
+	generated, err := newTestRecurringInvoiceService(repo, invoiceRepo).GenerateDueInvoices(context.Background(), today)
+
+	assert.Equal(t, 1, generated)
+	assert.True(t, errors.Is(err, utils.ErrItemNotFound))
+	repo.AssertExpectations(t)
+}
+
+func TestRecurringInvoiceService_UpdateRecurringInvoiceStatus(t *testing.T) {
+	paused := monthlyRetainer()
+	paused.Status = domain.RecurringStatusPaused
+	completed := monthlyRetainer()
+	completed.Status = domain.RecurringStatusCompleted
+
+	tests := []struct {
+		name           string
+		recurring      domain.RecurringInvoice
+		status         string
+		expectedStatus string
+		expectedError  error
+	}{
+		{name: "pause", recurring: monthlyRetainer(), status: "paused", expectedStatus: domain.RecurringStatusPaused},
+		{name: "resume", recurring: paused, status: "Active", expectedStatus: domain.RecurringStatusActive},
+		{name: "completed", recurring: completed, status: "active", expectedError: utils.ErrRecurringInvoiceCompleted},
+		{name: "unknown status", recurring: paused, status: "completed", expectedError: utils.ErrInvalidRecurringStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockRecurringInvoiceRepository{}
+			repo.On("GetRecurringInvoiceByID", uint(3)).Return(tt.recurring, nil).Maybe()
+
+			var saved domain.RecurringInvoice
+			if tt.expectedError == nil {
+				repo.On("UpdateRecurringSchedule", mock.AnythingOfType("domain.RecurringInvoice"), tt.recurring.Occurrences).Run(func(args mock.Arguments) {
+					saved = args.Get(0).(domain.RecurringInvoice)
+				}).Return(nil)
+			}
+
+			err := newTestRecurringInvoiceService(repo, &MockInvoiceRepo{}).UpdateRecurringInvoiceStatus(context.Background(), 3, dto.UpdateRecurringInvoiceStatusRequest{Status: tt.status})
+
+			assert.Equal(t, tt.expectedError, err)
+			if tt.expectedError == nil {
+				assert.Equal(t, tt.expectedStatus, saved.Status)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
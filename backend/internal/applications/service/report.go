@@ -0,0 +1,285 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Top sales lists show 10 entries unless asked for more, up to 100.
+const (
+	defaultTopSalesLimit = 10
+	maxTopSalesLimit     = 100
+)
+
+type reportService struct {
+	repo  repository.ReportRepository
+	rates services.ExchangeRateService
+}
+
+func NewReportService(repo repository.ReportRepository, rates services.ExchangeRateService) services.ReportService {
+	return &reportService{repo: repo, rates: rates}
+}
+
+// GetInvoiceTotals sums invoices per currency and converts each day's totals
+// into the base currency with the rate of that issue date.
+func (s *reportService) GetInvoiceTotals(ctx context.Context, req dto.ReportFilterRequest) (dto.InvoiceTotalsResponse, error) {
+	totals, err := s.repo.GetInvoiceTotalsByCurrencyDay(ctx, mapper.ToDomainReportFilter(req))
+	if err != nil {
+		return dto.InvoiceTotalsResponse{}, err
+	}
+
+	resp := dto.InvoiceTotalsResponse{
+		BaseCurrency: s.rates.BaseCurrency(ctx),
+		ByCurrency:   []dto.CurrencyTotalResponse{},
+	}
+	index := make(map[string]int)
+
+	for _, t := range totals {
+		subtotal, err := s.rates.Convert(ctx, t.Subtotal, t.Currency, t.IssueDate)
+		if err != nil {
+			return dto.InvoiceTotalsResponse{}, err
+		}
+
+		tax, err := s.rates.Convert(ctx, t.Tax, t.Currency, t.IssueDate)
+		if err != nil {
+			return dto.InvoiceTotalsResponse{}, err
+		}
+
+		// converting the parts keeps subtotal + tax = total in the base currency
+		converted := subtotal.Add(tax)
+
+		resp.InvoiceCount += t.InvoiceCount
+		resp.Subtotal = resp.Subtotal.Add(subtotal)
+		resp.Tax = resp.Tax.Add(tax)
+		resp.TotalAmount = resp.TotalAmount.Add(converted)
+
+		idx, ok := index[t.Currency]
+		if !ok {
+			idx = len(resp.ByCurrency)
+			index[t.Currency] = idx
+			resp.ByCurrency = append(resp.ByCurrency, dto.CurrencyTotalResponse{Currency: t.Currency})
+		}
+
+		row := &resp.ByCurrency[idx]
+		row.InvoiceCount += t.InvoiceCount
+		row.Subtotal = row.Subtotal.Add(t.Subtotal)
+		row.Tax = row.Tax.Add(t.Tax)
+		row.TotalAmount = row.TotalAmount.Add(t.TotalAmount)
+		row.ConvertedTotal = row.ConvertedTotal.Add(converted)
+	}
+
+	return resp, nil
+}
+
+// GetAgingReport buckets what each customer owed at the end of the as-of day
+// by days past due and adds up the buckets per currency. Balances in
+// different currencies are never added together.
+func (s *reportService) GetAgingReport(ctx context.Context, req dto.AgingReportRequest) (dto.AgingReportResponse, error) {
+	asOf := utils.DateOnly(time.Now())
+	if req.AsOf != nil {
+		asOf = utils.DateOnly(*req.AsOf)
+	}
+
+	rows, err := s.repo.GetAgingRows(ctx, domain.AgingFilter{AsOf: asOf, CustomerID: req.CustomerID})
+	if err != nil {
+		return dto.AgingReportResponse{}, err
+	}
+
+	resp := dto.AgingReportResponse{
+		AsOf:      asOf,
+		Customers: make([]dto.AgingCustomerResponse, 0, len(rows)),
+		Totals:    []dto.AgingTotalResponse{},
+	}
+	totals := make(map[string]*domain.AgingRow)
+
+	for _, row := range rows {
+		resp.Customers = append(resp.Customers, mapper.ToAgingCustomerResponse(row))
+
+		total, ok := totals[row.Currency]
+		if !ok {
+			total = &domain.AgingRow{Currency: row.Currency}
+			totals[row.Currency] = total
+		}
+		total.Current = total.Current.Add(row.Current)
+		total.Days1To30 = total.Days1To30.Add(row.Days1To30)
+		total.Days31To60 = total.Days31To60.Add(row.Days31To60)
+		total.Days61To90 = total.Days61To90.Add(row.Days61To90)
+		total.DaysOver90 = total.DaysOver90.Add(row.DaysOver90)
+	}
+
+	for currency, total := range totals {
+		resp.Totals = append(resp.Totals, dto.AgingTotalResponse{
+			Currency:             currency,
+			AgingBucketsResponse: mapper.ToAgingBucketsResponse(*total),
+		})
+	}
+	sort.Slice(resp.Totals, func(i, j int) bool { return resp.Totals[i].Currency < resp.Totals[j].Currency })
+
+	return resp, nil
+}
+
+// ExportAgingReportCSV implements services.ReportService.
+func (s *reportService) ExportAgingReportCSV(ctx context.Context, req dto.AgingReportRequest) (dto.DocumentResponse, error) {
+	report, err := s.GetAgingReport(ctx, req)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"customer_id", "customer_name", "currency", "current", "days_1_30", "days_31_60", "days_61_90", "days_over_90", "total"})
+
+	for _, c := range report.Customers {
+		_ = w.Write(append([]string{strconv.FormatUint(uint64(c.CustomerID), 10), c.CustomerName, c.Currency}, agingBucketFields(c.AgingBucketsResponse)...))
+	}
+
+	for _, t := range report.Totals {
+		_ = w.Write(append([]string{"", "Total", t.Currency}, agingBucketFields(t.AgingBucketsResponse)...))
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to write aging report: %w", err)
+	}
+
+	return dto.DocumentResponse{
+		FileName:    fmt.Sprintf("aging-%s.csv", report.AsOf.Format("2006-01-02")),
+		ContentType: "text/csv; charset=utf-8",
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+func agingBucketFields(b dto.AgingBucketsResponse) []string {
+	return []string{b.Current.String(), b.Days1To30.String(), b.Days31To60.String(), b.Days61To90.String(), b.DaysOver90.String(), b.Total.String()}
+}
+
+// GetRevenueReport implements services.ReportService.
+func (s *reportService) GetRevenueReport(ctx context.Context, req dto.PeriodReportRequest) (dto.RevenueReportResponse, error) {
+	period, filter, err := periodReportFilter(req)
+	if err != nil {
+		return dto.RevenueReportResponse{}, err
+	}
+
+	totals, err := s.repo.GetRevenueByPeriod(ctx, filter, period)
+	if err != nil {
+		return dto.RevenueReportResponse{}, err
+	}
+
+	resp := dto.RevenueReportResponse{Period: period, Periods: make([]dto.RevenuePeriodResponse, 0, len(totals))}
+	for _, t := range totals {
+		resp.Periods = append(resp.Periods, mapper.ToRevenuePeriodResponse(t))
+	}
+
+	return resp, nil
+}
+
+// GetTaxReport implements services.ReportService.
+func (s *reportService) GetTaxReport(ctx context.Context, req dto.PeriodReportRequest) (dto.TaxReportResponse, error) {
+	period, filter, err := periodReportFilter(req)
+	if err != nil {
+		return dto.TaxReportResponse{}, err
+	}
+
+	totals, err := s.repo.GetTaxByPeriod(ctx, filter, period)
+	if err != nil {
+		return dto.TaxReportResponse{}, err
+	}
+
+	resp := dto.TaxReportResponse{Period: period, Periods: make([]dto.TaxPeriodResponse, 0, len(totals))}
+	for _, t := range totals {
+		resp.Periods = append(resp.Periods, mapper.ToTaxPeriodResponse(t))
+	}
+
+	return resp, nil
+}
+
+// GetTopCustomers implements services.ReportService.
+func (s *reportService) GetTopCustomers(ctx context.Context, req dto.TopSalesRequest) (dto.TopCustomersResponse, error) {
+	filter, limit := s.topSalesFilter(ctx, req)
+
+	customers, err := s.repo.GetTopCustomers(ctx, filter, limit)
+	if err != nil {
+		return dto.TopCustomersResponse{}, err
+	}
+
+	resp := dto.TopCustomersResponse{Currency: filter.Currency, Customers: make([]dto.CustomerSalesResponse, 0, len(customers))}
+	for _, c := range customers {
+		resp.Customers = append(resp.Customers, mapper.ToCustomerSalesResponse(c))
+	}
+
+	return resp, nil
+}
+
+// GetTopItems implements services.ReportService.
+func (s *reportService) GetTopItems(ctx context.Context, req dto.TopSalesRequest) (dto.TopItemsResponse, error) {
+	sortBy := strings.ToLower(strings.TrimSpace(req.SortBy))
+	if sortBy == "" {
+		sortBy = domain.ItemSalesByRevenue
+	}
+	if sortBy != domain.ItemSalesByRevenue && sortBy != domain.ItemSalesByQuantity {
+		return dto.TopItemsResponse{}, utils.ErrInvalidSortField
+	}
+
+	filter, limit := s.topSalesFilter(ctx, req)
+
+	items, err := s.repo.GetTopItems(ctx, filter, sortBy, limit)
+	if err != nil {
+		return dto.TopItemsResponse{}, err
+	}
+
+	resp := dto.TopItemsResponse{Currency: filter.Currency, SortBy: sortBy, Items: make([]dto.ItemSalesResponse, 0, len(items))}
+	for _, i := range items {
+		resp.Items = append(resp.Items, mapper.ToItemSalesResponse(i))
+	}
+
+	return resp, nil
+}
+
+// periodReportFilter checks the requested period, a month when none is
+// given, and turns the request into a report filter.
+func periodReportFilter(req dto.PeriodReportRequest) (string, domain.ReportFilter, error) {
+	period := strings.ToLower(strings.TrimSpace(req.Period))
+	if period == "" {
+		period = domain.ReportPeriodMonth
+	}
+	if !domain.IsValidReportPeriod(period) {
+		return "", domain.ReportFilter{}, utils.ErrInvalidReportPeriod
+	}
+
+	return period, domain.ReportFilter{
+		From:     req.From,
+		To:       req.To,
+		Currency: strings.ToUpper(strings.TrimSpace(req.Currency)),
+	}, nil
+}
+
+// topSalesFilter ranks in the base currency unless another one is asked
+// for, since amounts in different currencies can't be compared.
+func (s *reportService) topSalesFilter(ctx context.Context, req dto.TopSalesRequest) (domain.ReportFilter, int) {
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if currency == "" {
+		currency = s.rates.BaseCurrency(ctx)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultTopSalesLimit
+	}
+	if limit > maxTopSalesLimit {
+		limit = maxTopSalesLimit
+	}
+
+	return domain.ReportFilter{From: req.From, To: req.To, Currency: currency}, limit
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportService_GetAgingReport(t *testing.T) {
+	asOf := time.Date(2025, 6, 30, 0, 0, 0, 0, time.Local)
+	afternoon := asOf.Add(15 * time.Hour)
+	customerID := uint(2)
+
+	tests := []struct {
+		name        string
+		req         dto.AgingReportRequest
+		filter      domain.AgingFilter
+		rows        []domain.AgingRow
+		repoErr     error
+		expectError bool
+		expected    dto.AgingReportResponse
+	}{
+		{
+			name:   "totals per currency",
+			req:    dto.AgingReportRequest{AsOf: &asOf},
+			filter: domain.AgingFilter{AsOf: asOf},
+			rows: []domain.AgingRow{
+				{CustomerID: 1, CustomerName: "Alice", Currency: "IDR", Current: domain.NewMoney(100), Days31To60: domain.NewMoney(50)},
+				{CustomerID: 2, CustomerName: "Bob", Currency: "USD", DaysOver90: domain.NewMoney(7)},
+				{CustomerID: 2, CustomerName: "Bob", Currency: "IDR", Days1To30: domain.NewMoney(25), Current: domain.NewMoney(5)},
+			},
+			expected: dto.AgingReportResponse{
+				AsOf: asOf,
+				Customers: []dto.AgingCustomerResponse{
+					{CustomerID: 1, CustomerName: "Alice", Currency: "IDR", AgingBucketsResponse: dto.AgingBucketsResponse{Current: domain.NewMoney(100), Days31To60: domain.NewMoney(50), Total: domain.NewMoney(150)}},
+					{CustomerID: 2, CustomerName: "Bob", Currency: "USD", AgingBucketsResponse: dto.AgingBucketsResponse{DaysOver90: domain.NewMoney(7), Total: domain.NewMoney(7)}},
+					{CustomerID: 2, CustomerName: "Bob", Currency: "IDR", AgingBucketsResponse: dto.AgingBucketsResponse{Current: domain.NewMoney(5), Days1To30: domain.NewMoney(25), Total: domain.NewMoney(30)}},
+				},
+				Totals: []dto.AgingTotalResponse{
+					{Currency: "IDR", AgingBucketsResponse: dto.AgingBucketsResponse{Current: domain.NewMoney(105), Days1To30: domain.NewMoney(25), Days31To60: domain.NewMoney(50), Total: domain.NewMoney(180)}},
+					{Currency: "USD", AgingBucketsResponse: dto.AgingBucketsResponse{DaysOver90: domain.NewMoney(7), Total: domain.NewMoney(7)}},
+				},
+			},
+		},
+		{
+			name:   "as-of time and customer filter",
+			req:    dto.AgingReportRequest{AsOf: &afternoon, CustomerID: &customerID},
+			filter: domain.AgingFilter{AsOf: asOf, CustomerID: &customerID},
+			rows:   []domain.AgingRow{},
+			expected: dto.AgingReportResponse{
+				AsOf:      asOf,
+				Customers: []dto.AgingCustomerResponse{},
+				Totals:    []dto.AgingTotalResponse{},
+			},
+		},
+		{
+			name:        "repository error",
+			req:         dto.AgingReportRequest{AsOf: &asOf},
+			filter:      domain.AgingFilter{AsOf: asOf},
+			rows:        []domain.AgingRow{},
+			repoErr:     errors.New("database connection error"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportRepo := &MockReportRepository{}
+			reportRepo.On("GetAgingRows", tt.filter).Return(tt.rows, tt.repoErr)
+
+			s := NewReportService(reportRepo, NewExchangeRateService(&MockExchangeRateRepository{}, "IDR"))
+
+			resp, err := s.GetAgingReport(context.Background(), tt.req)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, resp)
+			}
+			reportRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReportService_ExportAgingReportCSV(t *testing.T) {
+	asOf := time.Date(2025, 6, 30, 0, 0, 0, 0, time.Local)
+
+	reportRepo := &MockReportRepository{}
+	reportRepo.On("GetAgingRows", domain.AgingFilter{AsOf: asOf}).Return([]domain.AgingRow{
+		{CustomerID: 1, CustomerName: "Doe, Jane", Currency: "IDR", Current: domain.MustParseMoney("100.50"), DaysOver90: domain.NewMoney(20)},
+	}, nil)
+
+	s := NewReportService(reportRepo, NewExchangeRateService(&MockExchangeRateRepository{}, "IDR"))
+
+	doc, err := s.ExportAgingReportCSV(context.Background(), dto.AgingReportRequest{AsOf: &asOf})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "aging-2025-06-30.csv", doc.FileName)
+	assert.Equal(t, "text/csv; charset=utf-8", doc.ContentType)
+	assert.Equal(t, "customer_id,customer_name,currency,current,days_1_30,days_31_60,days_61_90,days_over_90,total\n"+
+		"1,\"Doe, Jane\",IDR,100.50,0.00,0.00,0.00,20.00,120.50\n"+
+		",Total,IDR,100.50,0.00,0.00,0.00,20.00,120.50\n", string(doc.Content))
+}
+
+func TestReportService_GetRevenueReport(t *testing.T) {
+	june := time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		req         dto.PeriodReportRequest
+		setupMock   func(*MockReportRepository)
+		expectError error
+		expected    dto.RevenueReportResponse
+	}{
+		{
+			name: "monthly by default",
+			req:  dto.PeriodReportRequest{Currency: " usd "},
+			setupMock: func(m *MockReportRepository) {
+				m.On("GetRevenueByPeriod", domain.ReportFilter{Currency: "USD"}, domain.ReportPeriodMonth).Return([]domain.RevenueTotal{
+					{PeriodStart: june, Currency: "USD", InvoiceCount: 3, Billed: domain.NewMoney(300), Paid: domain.NewMoney(120), Credited: domain.NewMoney(30)},
+				}, nil)
+			},
+			expected: dto.RevenueReportResponse{
+				Period: domain.ReportPeriodMonth,
+				Periods: []dto.RevenuePeriodResponse{
+					{PeriodStart: june, Currency: "USD", InvoiceCount: 3, Billed: domain.NewMoney(300), Paid: domain.NewMoney(120), Credited: domain.NewMoney(30), Unpaid: domain.NewMoney(150)},
+				},
+			},
+		},
+		{
+			name: "weekly",
+			req:  dto.PeriodReportRequest{Period: "Week"},
+			setupMock: func(m *MockReportRepository) {
+				m.On("GetRevenueByPeriod", domain.ReportFilter{}, domain.ReportPeriodWeek).Return([]domain.RevenueTotal{}, nil)
+			},
+			expected: dto.RevenueReportResponse{Period: domain.ReportPeriodWeek, Periods: []dto.RevenuePeriodResponse{}},
+		},
+		{
+			name:        "unknown period",
+			req:         dto.PeriodReportRequest{Period: "quarter"},
+			setupMock:   func(m *MockReportRepository) {},
+			expectError: utils.ErrInvalidReportPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportRepo := &MockReportRepository{}
+			tt.setupMock(reportRepo)
+
+			s := NewReportService(reportRepo, NewExchangeRateService(&MockExchangeRateRepository{}, "IDR"))
+
+			resp, err := s.GetRevenueReport(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectError, err)
+			if tt.expectError == nil {
+				assert.Equal(t, tt.expected, resp)
+			}
+			reportRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReportService_GetTopSales(t *testing.T) {
+	reportRepo := &MockReportRepository{}
+	reportRepo.On("GetTopCustomers", domain.ReportFilter{Currency: "IDR"}, 10).Return([]domain.CustomerSales{
+		{CustomerID: 4, CustomerName: "Alice", Currency: "IDR", InvoiceCount: 2, Billed: domain.NewMoney(900)},
+	}, nil)
+	reportRepo.On("GetTopItems", domain.ReportFilter{Currency: "USD"}, domain.ItemSalesByQuantity, 100).Return([]domain.ItemSales{
+		{ItemID: 1, ItemName: "Consulting", Currency: "USD", Quantity: 12, Revenue: domain.NewMoney(3000)},
+	}, nil)
+
+	s := NewReportService(reportRepo, NewExchangeRateService(&MockExchangeRateRepository{}, "IDR"))
+
+	// ranked in the base currency unless asked otherwise
+	customers, err := s.GetTopCustomers(context.Background(), dto.TopSalesRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, dto.TopCustomersResponse{
+		Currency:  "IDR",
+		Customers: []dto.CustomerSalesResponse{{CustomerID: 4, CustomerName: "Alice", InvoiceCount: 2, Billed: domain.NewMoney(900)}},
+	}, customers)
+
+	items, err := s.GetTopItems(context.Background(), dto.TopSalesRequest{Currency: "usd", SortBy: "quantity", Limit: 500})
+	assert.NoError(t, err)
+	assert.Equal(t, dto.TopItemsResponse{
+		Currency: "USD",
+		SortBy:   domain.ItemSalesByQuantity,
+		Items:    []dto.ItemSalesResponse{{ItemID: 1, ItemName: "Consulting", Quantity: 12, Revenue: domain.NewMoney(3000)}},
+	}, items)
+
+	_, err = s.GetTopItems(context.Background(), dto.TopSalesRequest{SortBy: "margin"})
+	assert.Equal(t, utils.ErrInvalidSortField, err)
+
+	reportRepo.AssertExpectations(t)
+}
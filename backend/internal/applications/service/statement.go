@@ -0,0 +1,145 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"strings"
+	"time"
+)
+
+type statementService struct {
+	repo         repository.StatementRepository
+	customers    repository.CustomerRepository
+	renderer     services.StatementRenderer
+	baseCurrency string
+}
+
+func NewStatementService(repo repository.StatementRepository, customers repository.CustomerRepository, renderer services.StatementRenderer, baseCurrency string) services.StatementService {
+	return &statementService{repo: repo, customers: customers, renderer: renderer, baseCurrency: baseCurrency}
+}
+
+// GetCustomerStatement implements services.StatementService.
+func (s *statementService) GetCustomerStatement(ctx context.Context, customerID uint, req dto.StatementRequest) (dto.StatementResponse, error) {
+	statement, err := s.statement(ctx, customerID, req)
+	if err != nil {
+		return dto.StatementResponse{}, err
+	}
+
+	return mapper.ToStatementResponse(statement), nil
+}
+
+// ExportStatementCSV implements services.StatementService. The opening and
+// closing balances are the first and last lines.
+func (s *statementService) ExportStatementCSV(ctx context.Context, customerID uint, req dto.StatementRequest) (dto.DocumentResponse, error) {
+	statement, err := s.statement(ctx, customerID, req)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"date", "type", "reference", "invoice_number", "description", "debit", "credit", "balance"})
+	_ = w.Write([]string{statement.From.Format("2006-01-02"), "", "", "", "Opening balance", "", "", statement.OpeningBalance.String()})
+
+	for _, e := range statement.Entries {
+		_ = w.Write([]string{
+			e.Date.Format("2006-01-02"),
+			e.Type,
+			e.Reference,
+			e.InvoiceNumber,
+			e.Description,
+			e.Debit.String(),
+			e.Credit.String(),
+			e.Balance.String(),
+		})
+	}
+
+	_ = w.Write([]string{statement.To.Format("2006-01-02"), "", "", "", "Closing balance", statement.TotalDebits().String(), statement.TotalCredits().String(), statement.ClosingBalance().String()})
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to write statement: %w", err)
+	}
+
+	return dto.DocumentResponse{
+		FileName:    statementFileName(statement) + ".csv",
+		ContentType: "text/csv; charset=utf-8",
+		Content:     buf.Bytes(),
+	}, nil
+}
+
+// RenderStatementPDF implements services.StatementService.
+func (s *statementService) RenderStatementPDF(ctx context.Context, customerID uint, req dto.StatementRequest) (dto.DocumentResponse, error) {
+	statement, err := s.statement(ctx, customerID, req)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	content, err := s.renderer.RenderStatement(statement)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	return dto.DocumentResponse{
+		FileName:    statementFileName(statement) + ".pdf",
+		ContentType: "application/pdf",
+		Content:     content,
+	}, nil
+}
+
+// statement fills in the defaults of the request and loads the statement
+// of the customer.
+func (s *statementService) statement(ctx context.Context, customerID uint, req dto.StatementRequest) (domain.Statement, error) {
+	to := utils.DateOnly(time.Now())
+	if req.To != nil {
+		to = utils.DateOnly(*req.To)
+	}
+
+	from := to.AddDate(0, 0, 1-to.Day())
+	if req.From != nil {
+		from = utils.DateOnly(*req.From)
+	}
+
+	// a bad period is the caller's mistake whichever customer it asks for
+	if from.After(to) {
+		return domain.Statement{}, utils.ErrInvalidStatementPeriod
+	}
+
+	customer, err := s.customers.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		return domain.Statement{}, err
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	if currency == "" {
+		currency = customer.Currency
+	}
+	if currency == "" {
+		currency = s.baseCurrency
+	}
+
+	statement, err := s.repo.GetStatement(ctx, domain.StatementFilter{
+		CustomerID: customer.ID,
+		Currency:   currency,
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		return domain.Statement{}, err
+	}
+	statement.Customer = customer
+
+	return statement, nil
+}
+
+func statementFileName(s domain.Statement) string {
+	return fmt.Sprintf("statement-%d-%s-%s", s.Customer.ID, s.From.Format("20060102"), s.To.Format("20060102"))
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockStatementRepository adalah mock untuk StatementRepository
+type MockStatementRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatementRepository) GetStatement(ctx context.Context, filter domain.StatementFilter) (domain.Statement, error) {
+	args := m.Called(filter)
+	return args.Get(0).(domain.Statement), args.Error(1)
+}
+
+// MockStatementRenderer adalah mock untuk StatementRenderer
+type MockStatementRenderer struct {
+	mock.Mock
+}
+
+func (m *MockStatementRenderer) RenderStatement(statement domain.Statement) ([]byte, error) {
+	args := m.Called(statement)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func TestStatementService_GetCustomerStatement(t *testing.T) {
+	customer := domain.Customer{ID: 3, Name: "Alice", Currency: "USD"}
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2025, 5, 31, 0, 0, 0, 0, time.Local)
+	toAfternoon := to.Add(16 * time.Hour)
+	today := utils.DateOnly(time.Now())
+	late := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name        string
+		customerID  uint
+		req         dto.StatementRequest
+		setupMock   func(*MockStatementRepository, *MockCustomerRepository)
+		expectError error
+	}{
+		{
+			name:       "this month in the customer's currency by default",
+			customerID: 3,
+			setupMock: func(m *MockStatementRepository, c *MockCustomerRepository) {
+				c.On("GetCustomerByID", uint(3)).Return(customer, nil)
+				m.On("GetStatement", domain.StatementFilter{CustomerID: 3, Currency: "USD", From: today.AddDate(0, 0, 1-today.Day()), To: today}).
+					Return(domain.Statement{Currency: "USD"}, nil)
+			},
+		},
+		{
+			name:       "requested range and currency",
+			customerID: 3,
+			req:        dto.StatementRequest{From: &from, To: &toAfternoon, Currency: "idr"},
+			setupMock: func(m *MockStatementRepository, c *MockCustomerRepository) {
+				c.On("GetCustomerByID", uint(3)).Return(customer, nil)
+				m.On("GetStatement", domain.StatementFilter{CustomerID: 3, Currency: "IDR", From: from, To: to}).
+					Return(domain.Statement{Currency: "IDR"}, nil)
+			},
+		},
+		{
+			name:        "from after to",
+			customerID:  3,
+			req:         dto.StatementRequest{From: &late, To: &to},
+			setupMock:   func(m *MockStatementRepository, c *MockCustomerRepository) {},
+			expectError: utils.ErrInvalidStatementPeriod,
+		},
+		{
+			name:        "from after to of an unknown customer",
+			customerID:  99,
+			req:         dto.StatementRequest{From: &late, To: &to},
+			setupMock:   func(m *MockStatementRepository, c *MockCustomerRepository) {},
+			expectError: utils.ErrInvalidStatementPeriod,
+		},
+		{
+			name:       "unknown customer",
+			customerID: 99,
+			setupMock: func(m *MockStatementRepository, c *MockCustomerRepository) {
+				c.On("GetCustomerByID", uint(99)).Return(domain.Customer{}, utils.ErrCustomerNotFound)
+			},
+			expectError: utils.ErrCustomerNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockStatementRepository{}
+			customers := &MockCustomerRepository{}
+			tt.setupMock(repo, customers)
+
+			s := NewStatementService(repo, customers, &MockStatementRenderer{}, "IDR")
+
+			resp, err := s.GetCustomerStatement(context.Background(), tt.customerID, tt.req)
+
+			assert.Equal(t, tt.expectError, err)
+			if tt.expectError == nil {
+				assert.Equal(t, "Alice", resp.Customer.Name)
+				assert.NotNil(t, resp.Entries)
+			}
+			repo.AssertExpectations(t)
+			customers.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStatementService_Export(t *testing.T) {
+	customer := domain.Customer{ID: 3, Name: "Alice", Currency: "IDR"}
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2025, 5, 31, 0, 0, 0, 0, time.Local)
+
+	statement := domain.Statement{
+		Currency:       "IDR",
+		From:           from,
+		To:             to,
+		OpeningBalance: domain.NewMoney(50),
+		Entries: []domain.StatementEntry{
+			{Type: domain.StatementEntryInvoice, ID: 2, Date: from.AddDate(0, 0, 2), Reference: "INV-2", Description: "Retainer, May", InvoiceID: 2, InvoiceNumber: "INV-2", Debit: domain.NewMoney(300), Balance: domain.NewMoney(350)},
+			{Type: domain.StatementEntryPayment, ID: 9, Date: from.AddDate(0, 0, 9), Reference: "TRX-1", Description: "Payment by bank_transfer", InvoiceID: 2, InvoiceNumber: "INV-2", Credit: domain.NewMoney(100), Balance: domain.NewMoney(250)},
+		},
+	}
+	withCustomer := statement
+	withCustomer.Customer = customer
+
+	repo := &MockStatementRepository{}
+	repo.On("GetStatement", domain.StatementFilter{CustomerID: 3, Currency: "IDR", From: from, To: to}).Return(statement, nil)
+	customers := &MockCustomerRepository{}
+	customers.On("GetCustomerByID", uint(3)).Return(customer, nil)
+	renderer := &MockStatementRenderer{}
+	renderer.On("RenderStatement", withCustomer).Return([]byte("%PDF-1.3"), nil)
+
+	s := NewStatementService(repo, customers, renderer, "IDR")
+	req := dto.StatementRequest{From: &from, To: &to}
+
+	doc, err := s.ExportStatementCSV(context.Background(), 3, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "statement-3-20250501-20250531.csv", doc.FileName)
+	assert.Equal(t, "date,type,reference,invoice_number,description,debit,credit,balance\n"+
+		"2025-05-01,,,,Opening balance,,,50.00\n"+
+		"2025-05-03,invoice,INV-2,INV-2,\"Retainer, May\",300.00,0.00,350.00\n"+
+		"2025-05-10,payment,TRX-1,INV-2,Payment by bank_transfer,0.00,100.00,250.00\n"+
+		"2025-05-31,,,,Closing balance,300.00,100.00,250.00\n", string(doc.Content))
+
+	doc, err = s.RenderStatementPDF(context.Background(), 3, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "statement-3-20250501-20250531.pdf", doc.FileName)
+	assert.Equal(t, "application/pdf", doc.ContentType)
+	renderer.AssertExpectations(t)
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+)
+
+type taxService struct {
+	repo repository.TaxRateRepository
+}
+
+func NewTaxService(repo repository.TaxRateRepository) services.TaxService {
+	return &taxService{repo: repo}
+}
+
+// GetAllTaxRates implements services.TaxService.
+func (s *taxService) GetAllTaxRates(ctx context.Context) ([]dto.TaxRateResponse, error) {
+	rates, err := s.repo.GetAllTaxRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToTaxRateResponseList(rates), nil
+}
+
+// GetTaxRateByID implements services.TaxService.
+func (s *taxService) GetTaxRateByID(ctx context.Context, id uint) (dto.TaxRateResponse, error) {
+	rate, err := s.repo.GetTaxRateByID(ctx, id)
+	if err != nil {
+		return dto.TaxRateResponse{}, err
+	}
+
+	return mapper.ToTaxRateResponse(rate), nil
+}
+
+// CreateTaxRate implements services.TaxService.
+func (s *taxService) CreateTaxRate(ctx context.Context, req dto.TaxRateRequest) (dto.TaxRateResponse, error) {
+	rate := mapper.ToDomainTaxRate(req)
+
+	if err := s.repo.CreateTaxRate(ctx, &rate); err != nil {
+		return dto.TaxRateResponse{}, err
+	}
+
+	return mapper.ToTaxRateResponse(rate), nil
+}
+
+// UpdateTaxRate implements services.TaxService.
+func (s *taxService) UpdateTaxRate(ctx context.Context, id uint, req dto.TaxRateRequest) error {
+	return s.repo.UpdateTaxRate(ctx, id, mapper.ToDomainTaxRate(req))
+}
+
+// ApplyTaxes resolves the tax rate of every line and fills in its tax fields.
+// The rate is picked in this order: the rate set on the line itself, the
+// customer's override, the item's rate and finally the default rate.
+func (s *taxService) ApplyTaxes(ctx context.Context, customerID uint, items []domain.InvoiceItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	customerRate, err := s.repo.GetCustomerTaxRate(ctx, customerID)
+	if err != nil {
+		return err
+	}
+
+	itemIDs := make([]uint, 0, len(items))
+	for _, it := range items {
+		itemIDs = append(itemIDs, it.ItemID)
+	}
+
+	itemRates, err := s.repo.GetItemTaxRates(ctx, itemIDs)
+	if err != nil {
+		return err
+	}
+
+	var defaultRate *domain.TaxRate
+	lineRates := make(map[uint]domain.TaxRate)
+
+	for idx := range items {
+		var rate domain.TaxRate
+
+		switch {
+		case items[idx].TaxRateID != nil:
+			id := *items[idx].TaxRateID
+			cached, ok := lineRates[id]
+			if !ok {
+				cached, err = s.repo.GetTaxRateByID(ctx, id)
+				if err != nil {
+					return err
+				}
+				lineRates[id] = cached
+			}
+			rate = cached
+		case customerRate != nil:
+			rate = *customerRate
+		default:
+			itemRate, ok := itemRates[items[idx].ItemID]
+			if ok {
+				rate = itemRate
+				break
+			}
+
+			if defaultRate == nil {
+				d, err := s.repo.GetDefaultTaxRate(ctx)
+				if err != nil {
+					return err
+				}
+				defaultRate = &d
+			}
+			rate = *defaultRate
+		}
+
+		rateID := rate.ID
+		items[idx].TaxRateID = &rateID
+		items[idx].TaxCode = rate.Code
+		items[idx].TaxRate = rate.Rate
+		items[idx].TaxAmount = rate.Apply(items[idx].TotalPrice)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTaxRateRepository adalah mock untuk TaxRateRepository
+type MockTaxRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaxRateRepository) GetAllTaxRates(ctx context.Context) ([]domain.TaxRate, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.TaxRate), args.Error(1)
+}
+
+func (m *MockTaxRateRepository) GetTaxRateByID(ctx context.Context, id uint) (domain.TaxRate, error) {
+	args := m.Called(id)
+	return args.Get(0).(domain.TaxRate), args.Error(1)
+}
+
+func (m *MockTaxRateRepository) GetDefaultTaxRate(ctx context.Context) (domain.TaxRate, error) {
+	args := m.Called()
+	return args.Get(0).(domain.TaxRate), args.Error(1)
+}
+
+func (m *MockTaxRateRepository) CreateTaxRate(ctx context.Context, rate *domain.TaxRate) error {
+	args := m.Called(rate)
+	return args.Error(0)
+}
+
+func (m *MockTaxRateRepository) UpdateTaxRate(ctx context.Context, id uint, rate domain.TaxRate) error {
+	args := m.Called(id, rate)
+	return args.Error(0)
+}
+
+func (m *MockTaxRateRepository) GetCustomerTaxRate(ctx context.Context, customerID uint) (*domain.TaxRate, error) {
+	args := m.Called(customerID)
+	return args.Get(0).(*domain.TaxRate), args.Error(1)
+}
+
+func (m *MockTaxRateRepository) GetItemTaxRates(ctx context.Context, itemIDs []uint) (map[uint]domain.TaxRate, error) {
+	args := m.Called(itemIDs)
+	return args.Get(0).(map[uint]domain.TaxRate), args.Error(1)
+}
+
+var (
+	standardRate = domain.TaxRate{ID: 1, Code: "VAT10", Type: domain.TaxTypeStandard, Rate: 10, IsDefault: true, IsActive: true}
+	reducedRate  = domain.TaxRate{ID: 2, Code: "VAT5", Type: domain.TaxTypeReduced, Rate: 5, IsActive: true}
+	exemptRate   = domain.TaxRate{ID: 3, Code: "EXEMPT", Type: domain.TaxTypeExempt, IsActive: true}
+)
+
+// newDefaultTaxRepo returns a tax repository where every line falls back to
+// the standard 10% default rate.
+func newDefaultTaxRepo() *MockTaxRateRepository {
+	m := &MockTaxRateRepository{}
+	m.On("GetCustomerTaxRate", mock.Anything).Return((*domain.TaxRate)(nil), nil)
+	m.On("GetItemTaxRates", mock.Anything).Return(map[uint]domain.TaxRate{}, nil)
+	m.On("GetDefaultTaxRate").Return(standardRate, nil)
+	return m
+}
+
+func TestTaxService_ApplyTaxes(t *testing.T) {
+	lineRate := uint(2)
+
+	tests := []struct {
+		name         string
+		items        []domain.InvoiceItem
+		setupMock    func(*MockTaxRateRepository)
+		expectError  bool
+		expectedTax  []domain.Money
+		expectedCode []string
+	}{
+		{
+			name:  "default rate applied when no override",
+			items: []domain.InvoiceItem{{ItemID: 1, TotalPrice: domain.NewMoney(450)}},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("GetCustomerTaxRate", uint(1)).Return((*domain.TaxRate)(nil), nil)
+				m.On("GetItemTaxRates", []uint{1}).Return(map[uint]domain.TaxRate{}, nil)
+				m.On("GetDefaultTaxRate").Return(standardRate, nil)
+			},
+			expectedTax:  []domain.Money{domain.NewMoney(45)},
+			expectedCode: []string{"VAT10"},
+		},
+		{
+			name:  "item rate overrides default",
+			items: []domain.InvoiceItem{{ItemID: 1, TotalPrice: domain.NewMoney(100)}, {ItemID: 2, TotalPrice: domain.NewMoney(100)}},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("GetCustomerTaxRate", uint(1)).Return((*domain.TaxRate)(nil), nil)
+				m.On("GetItemTaxRates", []uint{1, 2}).Return(map[uint]domain.TaxRate{2: reducedRate}, nil)
+				m.On("GetDefaultTaxRate").Return(standardRate, nil)
+			},
+			expectedTax:  []domain.Money{domain.NewMoney(10), domain.NewMoney(5)},
+			expectedCode: []string{"VAT10", "VAT5"},
+		},
+		{
+			name:  "customer rate overrides item rate",
+			items: []domain.InvoiceItem{{ItemID: 2, TotalPrice: domain.NewMoney(100)}},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("GetCustomerTaxRate", uint(1)).Return(&exemptRate, nil)
+				m.On("GetItemTaxRates", []uint{2}).Return(map[uint]domain.TaxRate{2: reducedRate}, nil)
+			},
+			expectedTax:  []domain.Money{domain.NewMoney(0)},
+			expectedCode: []string{"EXEMPT"},
+		},
+		{
+			name:  "line rate overrides customer rate",
+			items: []domain.InvoiceItem{{ItemID: 1, TotalPrice: domain.NewMoney(100), TaxRateID: &lineRate}},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("GetCustomerTaxRate", uint(1)).Return(&exemptRate, nil)
+				m.On("GetItemTaxRates", []uint{1}).Return(map[uint]domain.TaxRate{}, nil)
+				m.On("GetTaxRateByID", uint(2)).Return(reducedRate, nil)
+			},
+			expectedTax:  []domain.Money{domain.NewMoney(5)},
+			expectedCode: []string{"VAT5"},
+		},
+		{
+			name:  "unknown line rate",
+			items: []domain.InvoiceItem{{ItemID: 1, TotalPrice: domain.NewMoney(100), TaxRateID: &lineRate}},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("GetCustomerTaxRate", uint(1)).Return((*domain.TaxRate)(nil), nil)
+				m.On("GetItemTaxRates", []uint{1}).Return(map[uint]domain.TaxRate{}, nil)
+				m.On("GetTaxRateByID", uint(2)).Return(domain.TaxRate{}, utils.ErrTaxRateNotFound)
+			},
+			expectError: true,
+		},
+		{
+			name:  "missing default rate",
+			items: []domain.InvoiceItem{{ItemID: 1, TotalPrice: domain.NewMoney(100)}},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("GetCustomerTaxRate", uint(1)).Return((*domain.TaxRate)(nil), nil)
+				m.On("GetItemTaxRates", []uint{1}).Return(map[uint]domain.TaxRate{}, nil)
+				m.On("GetDefaultTaxRate").Return(domain.TaxRate{}, utils.ErrDefaultTaxRateMissing)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockTaxRateRepository{}
+			tt.setupMock(mockRepo)
+
+			taxService := NewTaxService(mockRepo)
+
+			err := taxService.ApplyTaxes(context.Background(), 1, tt.items)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				for i, item := range tt.items {
+					assert.Equal(t, tt.expectedTax[i], item.TaxAmount)
+					assert.Equal(t, tt.expectedCode[i], item.TaxCode)
+					assert.NotNil(t, item.TaxRateID)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaxService_CreateTaxRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     dto.TaxRateRequest
+		setupMock   func(*MockTaxRateRepository)
+		expectError bool
+	}{
+		{
+			name:    "successful creation",
+			request: dto.TaxRateRequest{Code: "VAT11", Name: "PPN 11%", Type: domain.TaxTypeStandard, Rate: 11},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("CreateTaxRate", mock.MatchedBy(func(r *domain.TaxRate) bool {
+					return r.Code == "VAT11" && r.Rate == 11 && r.IsActive
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "duplicate code",
+			request: dto.TaxRateRequest{Code: "VAT10", Name: "PPN 10%", Type: domain.TaxTypeStandard, Rate: 10},
+			setupMock: func(m *MockTaxRateRepository) {
+				m.On("CreateTaxRate", mock.AnythingOfType("*domain.TaxRate")).Return(utils.ErrTaxRateAlreadyExists)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockTaxRateRepository{}
+			tt.setupMock(mockRepo)
+
+			taxService := NewTaxService(mockRepo)
+
+			result, err := taxService.CreateTaxRate(context.Background(), tt.request)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.request.Code, result.Code)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaxService_GetAllTaxRates(t *testing.T) {
+	mockRepo := &MockTaxRateRepository{}
+	mockRepo.On("GetAllTaxRates").Return([]domain.TaxRate{}, errors.New("database connection error"))
+
+	taxService := NewTaxService(mockRepo)
+
+	result, err := taxService.GetAllTaxRates(context.Background())
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// minPasswordLength is the shortest password a user is given.
+const minPasswordLength = 8
+
+type userService struct {
+	repo repository.UserRepository
+}
+
+func NewUserService(repo repository.UserRepository) services.UserService {
+	return &userService{repo: repo}
+}
+
+// GetAllUsers implements services.UserService.
+func (s *userService) GetAllUsers(ctx context.Context) ([]dto.UserResponse, error) {
+	users, err := s.repo.GetAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ToUserResponses(users), nil
+}
+
+// CreateUser implements services.UserService.
+func (s *userService) CreateUser(ctx context.Context, req dto.CreateUserRequest) (dto.UserResponse, error) {
+	role := strings.ToLower(strings.TrimSpace(req.Role))
+	if !domain.IsValidRole(role) {
+		return dto.UserResponse{}, utils.ErrInvalidRole
+	}
+
+	hash, err := hashPassword(req.Password)
+	if err != nil {
+		return dto.UserResponse{}, err
+	}
+
+	user := domain.User{
+		Name:         strings.TrimSpace(req.Name),
+		Email:        domain.NormalizeEmail(req.Email),
+		PasswordHash: hash,
+		Role:         role,
+		IsActive:     true,
+	}
+	if err := s.repo.CreateUser(ctx, &user); err != nil {
+		return dto.UserResponse{}, err
+	}
+
+	return mapper.ToUserResponse(user), nil
+}
+
+// UpdateUser implements services.UserService.
+func (s *userService) UpdateUser(ctx context.Context, id uint, req dto.UpdateUserRequest) (dto.UserResponse, error) {
+	user, err := s.repo.GetUserByID(ctx, id)
+	if err != nil {
+		return dto.UserResponse{}, err
+	}
+	wasActiveAdmin := user.Role == domain.RoleAdmin && user.IsActive
+
+	if req.Name != nil {
+		user.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Role != nil {
+		role := strings.ToLower(strings.TrimSpace(*req.Role))
+		if !domain.IsValidRole(role) {
+			return dto.UserResponse{}, utils.ErrInvalidRole
+		}
+		user.Role = role
+	}
+	if req.IsActive != nil {
+		user.IsActive = *req.IsActive
+	}
+	if req.Password != nil {
+		hash, err := hashPassword(*req.Password)
+		if err != nil {
+			return dto.UserResponse{}, err
+		}
+		user.PasswordHash = hash
+	}
+
+	if wasActiveAdmin && (user.Role != domain.RoleAdmin || !user.IsActive) {
+		admins, err := s.repo.CountActiveAdmins(ctx)
+		if err != nil {
+			return dto.UserResponse{}, err
+		}
+		if admins <= 1 {
+			return dto.UserResponse{}, utils.ErrLastAdmin
+		}
+	}
+
+	if err := s.repo.UpdateUser(ctx, user); err != nil {
+		return dto.UserResponse{}, err
+	}
+
+	return mapper.ToUserResponse(user), nil
+}
+
+// hashPassword checks the length of a new password and returns its bcrypt
+// hash.
+func hashPassword(password string) (string, error) {
+	if len(password) < minPasswordLength {
+		return "", utils.ErrPasswordTooShort
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return string(hash), nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserService_CreateUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         dto.CreateUserRequest
+		expectError error
+	}{
+		{
+			name: "clerk",
+			req:  dto.CreateUserRequest{Name: "Clerk", Email: "Clerk@Example.com", Password: "s3cret-pass", Role: "Clerk"},
+		},
+		{
+			name:        "unknown role",
+			req:         dto.CreateUserRequest{Name: "Owner", Email: "owner@example.com", Password: "s3cret-pass", Role: "owner"},
+			expectError: utils.ErrInvalidRole,
+		},
+		{
+			name:        "short password",
+			req:         dto.CreateUserRequest{Name: "Clerk", Email: "clerk@example.com", Password: "short", Role: "clerk"},
+			expectError: utils.ErrPasswordTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &MockUserRepository{}
+			if tt.expectError == nil {
+				users.On("CreateUser", mock.MatchedBy(func(u *domain.User) bool {
+					return u.Email == "clerk@example.com" && u.Role == domain.RoleClerk && u.IsActive && u.PasswordHash != "s3cret-pass"
+				})).Return(nil)
+			}
+
+			resp, err := NewUserService(users).CreateUser(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectError, err)
+			if tt.expectError == nil {
+				assert.Equal(t, domain.RoleClerk, resp.Role)
+				assert.Contains(t, resp.Permissions, string(domain.PermInvoicesWrite))
+				assert.NotContains(t, resp.Permissions, string(domain.PermInvoicesVoid))
+			}
+			users.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserService_UpdateUser(t *testing.T) {
+	admin := domain.User{ID: 1, Name: "Admin", Role: domain.RoleAdmin, IsActive: true}
+	clerk := domain.User{ID: 2, Name: "Clerk", Role: domain.RoleClerk, IsActive: true}
+	accountant := domain.RoleAccountant
+	inactive := false
+
+	tests := []struct {
+		name        string
+		user        domain.User
+		req         dto.UpdateUserRequest
+		admins      int64
+		expectError error
+		expected    domain.User
+	}{
+		{
+			name:     "promote clerk",
+			user:     clerk,
+			req:      dto.UpdateUserRequest{Role: &accountant},
+			expected: domain.User{ID: 2, Name: "Clerk", Role: domain.RoleAccountant, IsActive: true},
+		},
+		{
+			name:     "demote one of several admins",
+			user:     admin,
+			req:      dto.UpdateUserRequest{Role: &accountant},
+			admins:   2,
+			expected: domain.User{ID: 1, Name: "Admin", Role: domain.RoleAccountant, IsActive: true},
+		},
+		{
+			name:        "demote the last admin",
+			user:        admin,
+			req:         dto.UpdateUserRequest{Role: &accountant},
+			admins:      1,
+			expectError: utils.ErrLastAdmin,
+		},
+		{
+			name:        "deactivate the last admin",
+			user:        admin,
+			req:         dto.UpdateUserRequest{IsActive: &inactive},
+			admins:      1,
+			expectError: utils.ErrLastAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &MockUserRepository{}
+			users.On("GetUserByID", tt.user.ID).Return(tt.user, nil)
+			users.On("CountActiveAdmins").Return(tt.admins, nil).Maybe()
+			if tt.expectError == nil {
+				users.On("UpdateUser", tt.expected).Return(nil)
+			}
+
+			_, err := NewUserService(users).UpdateUser(context.Background(), tt.user.ID, tt.req)
+
+			assert.Equal(t, tt.expectError, err)
+			users.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package config
 
 import (
+	"errors"
+	"os"
+	"time"
+
 	"github.com/spf13/viper"
 )
 
@@ -20,19 +24,104 @@ type ServerConfig struct {
 	Port int
 }
 
+// CurrencyConfig holds the base currency reports are converted into.
+type CurrencyConfig struct {
+	Base string
+}
+
+// CompanyConfig holds the issuer details printed on invoice documents.
+type CompanyConfig struct {
+	Name    string
+	Address string
+	Email   string
+	Phone   string
+	TaxID   string `mapstructure:"tax_id"`
+}
+
+// NumberingSchemeConfig describes the numbers of one document series, see
+// domain.NumberingScheme for the pattern syntax. Reset is never, yearly or
+// monthly.
+type NumberingSchemeConfig struct {
+	Pattern string
+	Reset   string
+}
+
+// NumberingConfig holds the numbering scheme of each document series.
+type NumberingConfig struct {
+	Invoice    NumberingSchemeConfig
+	CreditNote NumberingSchemeConfig `mapstructure:"credit_note"`
+	Quote      NumberingSchemeConfig
+}
+
+// SchedulerConfig controls the background jobs run inside the server, such
+// as generating recurring invoices. Interval is how often due work is
+// checked for.
+type SchedulerConfig struct {
+	Enabled  bool
+	Interval time.Duration
+}
+
+// AuthConfig controls the tokens users authenticate with, which are signed
+// with AppConfig.Secret. AdminEmail and AdminPassword, when set, create the
+// first user of an installation that has none. The secret and the admin
+// credentials only come from the environment, see LoadConfig.
+type AuthConfig struct {
+	AccessTokenTTL  time.Duration `mapstructure:"access_token_ttl"`
+	RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl"`
+	AdminName       string        `mapstructure:"admin_name"`
+	AdminEmail      string        `mapstructure:"admin_email"`
+	AdminPassword   string        `mapstructure:"admin_password"`
+}
+
 type AppConfig struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	Secret   string
+	Database  DatabaseConfig
+	Server    ServerConfig
+	Currency  CurrencyConfig
+	Company   CompanyConfig
+	Numbering NumberingConfig
+	Scheduler SchedulerConfig
+	Auth      AuthConfig
+	Secret    string
 }
 
 var Config AppConfig
 
+// placeholderSecret is the token secret earlier versions shipped in
+// config.yaml. It is public, so tokens signed with it can be forged.
+const placeholderSecret = "dev-secret-change-me"
+
+// ValidateAuth reports whether the server can safely sign tokens and create
+// its first admin with c.
+func (c AppConfig) ValidateAuth() error {
+	if c.Secret == "" {
+		return errors.New("SECRET must be set to sign authentication tokens")
+	}
+	if c.Secret == placeholderSecret {
+		return errors.New("SECRET must not be the placeholder secret")
+	}
+	if c.Auth.AdminEmail != "" && c.Auth.AdminPassword == "" {
+		return errors.New("ADMIN_PASSWORD must be set together with ADMIN_EMAIL")
+	}
+
+	return nil
+}
+
 func LoadConfig(path string) error {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
 	viper.AddConfigPath(path)
 	viper.AutomaticEnv()
+	viper.SetDefault("currency.base", "IDR")
+	viper.SetDefault("numbering.invoice.pattern", "INV/{YYYY}/{MM}/{seq:5}")
+	viper.SetDefault("numbering.invoice.reset", "monthly")
+	viper.SetDefault("numbering.credit_note.pattern", "CN/{YYYY}/{seq:5}")
+	viper.SetDefault("numbering.credit_note.reset", "yearly")
+	viper.SetDefault("numbering.quote.pattern", "QUO/{YYYY}/{seq:5}")
+	viper.SetDefault("numbering.quote.reset", "yearly")
+	viper.SetDefault("scheduler.enabled", true)
+	viper.SetDefault("scheduler.interval", "15m")
+	viper.SetDefault("auth.access_token_ttl", "15m")
+	viper.SetDefault("auth.refresh_token_ttl", "168h")
 
 	if err := viper.ReadInConfig(); err != nil {
 		return err
@@ -44,5 +133,14 @@ func LoadConfig(path string) error {
 		return err
 	}
 
+	// secrets only come from the environment, never from the config file,
+	// which is committed
+	Config.Secret = os.Getenv("SECRET")
+	Config.Auth.AdminEmail = os.Getenv("ADMIN_EMAIL")
+	Config.Auth.AdminPassword = os.Getenv("ADMIN_PASSWORD")
+	if name := os.Getenv("ADMIN_NAME"); name != "" {
+		Config.Auth.AdminName = name
+	}
+
 	return nil
 }
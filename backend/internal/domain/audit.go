@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"reflect"
+	"time"
+)
+
+// Kinds of records the audit log follows.
+const (
+	AuditEntityInvoice  = "invoice"
+	AuditEntityCustomer = "customer"
+	AuditEntityItem     = "item"
+)
+
+// Things done to an audited record.
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+)
+
+// AuditEntry records one change to an invoice, customer or item: who made
+// it, when, and what each changed field was before and after.
+type AuditEntry struct {
+	ID         uint
+	EntityType string
+	EntityID   uint
+	Action     string
+	ActorID    *uint // nil for changes made by background jobs
+	ActorEmail string
+	Changes    map[string]AuditChange
+	CreatedAt  time.Time
+}
+
+// AuditChange is a field's value before and after a change. Before is nil
+// for a create and After for a delete.
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type AuditFilter struct {
+	EntityType string
+	EntityID   *uint
+
+	Limit int
+	Page  int
+}
+
+func IsValidAuditEntity(entity string) bool {
+	return entity == AuditEntityInvoice || entity == AuditEntityCustomer || entity == AuditEntityItem
+}
+
+// AuditDiff compares two snapshots of a record, field by field, and returns
+// the fields that differ. A nil snapshot stands for a record that doesn't
+// exist, so every field of the other one is reported.
+func AuditDiff(before, after map[string]interface{}) map[string]AuditChange {
+	changes := map[string]AuditChange{}
+
+	for field, old := range before {
+		if now, ok := after[field]; !ok || !reflect.DeepEqual(old, now) {
+			changes[field] = AuditChange{Before: old, After: now}
+		}
+	}
+	for field, now := range after {
+		if _, ok := before[field]; !ok {
+			changes[field] = AuditChange{After: now}
+		}
+	}
+
+	return changes
+}
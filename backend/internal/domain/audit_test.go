@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditDiff(t *testing.T) {
+	before := map[string]interface{}{
+		"subject": "Website",
+		"status":  "draft",
+		"items":   []interface{}{map[string]interface{}{"item_id": 1.0, "quantity": 2.0}},
+		"note":    "removed",
+	}
+	after := map[string]interface{}{
+		"subject": "Website",
+		"status":  "issued",
+		"items":   []interface{}{map[string]interface{}{"item_id": 1.0, "quantity": 3.0}},
+		"due":     "2025-11-01",
+	}
+
+	assert.Equal(t, map[string]AuditChange{
+		"status": {Before: "draft", After: "issued"},
+		"items": {
+			Before: []interface{}{map[string]interface{}{"item_id": 1.0, "quantity": 2.0}},
+			After:  []interface{}{map[string]interface{}{"item_id": 1.0, "quantity": 3.0}},
+		},
+		"note": {Before: "removed"},
+		"due":  {After: "2025-11-01"},
+	}, AuditDiff(before, after))
+}
+
+func TestAuditDiff_CreateAndDelete(t *testing.T) {
+	record := map[string]interface{}{"name": "Acme", "email": "billing@acme.test"}
+
+	assert.Equal(t, map[string]AuditChange{
+		"name":  {After: "Acme"},
+		"email": {After: "billing@acme.test"},
+	}, AuditDiff(nil, record))
+
+	assert.Equal(t, map[string]AuditChange{
+		"name":  {Before: "Acme"},
+		"email": {Before: "billing@acme.test"},
+	}, AuditDiff(record, nil))
+
+	assert.Empty(t, AuditDiff(record, record))
+}
@@ -0,0 +1,114 @@
+package domain
+
+import "time"
+
+const (
+	CreditNoteStatusDraft  = "draft"
+	CreditNoteStatusIssued = "issued"
+)
+
+// CreditNote reverses part of an issued invoice. Its lines usually point back
+// at the invoice lines they credit; once issued it reduces the balance due of
+// the invoice and can no longer be changed.
+type CreditNote struct {
+	ID               uint
+	CreditNoteNumber string
+	InvoiceID        uint
+	CustomerID       uint
+	IssueDate        time.Time
+	Reason           string
+	TotalItems       int
+	Subtotal         Money
+	Tax              Money
+	TotalAmount      Money
+	Currency         string
+	Status           string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+
+	Invoice  *Invoice
+	Customer *Customer
+	Items    []CreditNoteItem
+}
+
+// CreditNoteItem is one credited line. InvoiceItemID is nil for lines that
+// don't correspond to a line of the original invoice.
+type CreditNoteItem struct {
+	ID            uint
+	CreditNoteID  uint
+	InvoiceItemID *uint
+	ItemID        uint
+	ItemName      string
+	Type          string
+	Quantity      int
+	Unit          string
+	Price         Money
+	TotalPrice    Money
+	TaxRateID     *uint
+	TaxCode       string
+	TaxRate       float64
+	TaxAmount     Money
+	CreatedAt     time.Time
+}
+
+type CreditNoteFilter struct {
+	InvoiceID  *uint
+	CustomerID *uint
+	Status     string
+
+	Limit int
+	Page  int
+}
+
+func IsValidCreditNoteStatus(status string) bool {
+	return status == CreditNoteStatusDraft || status == CreditNoteStatusIssued
+}
+
+// IsEditable reports whether the credit note may still be changed or deleted.
+func (cn CreditNote) IsEditable() bool {
+	return cn.Status == CreditNoteStatusDraft
+}
+
+// CalculateTotals derives the totals from the lines the same way invoices do,
+// so the lines must have their tax applied first.
+func (cn *CreditNote) CalculateTotals() {
+	inv := cn.asInvoice()
+	inv.CalculateTotals()
+
+	cn.Subtotal = inv.Subtotal
+	cn.Tax = inv.Tax
+	cn.TotalAmount = inv.TotalAmount
+	cn.TotalItems = inv.TotalItems
+}
+
+// TaxBreakdown groups the line taxes by rate, like Invoice.TaxBreakdown.
+func (cn CreditNote) TaxBreakdown() []TaxBreakdown {
+	return cn.asInvoice().TaxBreakdown()
+}
+
+func (cn CreditNote) asInvoice() Invoice {
+	items := make([]InvoiceItem, len(cn.Items))
+	for i, it := range cn.Items {
+		items[i] = it.InvoiceItem()
+	}
+
+	return Invoice{Items: items}
+}
+
+// InvoiceItem returns the line in invoice form, which is what the tax
+// calculation works on.
+func (it CreditNoteItem) InvoiceItem() InvoiceItem {
+	return InvoiceItem{
+		ItemID:     it.ItemID,
+		ItemName:   it.ItemName,
+		Type:       it.Type,
+		Quantity:   it.Quantity,
+		Unit:       it.Unit,
+		Price:      it.Price,
+		TotalPrice: it.TotalPrice,
+		TaxRateID:  it.TaxRateID,
+		TaxCode:    it.TaxCode,
+		TaxRate:    it.TaxRate,
+		TaxAmount:  it.TaxAmount,
+	}
+}
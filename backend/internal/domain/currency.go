@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ExchangeRate is the value of one unit of Currency in BaseCurrency on
+// RateDate.
+type ExchangeRate struct {
+	ID           uint
+	BaseCurrency string
+	Currency     string
+	Rate         float64
+	RateDate     time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NormalizeCurrency upper-cases an ISO 4217 code and reports whether it has
+// the expected three-letter shape.
+func NormalizeCurrency(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return code, false
+	}
+
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return code, false
+		}
+	}
+
+	return code, true
+}
@@ -10,8 +10,52 @@ type Customer struct {
 	Email     string
 	Phone     string
 	Address   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	TaxRateID *uint
+	Currency  string
+	// PriceListID assigns a customer specific price list, see PriceList.
+	PriceListID *uint
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 
 	Invoices []Invoice
+	Stats    *CustomerStats
+}
+
+// CustomerStats summarises a customer's invoices. OutstandingBalance is what
+// is still owed on issued, partially paid and overdue invoices.
+type CustomerStats struct {
+	InvoiceCount       int64
+	OutstandingBalance Money
+}
+
+const (
+	CustomerSortName               = "name"
+	CustomerSortEmail              = "email"
+	CustomerSortCreatedAt          = "created_at"
+	CustomerSortInvoiceCount       = "invoice_count"
+	CustomerSortOutstandingBalance = "outstanding_balance"
+)
+
+type CustomerFilter struct {
+	// Search matches name, email or phone.
+	Search string
+	// SortBy is one of the CustomerSort constants, SortDesc reverses it.
+	SortBy   string
+	SortDesc bool
+	// WithStats adds the invoice aggregates to every customer. Sorting by an
+	// aggregate implies it.
+	WithStats bool
+
+	Limit int
+	Page  int
+}
+
+func IsValidCustomerSort(sortBy string) bool {
+	switch sortBy {
+	case CustomerSortName, CustomerSortEmail, CustomerSortCreatedAt,
+		CustomerSortInvoiceCount, CustomerSortOutstandingBalance:
+		return true
+	}
+
+	return false
 }
@@ -1,33 +1,37 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type Invoice struct {
-	ID            uint
-	InvoiceNumber string
-	IssueDate     time.Time
-	DueDate       time.Time
-	Subject       string
-	CustomerID    uint
-	TotalItems    int
-	Subtotal      float64
-	Tax           float64
-	TotalAmount   float64
-	Status        string
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID             uint
+	InvoiceNumber  string
+	IssueDate      time.Time
+	DueDate        time.Time
+	Subject        string
+	CustomerID     uint
+	TotalItems     int
+	Subtotal       Money
+	Tax            Money
+	TotalAmount    Money
+	AmountPaid     Money
+	AmountCredited Money
+	Currency       string
+	Status         string
+	QuoteID        *uint      // quote the invoice was converted from, if any
+	RecurringID    *uint      // recurring invoice that generated the invoice, if any
+	RecurringRun   int        // run of the recurring invoice, counted from 1
+	OverdueAt      *time.Time // when the invoice first went overdue
+	VoidedAt       *time.Time
+	Version        uint // raised by every change, so stale edits can be told apart
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 
 	Customer *Customer
 	Items    []InvoiceItem
-}
-
-type Pagination struct {
-	TotalItems  int64
-	TotalPages  int
-	CurrentPage int
-	PrevPage    *int
-	NextPage    *int
-	Limit       int
+	Payments []Payment
 }
 
 type InvoiceItem struct {
@@ -37,8 +41,13 @@ type InvoiceItem struct {
 	ItemName   string
 	Type       string
 	Quantity   int
-	Price      float64
-	TotalPrice float64
+	Unit       string
+	Price      Money
+	TotalPrice Money
+	TaxRateID  *uint
+	TaxCode    string
+	TaxRate    float64
+	TaxAmount  Money
 	CreatedAt  time.Time
 
 	Item    *Item
@@ -52,9 +61,59 @@ type InvoiceFilter struct {
 	CustomerName string
 	DueDate      *time.Time
 	Status       string
+	Currency     string
 	TotalItems   *int
 
+	// Overdue keeps the invoices still owed past their due date and
+	// DaysOverdueGte those at least that many days past it, both as of the
+	// day of AsOf.
+	Overdue        bool
+	DaysOverdueGte *int
+	AsOf           time.Time
+
 	Limit  int
 	Page   int
 	Cursor *time.Time
 }
+
+// CalculateTotals derives the invoice totals from its lines. The invoice tax is
+// the sum of the line taxes, so the lines must have their tax applied first.
+func (inv *Invoice) CalculateTotals() {
+	var subtotal, tax Money
+	for _, it := range inv.Items {
+		subtotal = subtotal.Add(it.TotalPrice)
+		tax = tax.Add(it.TaxAmount)
+	}
+
+	inv.Subtotal = subtotal
+	inv.Tax = tax
+	inv.TotalAmount = subtotal.Add(tax)
+	inv.TotalItems = len(inv.Items)
+}
+
+// TaxBreakdown groups the line taxes of the invoice by rate, in the order the
+// rates first appear on the invoice.
+func (inv Invoice) TaxBreakdown() []TaxBreakdown {
+	var breakdown []TaxBreakdown
+	index := make(map[string]int)
+
+	for _, it := range inv.Items {
+		key := fmt.Sprintf("%s|%v", it.TaxCode, it.TaxRate)
+
+		idx, ok := index[key]
+		if !ok {
+			idx = len(breakdown)
+			index[key] = idx
+			breakdown = append(breakdown, TaxBreakdown{
+				TaxRateID: it.TaxRateID,
+				Code:      it.TaxCode,
+				Rate:      it.TaxRate,
+			})
+		}
+
+		breakdown[idx].TaxableAmount = breakdown[idx].TaxableAmount.Add(it.TotalPrice)
+		breakdown[idx].TaxAmount = breakdown[idx].TaxAmount.Add(it.TaxAmount)
+	}
+
+	return breakdown
+}
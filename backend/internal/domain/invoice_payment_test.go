@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoice_ApplyPayment(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         string
+		amountPaid     Money
+		payment        Money
+		expectedStatus string
+		expectedPaid   Money
+		expectError    error
+	}{
+		{
+			name:           "partial payment on issued invoice",
+			status:         InvoiceStatusIssued,
+			payment:        NewMoney(40),
+			expectedStatus: InvoiceStatusPartiallyPaid,
+			expectedPaid:   NewMoney(40),
+		},
+		{
+			name:           "settling a partially paid invoice",
+			status:         InvoiceStatusPartiallyPaid,
+			amountPaid:     NewMoney(40),
+			payment:        NewMoney(70),
+			expectedStatus: InvoiceStatusPaid,
+			expectedPaid:   NewMoney(110),
+		},
+		{
+			name:           "partial payment keeps an overdue invoice overdue",
+			status:         InvoiceStatusOverdue,
+			payment:        MustParseMoney("0.01"),
+			expectedStatus: InvoiceStatusOverdue,
+			expectedPaid:   MustParseMoney("0.01"),
+		},
+		{
+			name:        "overpayment is rejected",
+			status:      InvoiceStatusPartiallyPaid,
+			amountPaid:  NewMoney(100),
+			payment:     MustParseMoney("10.01"),
+			expectError: ErrOverpayment,
+		},
+		{
+			name:        "draft is not payable",
+			status:      InvoiceStatusDraft,
+			payment:     NewMoney(10),
+			expectError: ErrInvoiceNotPayable,
+		},
+		{
+			name:        "zero payment",
+			status:      InvoiceStatusIssued,
+			payment:     Money{},
+			expectError: ErrNonPositivePayment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := Invoice{Status: tt.status, TotalAmount: NewMoney(110), AmountPaid: tt.amountPaid}
+
+			err := inv.ApplyPayment(tt.payment)
+
+			if tt.expectError != nil {
+				assert.Equal(t, tt.expectError, err)
+				assert.Equal(t, tt.status, inv.Status)
+				assert.Equal(t, tt.amountPaid, inv.AmountPaid)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, inv.Status)
+			assert.Equal(t, tt.expectedPaid, inv.AmountPaid)
+			assert.Equal(t, NewMoney(110).Sub(tt.expectedPaid), inv.BalanceDue())
+		})
+	}
+}
+
+func TestInvoice_ApplyCredit(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           string
+		amountPaid       Money
+		credit           Money
+		expectedStatus   string
+		expectedCredited Money
+		expectError      error
+	}{
+		{
+			name:             "partial credit keeps the status",
+			status:           InvoiceStatusIssued,
+			credit:           NewMoney(10),
+			expectedStatus:   InvoiceStatusIssued,
+			expectedCredited: NewMoney(10),
+		},
+		{
+			name:             "credit settling the balance marks the invoice paid",
+			status:           InvoiceStatusPartiallyPaid,
+			amountPaid:       NewMoney(60),
+			credit:           NewMoney(50),
+			expectedStatus:   InvoiceStatusPaid,
+			expectedCredited: NewMoney(50),
+		},
+		{
+			name:        "credit above the balance due",
+			status:      InvoiceStatusPartiallyPaid,
+			amountPaid:  NewMoney(60),
+			credit:      MustParseMoney("50.01"),
+			expectError: ErrOverCredit,
+		},
+		{
+			name:        "draft can't be credited",
+			status:      InvoiceStatusDraft,
+			credit:      NewMoney(10),
+			expectError: ErrInvoiceNotCreditable,
+		},
+		{
+			name:        "zero credit",
+			status:      InvoiceStatusIssued,
+			credit:      Money{},
+			expectError: ErrNonPositiveCredit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := Invoice{Status: tt.status, TotalAmount: NewMoney(110), AmountPaid: tt.amountPaid}
+
+			err := inv.ApplyCredit(tt.credit)
+
+			if tt.expectError != nil {
+				assert.Equal(t, tt.expectError, err)
+				assert.Equal(t, tt.status, inv.Status)
+				assert.True(t, inv.AmountCredited.IsZero())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, inv.Status)
+			assert.Equal(t, tt.expectedCredited, inv.AmountCredited)
+			assert.Equal(t, NewMoney(110).Sub(tt.amountPaid).Sub(tt.expectedCredited), inv.BalanceDue())
+		})
+	}
+}
+
+func TestInvoice_BalanceDue(t *testing.T) {
+	inv := Invoice{TotalAmount: NewMoney(110), AmountPaid: NewMoney(40), AmountCredited: NewMoney(10)}
+
+	inv.Status = InvoiceStatusOverdue
+	assert.Equal(t, NewMoney(60), inv.BalanceDue())
+
+	for _, status := range []string{InvoiceStatusVoid, InvoiceStatusCancelled} {
+		inv.Status = status
+		assert.True(t, inv.BalanceDue().IsZero(), status)
+	}
+}
@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	InvoiceStatusDraft         = "draft"
+	InvoiceStatusIssued        = "issued"
+	InvoiceStatusPartiallyPaid = "partially_paid"
+	InvoiceStatusPaid          = "paid"
+	InvoiceStatusOverdue       = "overdue"
+	InvoiceStatusVoid          = "void"
+	InvoiceStatusCancelled     = "cancelled"
+)
+
+var (
+	ErrInvoiceNotPayable  = errors.New("invoice does not accept payments in its current status")
+	ErrOverpayment        = errors.New("payment exceeds the balance due")
+	ErrNonPositivePayment = errors.New("payment amount must be greater than zero")
+
+	ErrInvoiceNotCreditable = errors.New("invoice does not accept credit notes in its current status")
+	ErrOverCredit           = errors.New("credit exceeds the balance due")
+	ErrNonPositiveCredit    = errors.New("credit amount must be greater than zero")
+)
+
+// invoiceTransitions lists, for every status, the statuses an invoice may move
+// to next. Paid, void and cancelled are final.
+var invoiceTransitions = map[string][]string{
+	InvoiceStatusDraft:         {InvoiceStatusIssued, InvoiceStatusCancelled},
+	InvoiceStatusIssued:        {InvoiceStatusPartiallyPaid, InvoiceStatusPaid, InvoiceStatusOverdue, InvoiceStatusVoid},
+	InvoiceStatusPartiallyPaid: {InvoiceStatusPaid, InvoiceStatusOverdue, InvoiceStatusVoid},
+	InvoiceStatusOverdue:       {InvoiceStatusPartiallyPaid, InvoiceStatusPaid, InvoiceStatusVoid},
+	InvoiceStatusPaid:          {},
+	InvoiceStatusVoid:          {},
+	InvoiceStatusCancelled:     {},
+}
+
+// NormalizeInvoiceStatus lower-cases a status and maps the older names onto
+// the current ones: "sent" and the legacy "unpaid" both mean issued.
+func NormalizeInvoiceStatus(status string) string {
+	status = strings.ToLower(strings.TrimSpace(status))
+
+	switch status {
+	case "sent", "unpaid":
+		return InvoiceStatusIssued
+	case "canceled":
+		return InvoiceStatusCancelled
+	}
+
+	return status
+}
+
+func IsValidInvoiceStatus(status string) bool {
+	_, ok := invoiceTransitions[status]
+	return ok
+}
+
+// CanTransitionInvoiceStatus reports whether an invoice in status from may be
+// moved to status to. Staying in the same status is always allowed.
+func CanTransitionInvoiceStatus(from, to string) bool {
+	if from == to {
+		return IsValidInvoiceStatus(from)
+	}
+
+	for _, next := range invoiceTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEditable reports whether the lines and header of the invoice may still be
+// changed. Only drafts are editable; once issued an invoice is locked.
+func (inv Invoice) IsEditable() bool {
+	return inv.Status == InvoiceStatusDraft
+}
+
+// OutstandingInvoiceStatuses returns the statuses of invoices the customer
+// still owes money on.
+func OutstandingInvoiceStatuses() []string {
+	return []string{InvoiceStatusIssued, InvoiceStatusPartiallyPaid, InvoiceStatusOverdue}
+}
+
+// IsPastDue reports whether the invoice is still owed and was due before the
+// day of date.
+func (inv Invoice) IsPastDue(date time.Time) bool {
+	return inv.DaysOverdue(date) > 0
+}
+
+// DaysOverdue returns how many days past its due date the invoice is on the
+// day of date. Invoices that are settled, not yet issued or not yet due are
+// 0 days overdue.
+func (inv Invoice) DaysOverdue(date time.Time) int {
+	if !inv.IsPayable() {
+		return 0
+	}
+
+	days := daysBetween(inv.DueDate, date)
+	if days < 0 {
+		return 0
+	}
+
+	return days
+}
+
+// PaymentTermDays is how many days after it was issued the invoice falls
+// due, never less than 0.
+func (inv Invoice) PaymentTermDays() int {
+	days := daysBetween(inv.IssueDate, inv.DueDate)
+	if days < 0 {
+		return 0
+	}
+
+	return days
+}
+
+// OverdueCutoff returns the day the due date of an invoice must fall before
+// for the invoice to match the overdue filters, and false when neither
+// filter is set.
+func (f InvoiceFilter) OverdueCutoff() (time.Time, bool) {
+	if !f.Overdue && f.DaysOverdueGte == nil {
+		return time.Time{}, false
+	}
+
+	today := truncateDay(f.AsOf)
+	if f.DaysOverdueGte == nil {
+		return today, true
+	}
+
+	// at least N days overdue means due on or before N days ago
+	cutoff := today.AddDate(0, 0, 1-*f.DaysOverdueGte)
+	if f.Overdue && today.Before(cutoff) {
+		cutoff = today
+	}
+
+	return cutoff, true
+}
+
+// daysBetween counts the calendar days from the day of from to the day of to.
+func daysBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+
+	start := time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)
+	end := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC)
+
+	return int(end.Sub(start).Hours() / 24)
+}
+
+// IsPaymentStatus reports whether status is one that is derived from the
+// payments recorded against an invoice and so can't be set by hand.
+func IsPaymentStatus(status string) bool {
+	return status == InvoiceStatusPartiallyPaid || status == InvoiceStatusPaid
+}
+
+// BalanceDue is what is still owed on the invoice. Nothing is owed on void
+// and cancelled invoices, whatever was paid or credited before.
+func (inv Invoice) BalanceDue() Money {
+	if inv.Status == InvoiceStatusVoid || inv.Status == InvoiceStatusCancelled {
+		return Money{}
+	}
+
+	return inv.TotalAmount.Sub(inv.AmountPaid).Sub(inv.AmountCredited)
+}
+
+// IsPayable reports whether payments may be recorded against the invoice.
+func (inv Invoice) IsPayable() bool {
+	switch inv.Status {
+	case InvoiceStatusIssued, InvoiceStatusPartiallyPaid, InvoiceStatusOverdue:
+		return true
+	}
+
+	return false
+}
+
+// ApplyPayment adds amount to what has been paid and moves the invoice to paid
+// once nothing is left to pay. A partial payment marks an issued invoice as
+// partially paid; an overdue invoice stays overdue until it is settled.
+func (inv *Invoice) ApplyPayment(amount Money) error {
+	if !inv.IsPayable() {
+		return ErrInvoiceNotPayable
+	}
+
+	if amount.Cmp(Money{}) <= 0 {
+		return ErrNonPositivePayment
+	}
+
+	if amount.Cmp(inv.BalanceDue()) > 0 {
+		return ErrOverpayment
+	}
+
+	inv.AmountPaid = inv.AmountPaid.Add(amount)
+
+	switch {
+	case inv.BalanceDue().IsZero():
+		inv.Status = InvoiceStatusPaid
+	case inv.Status == InvoiceStatusIssued:
+		inv.Status = InvoiceStatusPartiallyPaid
+	}
+
+	return nil
+}
+
+// ApplyCredit reduces the balance due by a credit note of amount. Credit can
+// only be given on invoices that are still open and never for more than is
+// owed; an invoice with nothing left to collect is settled and becomes paid.
+func (inv *Invoice) ApplyCredit(amount Money) error {
+	if !inv.IsPayable() {
+		return ErrInvoiceNotCreditable
+	}
+
+	if amount.Cmp(Money{}) <= 0 {
+		return ErrNonPositiveCredit
+	}
+
+	if amount.Cmp(inv.BalanceDue()) > 0 {
+		return ErrOverCredit
+	}
+
+	inv.AmountCredited = inv.AmountCredited.Add(amount)
+
+	if inv.BalanceDue().IsZero() {
+		inv.Status = InvoiceStatusPaid
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransitionInvoiceStatus(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{InvoiceStatusDraft, InvoiceStatusIssued, true},
+		{InvoiceStatusDraft, InvoiceStatusCancelled, true},
+		{InvoiceStatusDraft, InvoiceStatusPaid, false},
+		{InvoiceStatusIssued, InvoiceStatusPartiallyPaid, true},
+		{InvoiceStatusIssued, InvoiceStatusVoid, true},
+		{InvoiceStatusIssued, InvoiceStatusDraft, false},
+		{InvoiceStatusIssued, InvoiceStatusCancelled, false},
+		{InvoiceStatusOverdue, InvoiceStatusPaid, true},
+		{InvoiceStatusPartiallyPaid, InvoiceStatusIssued, false},
+		{InvoiceStatusPaid, InvoiceStatusVoid, false},
+		{InvoiceStatusVoid, InvoiceStatusIssued, false},
+		{InvoiceStatusCancelled, InvoiceStatusDraft, false},
+		{InvoiceStatusIssued, InvoiceStatusIssued, true},
+		{"unknown", "unknown", false},
+		{InvoiceStatusDraft, "unknown", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CanTransitionInvoiceStatus(tt.from, tt.to), "%s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestNormalizeInvoiceStatus(t *testing.T) {
+	tests := map[string]string{
+		" Draft ":  InvoiceStatusDraft,
+		"unpaid":   InvoiceStatusIssued,
+		"SENT":     InvoiceStatusIssued,
+		"canceled": InvoiceStatusCancelled,
+		"paid":     InvoiceStatusPaid,
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, NormalizeInvoiceStatus(in), in)
+	}
+}
+
+func TestInvoice_DaysOverdue(t *testing.T) {
+	due := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2025, 4, 30, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		invoice Invoice
+		want    int
+	}{
+		{"issued and late", Invoice{Status: InvoiceStatusIssued, DueDate: due}, 30},
+		{"already flagged", Invoice{Status: InvoiceStatusOverdue, DueDate: due}, 30},
+		{"due today", Invoice{Status: InvoiceStatusIssued, DueDate: time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)}, 0},
+		{"not yet due", Invoice{Status: InvoiceStatusIssued, DueDate: today.AddDate(0, 0, 7)}, 0},
+		{"paid", Invoice{Status: InvoiceStatusPaid, DueDate: due}, 0},
+		{"draft", Invoice{Status: InvoiceStatusDraft, DueDate: due}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.invoice.DaysOverdue(today))
+			assert.Equal(t, tt.want > 0, tt.invoice.IsPastDue(today))
+		})
+	}
+}
+
+func TestInvoiceFilter_OverdueCutoff(t *testing.T) {
+	asOf := time.Date(2025, 4, 30, 15, 30, 0, 0, time.UTC)
+	today := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	zero, thirty := 0, 30
+
+	_, ok := InvoiceFilter{AsOf: asOf}.OverdueCutoff()
+	assert.False(t, ok)
+
+	cutoff, ok := InvoiceFilter{Overdue: true, AsOf: asOf}.OverdueCutoff()
+	assert.True(t, ok)
+	assert.Equal(t, today, cutoff)
+
+	cutoff, _ = InvoiceFilter{DaysOverdueGte: &thirty, AsOf: asOf}.OverdueCutoff()
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), cutoff, "due on March 31 or earlier")
+
+	cutoff, _ = InvoiceFilter{DaysOverdueGte: &zero, AsOf: asOf}.OverdueCutoff()
+	assert.Equal(t, today.AddDate(0, 0, 1), cutoff, "due today or earlier")
+
+	cutoff, _ = InvoiceFilter{Overdue: true, DaysOverdueGte: &zero, AsOf: asOf}.OverdueCutoff()
+	assert.Equal(t, today, cutoff, "overdue still excludes invoices due today")
+}
+
+func TestInvoice_PaymentTermDays(t *testing.T) {
+	issue := time.Date(2025, 3, 28, 0, 0, 0, 0, time.Local)
+
+	assert.Equal(t, 14, Invoice{IssueDate: issue, DueDate: issue.AddDate(0, 0, 14)}.PaymentTermDays())
+	assert.Equal(t, 0, Invoice{IssueDate: issue, DueDate: issue}.PaymentTermDays())
+	assert.Equal(t, 0, Invoice{IssueDate: issue, DueDate: issue.AddDate(0, 0, -3)}.PaymentTermDays())
+}
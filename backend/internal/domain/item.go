@@ -2,11 +2,19 @@ package domain
 
 import "time"
 
+// DefaultItemUnit is the unit of measure used when an item doesn't name one.
+const DefaultItemUnit = "pcs"
+
 type Item struct {
 	ID           uint
+	SKU          string
 	Name         string
 	Type         string
+	Description  string
+	UnitPrice    Money
+	Unit         string
 	IsActive     bool
+	TaxRateID    *uint
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	InvoiceItems []InvoiceItem
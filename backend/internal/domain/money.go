@@ -0,0 +1,354 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MoneyScale is the number of decimal places kept for amounts. It matches the
+// decimal(12,2) columns the amounts are stored in.
+const MoneyScale = 2
+
+const minorPerUnit = 100
+
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+// RoundingMode decides how results that fall between two minor units are
+// rounded.
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // ties away from zero
+	RoundHalfEven                     // ties to the even neighbour (banker's rounding)
+	RoundDown                         // truncate toward zero
+	RoundUp                           // away from zero
+)
+
+// Money is an exact amount held in minor units (hundredths). All arithmetic
+// stays in integers; only multiplication by a rate needs rounding, and that
+// always takes an explicit RoundingMode.
+type Money struct {
+	minor int64
+}
+
+// NewMoney returns an amount of whole currency units.
+func NewMoney(units int64) Money {
+	return Money{minor: units * minorPerUnit}
+}
+
+// MoneyFromMinor returns an amount expressed in minor units.
+func MoneyFromMinor(minor int64) Money {
+	return Money{minor: minor}
+}
+
+// MoneyFromFloat converts a float, rounding half up to the nearest minor
+// unit. It is only meant for sources that can't give a decimal string.
+func MoneyFromFloat(f float64) Money {
+	return Money{minor: int64(math.Round(f * minorPerUnit))}
+}
+
+// ParseMoney parses a plain decimal such as "1250", "-3.5" or "19.99". More
+// than MoneyScale decimal places is an error rather than a silent rounding.
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Money{}, ErrInvalidMoney
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return Money{}, ErrInvalidMoney
+	}
+	if len(frac) > MoneyScale {
+		return Money{}, fmt.Errorf("%w: more than %d decimal places in %q", ErrInvalidMoney, MoneyScale, s)
+	}
+	if !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+
+	frac += strings.Repeat("0", MoneyScale-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+
+	minor, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %v", ErrInvalidMoney, err)
+	}
+
+	if negative {
+		minor = -minor
+	}
+
+	return Money{minor: minor}, nil
+}
+
+// MustParseMoney is ParseMoney for literals known to be valid.
+func MustParseMoney(s string) Money {
+	m, err := ParseMoney(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Minor returns the amount in minor units.
+func (m Money) Minor() int64 {
+	return m.minor
+}
+
+func (m Money) IsZero() bool {
+	return m.minor == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.minor < 0
+}
+
+func (m Money) Add(o Money) Money {
+	return Money{minor: m.minor + o.minor}
+}
+
+func (m Money) Sub(o Money) Money {
+	return Money{minor: m.minor - o.minor}
+}
+
+func (m Money) Neg() Money {
+	return Money{minor: -m.minor}
+}
+
+// Mul multiplies the amount by a whole quantity, which is always exact.
+func (m Money) Mul(qty int) Money {
+	return Money{minor: m.minor * int64(qty)}
+}
+
+// Cmp returns -1, 0 or +1 depending on whether m is less than, equal to or
+// greater than o.
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.minor < o.minor:
+		return -1
+	case m.minor > o.minor:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MulRate multiplies the amount by a decimal factor such as an exchange rate.
+// The factor is read through its shortest decimal representation, so 0.1 is
+// treated as exactly one tenth.
+func (m Money) MulRate(rate float64, mode RoundingMode) Money {
+	factor, ok := new(big.Rat).SetString(strconv.FormatFloat(rate, 'f', -1, 64))
+	if !ok {
+		return Money{}
+	}
+
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(m.minor), factor)
+
+	return Money{minor: roundRat(product, mode)}
+}
+
+// Percent returns pct percent of the amount, e.g. Percent(10, RoundHalfUp)
+// for a 10% tax.
+func (m Money) Percent(pct float64, mode RoundingMode) Money {
+	factor, ok := new(big.Rat).SetString(strconv.FormatFloat(pct, 'f', -1, 64))
+	if !ok {
+		return Money{}
+	}
+
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(m.minor), factor)
+	product.Quo(product, big.NewRat(100, 1))
+
+	return Money{minor: roundRat(product, mode)}
+}
+
+// Prorate returns the share of the amount that part is of whole, e.g. the
+// tax of a line for the part of it being credited. A zero whole has no
+// share.
+func (m Money) Prorate(part, whole Money, mode RoundingMode) Money {
+	if whole.minor == 0 {
+		return Money{}
+	}
+
+	product := new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(m.minor), big.NewInt(part.minor)), big.NewInt(whole.minor))
+
+	return Money{minor: roundRat(product, mode)}
+}
+
+// Allocate splits the amount into n parts that differ by at most one minor
+// unit and always add back up to the original amount.
+func (m Money) Allocate(n int) []Money {
+	if n <= 0 {
+		return nil
+	}
+
+	parts := make([]Money, n)
+	base := m.minor / int64(n)
+	rest := m.minor % int64(n)
+
+	for i := range parts {
+		parts[i] = Money{minor: base}
+		if int64(i) < abs(rest) {
+			if rest > 0 {
+				parts[i].minor++
+			} else {
+				parts[i].minor--
+			}
+		}
+	}
+
+	return parts
+}
+
+// Float64 returns an approximation of the amount for display purposes only.
+func (m Money) Float64() float64 {
+	return float64(m.minor) / minorPerUnit
+}
+
+// String formats the amount with exactly MoneyScale decimals, e.g. "-12.50".
+func (m Money) String() string {
+	sign := ""
+	minor := m.minor
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, minor/minorPerUnit, minor%minorPerUnit)
+}
+
+// MarshalJSON encodes the amount as a JSON number with two decimals.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a numeric string.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	s = strings.Trim(s, `"`)
+
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner for decimal columns.
+func (m *Money) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*m = Money{}
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	case int64:
+		*m = NewMoney(v)
+	case float64:
+		// SQLite hands decimals back as REAL
+		*m = MoneyFromFloat(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidMoney, value)
+	}
+
+	return nil
+}
+
+func (m *Money) scanString(s string) error {
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		// aggregates such as SUM over decimal columns may come back with more
+		// decimals than the column scale
+		f, ferr := strconv.ParseFloat(s, 64)
+		if ferr != nil {
+			return err
+		}
+		parsed = MoneyFromFloat(f)
+	}
+
+	*m = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, writing the exact decimal string.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func roundRat(r *big.Rat, mode RoundingMode) int64 {
+	num := new(big.Int).Set(r.Num())
+	den := r.Denom()
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo.Int64()
+	}
+
+	negative := num.Sign() < 0
+	step := big.NewInt(1)
+	if negative {
+		step.Neg(step)
+	}
+
+	// compare twice the remainder against the denominator to find out which
+	// side of the half-way point the value lies on
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	half := twice.Cmp(den)
+
+	awayFromZero := false
+	switch mode {
+	case RoundDown:
+		awayFromZero = false
+	case RoundUp:
+		awayFromZero = true
+	case RoundHalfEven:
+		awayFromZero = half > 0 || (half == 0 && quo.Bit(0) == 1)
+	default:
+		awayFromZero = half >= 0
+	}
+
+	if awayFromZero {
+		quo.Add(quo, step)
+	}
+
+	return quo.Int64()
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    int64
+		expectError bool
+	}{
+		{name: "whole number", input: "4500000", expected: 450000000},
+		{name: "one decimal", input: "12.5", expected: 1250},
+		{name: "two decimals", input: "19.99", expected: 1999},
+		{name: "negative", input: "-0.05", expected: -5},
+		{name: "leading dot", input: ".50", expected: 50},
+		{name: "too many decimals", input: "1.005", expectError: true},
+		{name: "not a number", input: "abc", expectError: true},
+		{name: "exponent", input: "1e3", expectError: true},
+		{name: "empty", input: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseMoney(tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result.Minor())
+			}
+		})
+	}
+}
+
+func TestMoney_SumIsExact(t *testing.T) {
+	// 0.1 added ten times drifts with float64 but not with Money
+	var total Money
+	for i := 0; i < 10; i++ {
+		total = total.Add(MustParseMoney("0.10"))
+	}
+
+	assert.Equal(t, NewMoney(1), total)
+	assert.Equal(t, "1.00", total.String())
+}
+
+func TestMoney_Percent(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		pct      float64
+		mode     RoundingMode
+		expected string
+	}{
+		{name: "exact", amount: "450.00", pct: 10, mode: RoundHalfUp, expected: "45.00"},
+		{name: "half up", amount: "0.25", pct: 10, mode: RoundHalfUp, expected: "0.03"},
+		{name: "half even rounds to even", amount: "0.25", pct: 10, mode: RoundHalfEven, expected: "0.02"},
+		{name: "half even rounds odd up", amount: "0.35", pct: 10, mode: RoundHalfEven, expected: "0.04"},
+		{name: "down truncates", amount: "0.29", pct: 10, mode: RoundDown, expected: "0.02"},
+		{name: "up", amount: "0.21", pct: 10, mode: RoundUp, expected: "0.03"},
+		{name: "negative half up", amount: "-0.25", pct: 10, mode: RoundHalfUp, expected: "-0.03"},
+		{name: "fractional rate", amount: "100.00", pct: 12.5, mode: RoundHalfUp, expected: "12.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MustParseMoney(tt.amount).Percent(tt.pct, tt.mode)
+			assert.Equal(t, tt.expected, result.String())
+		})
+	}
+}
+
+func TestMoney_MulRate(t *testing.T) {
+	usd := MustParseMoney("12.34")
+
+	result := usd.MulRate(15750.5, RoundHalfUp)
+
+	assert.Equal(t, "194361.17", result.String())
+}
+
+func TestMoney_Prorate(t *testing.T) {
+	tax := MustParseMoney("10.00")
+
+	assert.Equal(t, "3.33", tax.Prorate(MustParseMoney("33.33"), MustParseMoney("100.00"), RoundHalfUp).String())
+	assert.Equal(t, tax, tax.Prorate(MustParseMoney("100.00"), MustParseMoney("100.00"), RoundHalfUp))
+	assert.True(t, tax.Prorate(MustParseMoney("5.00"), Money{}, RoundHalfUp).IsZero())
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	parts := MustParseMoney("100.00").Allocate(3)
+
+	assert.Equal(t, []Money{MustParseMoney("33.34"), MustParseMoney("33.33"), MustParseMoney("33.33")}, parts)
+
+	var total Money
+	for _, p := range parts {
+		total = total.Add(p)
+	}
+	assert.Equal(t, MustParseMoney("100.00"), total)
+}
+
+func TestMoney_JSON(t *testing.T) {
+	var payload struct {
+		Price Money `json:"price"`
+		Total Money `json:"total"`
+	}
+
+	err := json.Unmarshal([]byte(`{"price": 2000000, "total": "19.90"}`), &payload)
+	assert.NoError(t, err)
+	assert.Equal(t, NewMoney(2000000), payload.Price)
+	assert.Equal(t, MustParseMoney("19.9"), payload.Total)
+
+	out, err := json.Marshal(payload)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"price": 2000000.00, "total": 19.90}`, string(out))
+
+	err = json.Unmarshal([]byte(`{"price": 0.001}`), &payload)
+	assert.Error(t, err)
+}
+
+func TestMoney_Scan(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "mysql decimal", value: []byte("4950000.00"), expected: "4950000.00"},
+		{name: "string", value: "10.5", expected: "10.50"},
+		{name: "integer", value: int64(15000), expected: "15000.00"},
+		{name: "sqlite real", value: 0.1 + 0.2, expected: "0.30"},
+		{name: "aggregate with extra scale", value: []byte("33.3333"), expected: "33.33"},
+		{name: "null", value: nil, expected: "0.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Money
+			err := m.Scan(tt.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, m.String())
+		})
+	}
+}
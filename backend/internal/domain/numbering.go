@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Document series that are numbered independently of each other.
+const (
+	SeriesInvoice    = "invoice"
+	SeriesCreditNote = "credit_note"
+	SeriesQuote      = "quote"
+)
+
+// How often a series starts counting from 1 again.
+const (
+	SequenceResetNever   = "never"
+	SequenceResetYearly  = "yearly"
+	SequenceResetMonthly = "monthly"
+)
+
+// MaxDocumentNumberLength matches the width of the number columns.
+const MaxDocumentNumberLength = 50
+
+const defaultSequenceWidth = 5
+
+var ErrInvalidNumberingScheme = errors.New("invalid numbering scheme")
+
+// NumberingScheme describes how the numbers of a document series look, e.g.
+// "INV/{YYYY}/{MM}/{seq:5}" resetting monthly gives INV/2025/10/00001.
+//
+// Supported placeholders are {YYYY}, {YY}, {MM}, {DD} for the document date
+// and {seq} or {seq:N} for the sequence, zero padded to N digits (5 by
+// default). Everything else is copied as is.
+type NumberingScheme struct {
+	Series  string
+	Pattern string
+	Reset   string
+}
+
+// Validate checks the pattern and makes sure numbers can't repeat: a series
+// that resets yearly must print the year, one that resets monthly the year
+// and month.
+func (s NumberingScheme) Validate() error {
+	if s.Series == "" {
+		return fmt.Errorf("%w: missing series", ErrInvalidNumberingScheme)
+	}
+
+	tokens, err := parsePattern(s.Pattern)
+	if err != nil {
+		return err
+	}
+
+	var seqCount int
+	var hasYear, hasMonth bool
+	for _, tok := range tokens {
+		switch tok.kind {
+		case "seq":
+			seqCount++
+		case "YYYY", "YY":
+			hasYear = true
+		case "MM":
+			hasMonth = true
+		}
+	}
+
+	if seqCount != 1 {
+		return fmt.Errorf("%w: %q must contain {seq} exactly once", ErrInvalidNumberingScheme, s.Pattern)
+	}
+
+	switch s.reset() {
+	case SequenceResetNever:
+	case SequenceResetYearly:
+		if !hasYear {
+			return fmt.Errorf("%w: %q resets yearly but has no year", ErrInvalidNumberingScheme, s.Pattern)
+		}
+	case SequenceResetMonthly:
+		if !hasYear || !hasMonth {
+			return fmt.Errorf("%w: %q resets monthly but lacks the year or month", ErrInvalidNumberingScheme, s.Pattern)
+		}
+	default:
+		return fmt.Errorf("%w: unknown reset %q", ErrInvalidNumberingScheme, s.Reset)
+	}
+
+	return nil
+}
+
+// Period returns the key the sequence is counted under for date: empty for
+// series that never reset, "2025" for yearly and "2025-10" for monthly ones.
+func (s NumberingScheme) Period(date time.Time) string {
+	switch s.reset() {
+	case SequenceResetYearly:
+		return date.Format("2006")
+	case SequenceResetMonthly:
+		return date.Format("2006-01")
+	}
+
+	return ""
+}
+
+// Format renders the number of the seq-th document dated date.
+func (s NumberingScheme) Format(date time.Time, seq int64) (string, error) {
+	tokens, err := parsePattern(s.Pattern)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, tok := range tokens {
+		switch tok.kind {
+		case "":
+			b.WriteString(tok.text)
+		case "YYYY":
+			b.WriteString(date.Format("2006"))
+		case "YY":
+			b.WriteString(date.Format("06"))
+		case "MM":
+			b.WriteString(date.Format("01"))
+		case "DD":
+			b.WriteString(date.Format("02"))
+		case "seq":
+			b.WriteString(fmt.Sprintf("%0*d", tok.width, seq))
+		}
+	}
+
+	number := b.String()
+	if len(number) > MaxDocumentNumberLength {
+		return "", fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidNumberingScheme, number, MaxDocumentNumberLength)
+	}
+
+	return number, nil
+}
+
+func (s NumberingScheme) reset() string {
+	if s.Reset == "" {
+		return SequenceResetNever
+	}
+	return s.Reset
+}
+
+type patternToken struct {
+	kind  string // "" for literal text
+	text  string
+	width int
+}
+
+func parsePattern(pattern string) ([]patternToken, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("%w: empty pattern", ErrInvalidNumberingScheme)
+	}
+
+	var tokens []patternToken
+	rest := pattern
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			tokens = append(tokens, patternToken{text: rest})
+			break
+		}
+		if open > 0 {
+			tokens = append(tokens, patternToken{text: rest[:open]})
+		}
+
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unclosed placeholder in %q", ErrInvalidNumberingScheme, pattern)
+		}
+
+		tok, err := parsePlaceholder(rest[open+1 : open+end])
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, tok)
+		rest = rest[open+end+1:]
+	}
+
+	return tokens, nil
+}
+
+func parsePlaceholder(name string) (patternToken, error) {
+	switch name {
+	case "YYYY", "YY", "MM", "DD":
+		return patternToken{kind: name}, nil
+	case "seq":
+		return patternToken{kind: "seq", width: defaultSequenceWidth}, nil
+	}
+
+	if width, ok := strings.CutPrefix(name, "seq:"); ok {
+		n, err := strconv.Atoi(width)
+		if err != nil || n < 1 || n > 18 {
+			return patternToken{}, fmt.Errorf("%w: invalid sequence width %q", ErrInvalidNumberingScheme, width)
+		}
+		return patternToken{kind: "seq", width: n}, nil
+	}
+
+	return patternToken{}, fmt.Errorf("%w: unknown placeholder {%s}", ErrInvalidNumberingScheme, name)
+}
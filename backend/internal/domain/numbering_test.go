@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberingScheme_Format(t *testing.T) {
+	date := time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		pattern  string
+		seq      int64
+		expected string
+	}{
+		{"year and month", "INV/{YYYY}/{MM}/{seq:5}", 42, "INV/2025/03/00042"},
+		{"default width", "CN-{YY}{MM}{DD}-{seq}", 7, "CN-250307-00007"},
+		{"sequence wider than padding", "Q{seq:3}", 12345, "Q12345"},
+		{"plain counter", "{seq:1}", 1000, "1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			number, err := NumberingScheme{Series: SeriesInvoice, Pattern: tt.pattern}.Format(date, tt.seq)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, number)
+		})
+	}
+}
+
+func TestNumberingScheme_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		scheme  NumberingScheme
+		isValid bool
+	}{
+		{"monthly with year and month", NumberingScheme{Series: SeriesInvoice, Pattern: "INV/{YYYY}/{MM}/{seq:5}", Reset: SequenceResetMonthly}, true},
+		{"yearly with short year", NumberingScheme{Series: SeriesQuote, Pattern: "Q{YY}-{seq}", Reset: SequenceResetYearly}, true},
+		{"never resets", NumberingScheme{Series: SeriesCreditNote, Pattern: "CN-{seq:6}"}, true},
+		{"yearly without year", NumberingScheme{Series: SeriesInvoice, Pattern: "INV-{seq}", Reset: SequenceResetYearly}, false},
+		{"monthly without month", NumberingScheme{Series: SeriesInvoice, Pattern: "INV/{YYYY}/{seq}", Reset: SequenceResetMonthly}, false},
+		{"missing sequence", NumberingScheme{Series: SeriesInvoice, Pattern: "INV/{YYYY}"}, false},
+		{"two sequences", NumberingScheme{Series: SeriesInvoice, Pattern: "{seq}-{seq}"}, false},
+		{"unknown placeholder", NumberingScheme{Series: SeriesInvoice, Pattern: "INV/{HH}/{seq}"}, false},
+		{"unclosed placeholder", NumberingScheme{Series: SeriesInvoice, Pattern: "INV/{seq"}, false},
+		{"bad width", NumberingScheme{Series: SeriesInvoice, Pattern: "INV/{seq:x}"}, false},
+		{"unknown reset", NumberingScheme{Series: SeriesInvoice, Pattern: "INV/{YYYY}/{seq}", Reset: "weekly"}, false},
+		{"missing series", NumberingScheme{Pattern: "INV/{seq}"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scheme.Validate()
+
+			if tt.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidNumberingScheme)
+			}
+		})
+	}
+}
+
+func TestNumberingScheme_Period(t *testing.T) {
+	date := time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "", NumberingScheme{Reset: SequenceResetNever}.Period(date))
+	assert.Equal(t, "", NumberingScheme{}.Period(date))
+	assert.Equal(t, "2025", NumberingScheme{Reset: SequenceResetYearly}.Period(date))
+	assert.Equal(t, "2025-11", NumberingScheme{Reset: SequenceResetMonthly}.Period(date))
+}
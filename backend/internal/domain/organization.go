@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultOrganizationID is the organization that existing data and the
+// first user belong to, and the one signed in to when a login names none.
+const DefaultOrganizationID uint = 1
+
+// Organization is a tenant: a legal entity with its own customers, catalog,
+// documents and users, none of which are visible to other organizations.
+type Organization struct {
+	ID        uint
+	Name      string
+	Slug      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type tenantContextKey struct{}
+
+// ContextWithTenant returns a copy of ctx scoped to the organization
+// tenantID. Repositories only see and create that organization's records.
+func ContextWithTenant(ctx context.Context, tenantID uint) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenantID)
+}
+
+// TenantFromContext returns the organization ctx is scoped to. A context
+// without one, such as a background job's, sees every organization.
+func TenantFromContext(ctx context.Context) (uint, bool) {
+	tenantID, ok := ctx.Value(tenantContextKey{}).(uint)
+	return tenantID, ok && tenantID != 0
+}
@@ -0,0 +1,59 @@
+package domain
+
+const (
+	DefaultPageLimit = 10
+	MaxPageLimit     = 100
+)
+
+type Pagination struct {
+	TotalItems  int64
+	TotalPages  int
+	CurrentPage int
+	PrevPage    *int
+	NextPage    *int
+	Limit       int
+}
+
+// NormalizePage fills in the defaults for a missing or out of range page and
+// limit and returns the matching row offset.
+func NormalizePage(page, limit int) (int, int, int) {
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	return page, limit, (page - 1) * limit
+}
+
+// NewPagination builds the pagination metadata for a page of a result set
+// holding totalItems rows.
+func NewPagination(totalItems int64, page, limit int) Pagination {
+	totalPages := 0
+	if limit > 0 {
+		totalPages = int((totalItems + int64(limit) - 1) / int64(limit))
+	}
+
+	var prevPage, nextPage *int
+	if page > 1 {
+		p := page - 1
+		prevPage = &p
+	}
+	if page < totalPages {
+		n := page + 1
+		nextPage = &n
+	}
+
+	return Pagination{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PrevPage:    prevPage,
+		NextPage:    nextPage,
+		Limit:       limit,
+	}
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePage(t *testing.T) {
+	page, limit, offset := NormalizePage(0, 0)
+	assert.Equal(t, 1, page)
+	assert.Equal(t, DefaultPageLimit, limit)
+	assert.Equal(t, 0, offset)
+
+	page, limit, offset = NormalizePage(3, 1000)
+	assert.Equal(t, 3, page)
+	assert.Equal(t, MaxPageLimit, limit)
+	assert.Equal(t, 200, offset)
+}
+
+func TestNewPagination(t *testing.T) {
+	p := NewPagination(25, 2, 10)
+	assert.Equal(t, 3, p.TotalPages)
+	assert.Equal(t, 1, *p.PrevPage)
+	assert.Equal(t, 3, *p.NextPage)
+
+	empty := NewPagination(0, 1, 10)
+	assert.Equal(t, 0, empty.TotalPages)
+	assert.Nil(t, empty.PrevPage)
+	assert.Nil(t, empty.NextPage)
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+const (
+	PaymentMethodBankTransfer = "bank_transfer"
+	PaymentMethodCash         = "cash"
+	PaymentMethodCard         = "card"
+	PaymentMethodCheque       = "cheque"
+	PaymentMethodEWallet      = "e_wallet"
+	PaymentMethodOther        = "other"
+)
+
+type Payment struct {
+	ID          uint
+	InvoiceID   uint
+	Amount      Money
+	PaymentDate time.Time
+	Method      string
+	Reference   string
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func IsValidPaymentMethod(method string) bool {
+	switch method {
+	case PaymentMethodBankTransfer, PaymentMethodCash, PaymentMethodCard,
+		PaymentMethodCheque, PaymentMethodEWallet, PaymentMethodOther:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package domain
+
+import "time"
+
+// PriceList is a named set of item prices that applies between ValidFrom and
+// ValidTo, both inclusive. A nil ValidTo leaves the list open ended. Default
+// lists apply to every customer; other lists only to the customers they are
+// assigned to.
+type PriceList struct {
+	ID          uint
+	Name        string
+	Description string
+	IsDefault   bool
+	ValidFrom   time.Time
+	ValidTo     *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	Items []PriceListItem
+}
+
+// PriceListItem is the price of one item on a price list.
+type PriceListItem struct {
+	ID          uint
+	PriceListID uint
+	ItemID      uint
+	UnitPrice   Money
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// PriceListPrice is a price list entry that is in effect on some date,
+// together with what is needed to rank it against other entries.
+type PriceListPrice struct {
+	PriceListID uint
+	ItemID      uint
+	IsDefault   bool
+	ValidFrom   time.Time
+	UnitPrice   Money
+}
+
+// ItemPrice is the price resolved for an item. PriceListID is nil when no
+// price list applied and the catalog price was used.
+type ItemPrice struct {
+	Item        Item
+	UnitPrice   Money
+	PriceListID *uint
+}
+
+// HasValidWindow reports whether the validity window is well formed.
+func (p PriceList) HasValidWindow() bool {
+	return !p.ValidFrom.IsZero() && (p.ValidTo == nil || !p.ValidTo.Before(p.ValidFrom))
+}
+
+// IsEffectiveOn reports whether the list applies on the day of date.
+func (p PriceList) IsEffectiveOn(date time.Time) bool {
+	day := truncateDay(date)
+	if day.Before(truncateDay(p.ValidFrom)) {
+		return false
+	}
+
+	return p.ValidTo == nil || !day.After(truncateDay(*p.ValidTo))
+}
+
+// ResolveItemPrice picks the price of item from the candidates in effect.
+// The customer's own price list wins over default lists, a more recent
+// default list wins over an older one, and without any candidate the
+// catalog price is used.
+func ResolveItemPrice(item Item, customerPriceListID *uint, candidates []PriceListPrice) ItemPrice {
+	var best *PriceListPrice
+
+	for i := range candidates {
+		c := &candidates[i]
+		if c.ItemID != item.ID {
+			continue
+		}
+
+		own := customerPriceListID != nil && c.PriceListID == *customerPriceListID
+		if !own && !c.IsDefault {
+			continue
+		}
+
+		if best == nil || outranks(*c, *best, customerPriceListID) {
+			best = c
+		}
+	}
+
+	if best == nil {
+		return ItemPrice{Item: item, UnitPrice: item.UnitPrice}
+	}
+
+	id := best.PriceListID
+	return ItemPrice{Item: item, UnitPrice: best.UnitPrice, PriceListID: &id}
+}
+
+func outranks(a, b PriceListPrice, customerPriceListID *uint) bool {
+	if customerPriceListID != nil {
+		aOwn := a.PriceListID == *customerPriceListID
+		bOwn := b.PriceListID == *customerPriceListID
+		if aOwn != bOwn {
+			return aOwn
+		}
+	}
+
+	if !a.ValidFrom.Equal(b.ValidFrom) {
+		return a.ValidFrom.After(b.ValidFrom)
+	}
+
+	// same start date: the list created last wins
+	return a.PriceListID > b.PriceListID
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
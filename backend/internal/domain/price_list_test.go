@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceList_IsEffectiveOn(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	bounded := PriceList{ValidFrom: from, ValidTo: &to}
+	open := PriceList{ValidFrom: from}
+
+	assert.False(t, bounded.IsEffectiveOn(from.AddDate(0, 0, -1)))
+	assert.True(t, bounded.IsEffectiveOn(from))
+	assert.True(t, bounded.IsEffectiveOn(to.Add(15*time.Hour)), "last day is inclusive")
+	assert.False(t, bounded.IsEffectiveOn(to.AddDate(0, 0, 1)))
+	assert.True(t, open.IsEffectiveOn(from.AddDate(10, 0, 0)))
+}
+
+func TestPriceList_HasValidWindow(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := from.AddDate(0, 0, -1)
+
+	assert.True(t, PriceList{ValidFrom: from}.HasValidWindow())
+	assert.True(t, PriceList{ValidFrom: from, ValidTo: &from}.HasValidWindow())
+	assert.False(t, PriceList{ValidFrom: from, ValidTo: &before}.HasValidWindow())
+	assert.False(t, PriceList{}.HasValidWindow())
+}
+
+func TestResolveItemPrice(t *testing.T) {
+	item := Item{ID: 1, UnitPrice: NewMoney(100)}
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	wholesale := uint(7)
+
+	tests := []struct {
+		name           string
+		customerList   *uint
+		candidates     []PriceListPrice
+		expectedPrice  Money
+		expectedListID *uint
+	}{
+		{
+			name:          "no candidates falls back to catalog",
+			expectedPrice: NewMoney(100),
+		},
+		{
+			name: "most recent default list wins",
+			candidates: []PriceListPrice{
+				{PriceListID: 1, ItemID: 1, IsDefault: true, ValidFrom: jan, UnitPrice: NewMoney(110)},
+				{PriceListID: 2, ItemID: 1, IsDefault: true, ValidFrom: mar, UnitPrice: NewMoney(120)},
+			},
+			expectedPrice:  NewMoney(120),
+			expectedListID: uintPtr(2),
+		},
+		{
+			name:         "customer list wins over a newer default",
+			customerList: &wholesale,
+			candidates: []PriceListPrice{
+				{PriceListID: 2, ItemID: 1, IsDefault: true, ValidFrom: mar, UnitPrice: NewMoney(120)},
+				{PriceListID: 7, ItemID: 1, ValidFrom: jan, UnitPrice: NewMoney(80)},
+			},
+			expectedPrice:  NewMoney(80),
+			expectedListID: &wholesale,
+		},
+		{
+			name: "lists not assigned to the customer are ignored",
+			candidates: []PriceListPrice{
+				{PriceListID: 7, ItemID: 1, ValidFrom: jan, UnitPrice: NewMoney(80)},
+			},
+			expectedPrice: NewMoney(100),
+		},
+		{
+			name: "prices of other items are ignored",
+			candidates: []PriceListPrice{
+				{PriceListID: 1, ItemID: 2, IsDefault: true, ValidFrom: jan, UnitPrice: NewMoney(5)},
+			},
+			expectedPrice: NewMoney(100),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price := ResolveItemPrice(item, tt.customerList, tt.candidates)
+
+			assert.Equal(t, tt.expectedPrice, price.UnitPrice)
+			assert.Equal(t, tt.expectedListID, price.PriceListID)
+			assert.Equal(t, item, price.Item)
+		})
+	}
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
@@ -0,0 +1,154 @@
+package domain
+
+import "time"
+
+const (
+	QuoteStatusDraft    = "draft"
+	QuoteStatusSent     = "sent"
+	QuoteStatusAccepted = "accepted"
+	QuoteStatusDeclined = "declined"
+	QuoteStatusExpired  = "expired"
+)
+
+// quoteTransitions lists, for every status, the statuses a quote may move to
+// next. Accepted, declined and expired are final.
+var quoteTransitions = map[string][]string{
+	QuoteStatusDraft:    {QuoteStatusSent},
+	QuoteStatusSent:     {QuoteStatusAccepted, QuoteStatusDeclined, QuoteStatusExpired},
+	QuoteStatusAccepted: {},
+	QuoteStatusDeclined: {},
+	QuoteStatusExpired:  {},
+}
+
+// Quote is an estimate sent to a customer before invoicing. Its lines are
+// priced and taxed like invoice lines; once converted, InvoiceID points at
+// the invoice created from it.
+type Quote struct {
+	ID          uint
+	QuoteNumber string
+	CustomerID  uint
+	IssueDate   time.Time
+	ExpiryDate  time.Time
+	Subject     string
+	TotalItems  int
+	Subtotal    Money
+	Tax         Money
+	TotalAmount Money
+	Currency    string
+	Status      string
+	InvoiceID   *uint
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	Customer *Customer
+	Items    []QuoteItem
+}
+
+type QuoteItem struct {
+	ID         uint
+	QuoteID    uint
+	ItemID     uint
+	ItemName   string
+	Type       string
+	Quantity   int
+	Unit       string
+	Price      Money
+	TotalPrice Money
+	TaxRateID  *uint
+	TaxCode    string
+	TaxRate    float64
+	TaxAmount  Money
+	CreatedAt  time.Time
+}
+
+type QuoteFilter struct {
+	CustomerID *uint
+	Status     string
+
+	Limit int
+	Page  int
+}
+
+func IsValidQuoteStatus(status string) bool {
+	_, ok := quoteTransitions[status]
+	return ok
+}
+
+// CanTransitionQuoteStatus reports whether a quote in status from may be
+// moved to status to.
+func CanTransitionQuoteStatus(from, to string) bool {
+	for _, next := range quoteTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEditable reports whether the quote may still be changed.
+func (q Quote) IsEditable() bool {
+	return q.Status == QuoteStatusDraft
+}
+
+// IsExpiredOn reports whether the quote is past its expiry date on the day of
+// date. The expiry date itself is still valid.
+func (q Quote) IsExpiredOn(date time.Time) bool {
+	if q.Status == QuoteStatusExpired {
+		return true
+	}
+
+	return truncateDay(date).After(truncateDay(q.ExpiryDate))
+}
+
+// IsConvertible reports whether an invoice may be created from the quote:
+// it must have been sent or accepted, not be converted yet and, unless the
+// customer already accepted it, still be valid on the day of date.
+func (q Quote) IsConvertible(date time.Time) bool {
+	if q.InvoiceID != nil {
+		return false
+	}
+
+	switch q.Status {
+	case QuoteStatusAccepted:
+		return true
+	case QuoteStatusSent:
+		return !q.IsExpiredOn(date)
+	}
+
+	return false
+}
+
+// CalculateTotals derives the totals from the lines the same way invoices do,
+// so the lines must have their tax applied first.
+func (q *Quote) CalculateTotals() {
+	inv := q.asInvoice()
+	inv.CalculateTotals()
+
+	q.Subtotal = inv.Subtotal
+	q.Tax = inv.Tax
+	q.TotalAmount = inv.TotalAmount
+	q.TotalItems = inv.TotalItems
+}
+
+// TaxBreakdown groups the line taxes by rate, like Invoice.TaxBreakdown.
+func (q Quote) TaxBreakdown() []TaxBreakdown {
+	return q.asInvoice().TaxBreakdown()
+}
+
+func (q Quote) asInvoice() Invoice {
+	items := make([]InvoiceItem, len(q.Items))
+	for i, it := range q.Items {
+		items[i] = InvoiceItem{
+			ItemID:     it.ItemID,
+			Quantity:   it.Quantity,
+			TotalPrice: it.TotalPrice,
+			TaxRateID:  it.TaxRateID,
+			TaxCode:    it.TaxCode,
+			TaxRate:    it.TaxRate,
+			TaxAmount:  it.TaxAmount,
+		}
+	}
+
+	return Invoice{Items: items}
+}
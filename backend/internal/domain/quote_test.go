@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransitionQuoteStatus(t *testing.T) {
+	assert.True(t, CanTransitionQuoteStatus(QuoteStatusDraft, QuoteStatusSent))
+	assert.True(t, CanTransitionQuoteStatus(QuoteStatusSent, QuoteStatusAccepted))
+	assert.True(t, CanTransitionQuoteStatus(QuoteStatusSent, QuoteStatusDeclined))
+	assert.True(t, CanTransitionQuoteStatus(QuoteStatusSent, QuoteStatusExpired))
+	assert.False(t, CanTransitionQuoteStatus(QuoteStatusDraft, QuoteStatusAccepted))
+	assert.False(t, CanTransitionQuoteStatus(QuoteStatusAccepted, QuoteStatusSent))
+	assert.False(t, CanTransitionQuoteStatus(QuoteStatusDeclined, QuoteStatusAccepted))
+	assert.False(t, CanTransitionQuoteStatus(QuoteStatusExpired, QuoteStatusSent))
+	assert.False(t, IsValidQuoteStatus("issued"))
+}
+
+func TestQuote_IsExpiredOn(t *testing.T) {
+	expiry := time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)
+	q := Quote{Status: QuoteStatusSent, ExpiryDate: expiry}
+
+	assert.False(t, q.IsExpiredOn(expiry.Add(20*time.Hour)), "expiry date itself is still valid")
+	assert.True(t, q.IsExpiredOn(expiry.AddDate(0, 0, 1)))
+	assert.True(t, Quote{Status: QuoteStatusExpired, ExpiryDate: expiry}.IsExpiredOn(expiry.AddDate(0, 0, -5)))
+}
+
+func TestQuote_IsConvertible(t *testing.T) {
+	expiry := time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)
+	before := expiry.AddDate(0, 0, -1)
+	after := expiry.AddDate(0, 0, 1)
+	invoiceID := uint(3)
+
+	tests := []struct {
+		name     string
+		quote    Quote
+		date     time.Time
+		expected bool
+	}{
+		{"draft", Quote{Status: QuoteStatusDraft, ExpiryDate: expiry}, before, false},
+		{"sent and valid", Quote{Status: QuoteStatusSent, ExpiryDate: expiry}, before, true},
+		{"sent but expired", Quote{Status: QuoteStatusSent, ExpiryDate: expiry}, after, false},
+		{"accepted after expiry", Quote{Status: QuoteStatusAccepted, ExpiryDate: expiry}, after, true},
+		{"declined", Quote{Status: QuoteStatusDeclined, ExpiryDate: expiry}, before, false},
+		{"already converted", Quote{Status: QuoteStatusAccepted, ExpiryDate: expiry, InvoiceID: &invoiceID}, before, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.quote.IsConvertible(tt.date))
+		})
+	}
+}
+
+func TestQuote_CalculateTotals(t *testing.T) {
+	q := Quote{Items: []QuoteItem{
+		{ItemID: 1, Quantity: 2, TotalPrice: NewMoney(200), TaxAmount: NewMoney(20)},
+		{ItemID: 2, Quantity: 1, TotalPrice: NewMoney(50)},
+	}}
+
+	q.CalculateTotals()
+
+	assert.Equal(t, NewMoney(250), q.Subtotal)
+	assert.Equal(t, NewMoney(20), q.Tax)
+	assert.Equal(t, NewMoney(270), q.TotalAmount)
+	assert.Equal(t, 2, q.TotalItems)
+}
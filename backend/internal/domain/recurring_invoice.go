@@ -0,0 +1,171 @@
+package domain
+
+import "time"
+
+const (
+	RecurringIntervalWeekly  = "weekly"
+	RecurringIntervalMonthly = "monthly"
+	RecurringIntervalYearly  = "yearly"
+)
+
+const (
+	RecurringStatusActive    = "active"
+	RecurringStatusPaused    = "paused"
+	RecurringStatusCompleted = "completed"
+)
+
+// RecurringInvoice is a template the scheduler turns into an invoice on every
+// run date. Runs fall on the start date and every interval after it; the
+// schedule ends after MaxOccurrences invoices or once the next run would be
+// past EndDate, whichever comes first.
+type RecurringInvoice struct {
+	ID              uint
+	TenantID        uint
+	CustomerID      uint
+	Subject         string
+	Currency        string
+	Interval        string
+	StartDate       time.Time
+	NextRunDate     time.Time
+	LastRunDate     *time.Time
+	EndDate         *time.Time
+	MaxOccurrences  *int
+	Occurrences     int // invoices generated so far
+	PaymentTermDays int
+	InvoiceStatus   string // status the generated invoices start in
+	Status          string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	Customer *Customer
+	Items    []RecurringInvoiceItem
+}
+
+// RecurringInvoiceItem is a line of the template. Without a price, every
+// generated invoice takes the item's effective price on its issue date.
+type RecurringInvoiceItem struct {
+	ID                 uint
+	RecurringInvoiceID uint
+	ItemID             uint
+	ItemName           string
+	Quantity           int
+	Price              *Money
+	TaxRateID          *uint
+	CreatedAt          time.Time
+}
+
+type RecurringInvoiceFilter struct {
+	CustomerID *uint
+	Status     string
+
+	Limit int
+	Page  int
+}
+
+func IsValidRecurringInterval(interval string) bool {
+	switch interval {
+	case RecurringIntervalWeekly, RecurringIntervalMonthly, RecurringIntervalYearly:
+		return true
+	}
+
+	return false
+}
+
+// HasValidSchedule reports whether the template can run at least once.
+func (r RecurringInvoice) HasValidSchedule() bool {
+	if !IsValidRecurringInterval(r.Interval) || r.StartDate.IsZero() {
+		return false
+	}
+
+	if r.MaxOccurrences != nil && *r.MaxOccurrences <= 0 {
+		return false
+	}
+
+	return r.EndDate == nil || !truncateDay(*r.EndDate).Before(truncateDay(r.StartDate))
+}
+
+// OccurrenceDate returns the date of the n-th run, counting the start date
+// as run 0. Monthly and yearly runs keep the day of the start date and fall
+// on the last day of shorter months.
+func (r RecurringInvoice) OccurrenceDate(n int) time.Time {
+	start := truncateDay(r.StartDate)
+
+	switch r.Interval {
+	case RecurringIntervalWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case RecurringIntervalYearly:
+		return addMonthsClamped(start, 12*n)
+	default:
+		return addMonthsClamped(start, n)
+	}
+}
+
+// IsDueOn reports whether the next run of an active template falls on or
+// before the day of date.
+func (r RecurringInvoice) IsDueOn(date time.Time) bool {
+	return r.Status == RecurringStatusActive && !truncateDay(r.NextRunDate).After(truncateDay(date))
+}
+
+// HasEnded reports whether the schedule has no runs left.
+func (r RecurringInvoice) HasEnded() bool {
+	if r.MaxOccurrences != nil && r.Occurrences >= *r.MaxOccurrences {
+		return true
+	}
+
+	return r.EndDate != nil && truncateDay(r.NextRunDate).After(truncateDay(*r.EndDate))
+}
+
+// Advance records that the invoice of the next run has been generated and
+// moves on to the following run, completing the template when none is left.
+func (r *RecurringInvoice) Advance() {
+	ran := r.NextRunDate
+	r.LastRunDate = &ran
+	r.Occurrences++
+	r.NextRunDate = r.firstOccurrence(ran, false)
+
+	if r.HasEnded() {
+		r.Status = RecurringStatusCompleted
+	}
+}
+
+// Resume reactivates a paused template. Runs missed while it was paused are
+// skipped rather than caught up.
+func (r *RecurringInvoice) Resume(date time.Time) {
+	from := r.NextRunDate
+	if truncateDay(date).After(truncateDay(from)) {
+		from = date
+	}
+
+	r.NextRunDate = r.firstOccurrence(from, true)
+	r.Status = RecurringStatusActive
+
+	if r.HasEnded() {
+		r.Status = RecurringStatusCompleted
+	}
+}
+
+// firstOccurrence returns the first run after date, or on or after it when
+// inclusive is set.
+func (r RecurringInvoice) firstOccurrence(date time.Time, inclusive bool) time.Time {
+	day := truncateDay(date)
+
+	for n := 0; ; n++ {
+		next := r.OccurrenceDate(n)
+		if next.After(day) || (inclusive && next.Equal(day)) {
+			return next
+		}
+	}
+}
+
+// addMonthsClamped adds months to t, falling back to the last day of the
+// target month when it is shorter than the day of t.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+
+	return time.Date(first.Year(), first.Month(), d, 0, 0, 0, 0, t.Location())
+}
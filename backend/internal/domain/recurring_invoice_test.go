@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurringInvoice_OccurrenceDate(t *testing.T) {
+	jan31 := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	feb29 := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	monthly := RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: jan31}
+	assert.Equal(t, jan31, monthly.OccurrenceDate(0))
+	assert.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), monthly.OccurrenceDate(1))
+	assert.Equal(t, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), monthly.OccurrenceDate(2), "keeps the day of the start date")
+	assert.Equal(t, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), monthly.OccurrenceDate(12))
+
+	weekly := RecurringInvoice{Interval: RecurringIntervalWeekly, StartDate: jan31}
+	assert.Equal(t, time.Date(2025, 2, 14, 0, 0, 0, 0, time.UTC), weekly.OccurrenceDate(2))
+
+	yearly := RecurringInvoice{Interval: RecurringIntervalYearly, StartDate: feb29}
+	assert.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), yearly.OccurrenceDate(1))
+	assert.Equal(t, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), yearly.OccurrenceDate(4))
+}
+
+func TestRecurringInvoice_HasValidSchedule(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := start.AddDate(0, 0, -1)
+	zero := 0
+	three := 3
+
+	assert.True(t, RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start}.HasValidSchedule())
+	assert.True(t, RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start, EndDate: &start, MaxOccurrences: &three}.HasValidSchedule())
+	assert.False(t, RecurringInvoice{Interval: "daily", StartDate: start}.HasValidSchedule())
+	assert.False(t, RecurringInvoice{Interval: RecurringIntervalMonthly}.HasValidSchedule())
+	assert.False(t, RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start, EndDate: &before}.HasValidSchedule())
+	assert.False(t, RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start, MaxOccurrences: &zero}.HasValidSchedule())
+}
+
+func TestRecurringInvoice_Advance(t *testing.T) {
+	start := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	two := 2
+	end := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		recurring      RecurringInvoice
+		advances       int
+		expectedNext   time.Time
+		expectedStatus string
+	}{
+		{
+			name:           "open ended",
+			recurring:      RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start, NextRunDate: start, Status: RecurringStatusActive},
+			advances:       3,
+			expectedNext:   time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC),
+			expectedStatus: RecurringStatusActive,
+		},
+		{
+			name:           "max occurrences reached",
+			recurring:      RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start, NextRunDate: start, MaxOccurrences: &two, Status: RecurringStatusActive},
+			advances:       2,
+			expectedNext:   time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+			expectedStatus: RecurringStatusCompleted,
+		},
+		{
+			name:           "next run past end date",
+			recurring:      RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start, NextRunDate: start, EndDate: &end, Status: RecurringStatusActive},
+			advances:       2,
+			expectedNext:   time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+			expectedStatus: RecurringStatusCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.recurring
+			for i := 0; i < tt.advances; i++ {
+				r.Advance()
+			}
+
+			assert.Equal(t, tt.advances, r.Occurrences)
+			assert.Equal(t, tt.expectedNext, r.NextRunDate)
+			assert.Equal(t, tt.expectedStatus, r.Status)
+			if assert.NotNil(t, r.LastRunDate) {
+				assert.Equal(t, tt.recurring.OccurrenceDate(tt.advances-1), *r.LastRunDate)
+			}
+		})
+	}
+}
+
+func TestRecurringInvoice_IsDueOn(t *testing.T) {
+	next := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	r := RecurringInvoice{NextRunDate: next, Status: RecurringStatusActive}
+
+	assert.False(t, r.IsDueOn(next.AddDate(0, 0, -1)))
+	assert.True(t, r.IsDueOn(next.Add(9*time.Hour)))
+	assert.True(t, r.IsDueOn(next.AddDate(0, 3, 0)))
+
+	r.Status = RecurringStatusPaused
+	assert.False(t, r.IsDueOn(next))
+}
+
+func TestRecurringInvoice_Resume(t *testing.T) {
+	start := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	r := RecurringInvoice{Interval: RecurringIntervalMonthly, StartDate: start, NextRunDate: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), Occurrences: 1, Status: RecurringStatusPaused}
+
+	// resumed months later, the missed runs are skipped
+	resumed := r
+	resumed.Resume(time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, RecurringStatusActive, resumed.Status)
+	assert.Equal(t, time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), resumed.NextRunDate)
+	assert.Equal(t, 1, resumed.Occurrences)
+
+	// resumed before the next run, nothing is skipped
+	early := r
+	early.Resume(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), early.NextRunDate)
+
+	// resumed on a run date, that run is kept
+	onRun := r
+	onRun.Resume(time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC), onRun.NextRunDate)
+}
@@ -0,0 +1,146 @@
+package domain
+
+import "time"
+
+// ReportFilter narrows a report to invoices issued within [From, To] and,
+// when Currency is set, to invoices in that currency.
+type ReportFilter struct {
+	From     *time.Time
+	To       *time.Time
+	Currency string
+}
+
+// Periods sales are grouped by. Weeks start on Monday.
+const (
+	ReportPeriodDay   = "day"
+	ReportPeriodWeek  = "week"
+	ReportPeriodMonth = "month"
+)
+
+func IsValidReportPeriod(period string) bool {
+	switch period {
+	case ReportPeriodDay, ReportPeriodWeek, ReportPeriodMonth:
+		return true
+	}
+	return false
+}
+
+// ReportPeriodStart returns the first day of the period date falls in.
+func ReportPeriodStart(period string, date time.Time) time.Time {
+	day := truncateDay(date)
+
+	switch period {
+	case ReportPeriodWeek:
+		// time.Sunday is 0, count it as the last day of the week
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case ReportPeriodMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+// BilledInvoiceStatuses returns the statuses of invoices that were sent to
+// the customer and still stand, the ones sales reports count.
+func BilledInvoiceStatuses() []string {
+	return append(OutstandingInvoiceStatuses(), InvoiceStatusPaid)
+}
+
+// Sales orders for the top items report.
+const (
+	ItemSalesByRevenue  = "revenue"
+	ItemSalesByQuantity = "quantity"
+)
+
+// CurrencyDayTotal is the sum of the invoices issued in one currency on one
+// day. Reports convert these into the base currency with that day's rate.
+type CurrencyDayTotal struct {
+	Currency     string
+	IssueDate    time.Time
+	InvoiceCount int64
+	Subtotal     Money
+	Tax          Money
+	TotalAmount  Money
+}
+
+// AgingFilter narrows the aging report to one customer and sets the day the
+// balances are taken at.
+type AgingFilter struct {
+	AsOf       time.Time
+	CustomerID *uint
+}
+
+// AgingBucketStarts returns the first day of each past-due bucket as of the
+// day of asOf: invoices due on or after the first one are current, those
+// due before the last one are more than 90 days past due.
+func (f AgingFilter) AgingBucketStarts() [4]time.Time {
+	today := truncateDay(f.AsOf)
+	return [4]time.Time{
+		today,
+		today.AddDate(0, 0, -30),
+		today.AddDate(0, 0, -60),
+		today.AddDate(0, 0, -90),
+	}
+}
+
+// AgingRow is what one customer still owed in one currency on the as-of
+// day, split by how many days past due the invoices were.
+type AgingRow struct {
+	CustomerID   uint
+	CustomerName string
+	Currency     string
+	Current      Money
+	Days1To30    Money
+	Days31To60   Money
+	Days61To90   Money
+	DaysOver90   Money
+}
+
+// Total is the customer's whole outstanding balance in the row's currency.
+func (r AgingRow) Total() Money {
+	return r.Current.Add(r.Days1To30).Add(r.Days31To60).Add(r.Days61To90).Add(r.DaysOver90)
+}
+
+// RevenueTotal is what was billed in one currency over one period, split
+// into what has been paid, credited back and is still unpaid.
+type RevenueTotal struct {
+	PeriodStart  time.Time
+	Currency     string
+	InvoiceCount int64
+	Billed       Money
+	Paid         Money
+	Credited     Money
+}
+
+// Unpaid is the part of the billed amount still owed.
+func (r RevenueTotal) Unpaid() Money {
+	return r.Billed.Sub(r.Paid).Sub(r.Credited)
+}
+
+// TaxTotal is the tax billed at one rate in one currency over one period.
+type TaxTotal struct {
+	PeriodStart time.Time
+	Currency    string
+	TaxCode     string
+	TaxRate     float64
+	Taxable     Money
+	Tax         Money
+}
+
+// CustomerSales is what one customer was billed in one currency.
+type CustomerSales struct {
+	CustomerID   uint
+	CustomerName string
+	Currency     string
+	InvoiceCount int64
+	Billed       Money
+}
+
+// ItemSales is how much of one item was sold in one currency.
+type ItemSales struct {
+	ItemID   uint
+	ItemName string
+	Currency string
+	Quantity int64
+	Revenue  Money
+}
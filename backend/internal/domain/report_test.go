@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgingFilter_AgingBucketStarts(t *testing.T) {
+	f := AgingFilter{AsOf: time.Date(2025, 3, 31, 17, 45, 0, 0, time.UTC)}
+
+	assert.Equal(t, [4]time.Time{
+		time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+	}, f.AgingBucketStarts())
+}
+
+func TestAgingRow_Total(t *testing.T) {
+	r := AgingRow{
+		Current:    MustParseMoney("10.25"),
+		Days1To30:  NewMoney(20),
+		Days31To60: NewMoney(30),
+		Days61To90: NewMoney(40),
+		DaysOver90: MustParseMoney("0.75"),
+	}
+
+	assert.Equal(t, NewMoney(101), r.Total())
+}
+
+func TestReportPeriodStart(t *testing.T) {
+	sunday := time.Date(2025, 6, 15, 18, 0, 0, 0, time.UTC)
+	monday := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC), ReportPeriodStart(ReportPeriodDay, sunday))
+	assert.Equal(t, monday, ReportPeriodStart(ReportPeriodWeek, sunday), "weeks start on Monday")
+	assert.Equal(t, monday, ReportPeriodStart(ReportPeriodWeek, monday))
+	assert.Equal(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), ReportPeriodStart(ReportPeriodMonth, sunday))
+
+	assert.True(t, IsValidReportPeriod(ReportPeriodWeek))
+	assert.False(t, IsValidReportPeriod("quarter"))
+}
+
+func TestRevenueTotal_Unpaid(t *testing.T) {
+	r := RevenueTotal{Billed: NewMoney(500), Paid: NewMoney(320), Credited: MustParseMoney("29.50")}
+
+	assert.Equal(t, MustParseMoney("150.50"), r.Unpaid())
+}
@@ -0,0 +1,119 @@
+package domain
+
+// Roles a user can have. Admins may do everything; the others are limited to
+// the permissions listed for them in rolePermissions.
+const (
+	RoleAdmin      = "admin"
+	RoleClerk      = "clerk"
+	RoleAccountant = "accountant"
+	RoleAuditor    = "auditor"
+)
+
+// Permission is an operation a role may be allowed to perform.
+type Permission string
+
+const (
+	PermCustomersRead      Permission = "customers:read"
+	PermCustomersWrite     Permission = "customers:write"
+	PermCatalogRead        Permission = "catalog:read"
+	PermCatalogWrite       Permission = "catalog:write"
+	PermInvoicesRead       Permission = "invoices:read"
+	PermInvoicesWrite      Permission = "invoices:write"
+	PermInvoicesVoid       Permission = "invoices:void"
+	PermInvoicesDelete     Permission = "invoices:delete"
+	PermPaymentsRead       Permission = "payments:read"
+	PermPaymentsWrite      Permission = "payments:write"
+	PermCreditNotesRead    Permission = "credit_notes:read"
+	PermCreditNotesWrite   Permission = "credit_notes:write"
+	PermQuotesRead         Permission = "quotes:read"
+	PermQuotesWrite        Permission = "quotes:write"
+	PermRecurringRead      Permission = "recurring:read"
+	PermRecurringWrite     Permission = "recurring:write"
+	PermExchangeRatesRead  Permission = "exchange_rates:read"
+	PermExchangeRatesWrite Permission = "exchange_rates:write"
+	PermReportsRead        Permission = "reports:read"
+	PermAuditRead          Permission = "audit:read"
+	PermUsersManage        Permission = "users:manage"
+)
+
+// readPermissions is everything that only looks at data.
+var readPermissions = []Permission{
+	PermCustomersRead,
+	PermCatalogRead,
+	PermInvoicesRead,
+	PermPaymentsRead,
+	PermCreditNotesRead,
+	PermQuotesRead,
+	PermRecurringRead,
+	PermExchangeRatesRead,
+	PermReportsRead,
+}
+
+// rolePermissions is the permission matrix. Clerks prepare customers,
+// quotes and invoices; accountants settle them with payments and credit
+// notes, void and delete them; auditors only read. Only accountants and
+// auditors see the audit log.
+var rolePermissions = map[string][]Permission{
+	RoleClerk: append([]Permission{
+		PermCustomersWrite,
+		PermInvoicesWrite,
+		PermQuotesWrite,
+		PermRecurringWrite,
+	}, readPermissions...),
+	RoleAccountant: append([]Permission{
+		PermInvoicesVoid,
+		PermInvoicesDelete,
+		PermPaymentsWrite,
+		PermCreditNotesWrite,
+		PermExchangeRatesWrite,
+		PermAuditRead,
+	}, readPermissions...),
+	RoleAuditor: append([]Permission{PermAuditRead}, readPermissions...),
+}
+
+// AllPermissions lists every permission there is, in a stable order.
+func AllPermissions() []Permission {
+	return append(append([]Permission{}, readPermissions...),
+		PermCustomersWrite,
+		PermCatalogWrite,
+		PermInvoicesWrite,
+		PermInvoicesVoid,
+		PermInvoicesDelete,
+		PermPaymentsWrite,
+		PermCreditNotesWrite,
+		PermQuotesWrite,
+		PermRecurringWrite,
+		PermExchangeRatesWrite,
+		PermAuditRead,
+		PermUsersManage,
+	)
+}
+
+func IsValidRole(role string) bool {
+	if role == RoleAdmin {
+		return true
+	}
+
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// RoleHasPermission reports whether role is granted permission.
+func RoleHasPermission(role string, permission Permission) bool {
+	if role == RoleAdmin {
+		return true
+	}
+
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Can reports whether the user is active and its role grants permission.
+func (u User) Can(permission Permission) bool {
+	return u.IsActive && RoleHasPermission(u.Role, permission)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleHasPermission(t *testing.T) {
+	tests := []struct {
+		role       string
+		permission Permission
+		expected   bool
+	}{
+		{RoleAdmin, PermUsersManage, true},
+		{RoleAdmin, PermInvoicesVoid, true},
+
+		{RoleClerk, PermInvoicesWrite, true},
+		{RoleClerk, PermQuotesWrite, true},
+		{RoleClerk, PermCustomersWrite, true},
+		{RoleClerk, PermInvoicesVoid, false},
+		{RoleClerk, PermPaymentsWrite, false},
+		{RoleClerk, PermUsersManage, false},
+		{RoleClerk, PermAuditRead, false},
+
+		{RoleAccountant, PermPaymentsWrite, true},
+		{RoleAccountant, PermInvoicesVoid, true},
+		{RoleAccountant, PermCreditNotesWrite, true},
+		{RoleAccountant, PermInvoicesWrite, false},
+		{RoleAccountant, PermCatalogWrite, false},
+		{RoleAccountant, PermAuditRead, true},
+
+		{RoleAuditor, PermInvoicesRead, true},
+		{RoleAuditor, PermReportsRead, true},
+		{RoleAuditor, PermAuditRead, true},
+		{RoleAuditor, PermInvoicesWrite, false},
+		{RoleAuditor, PermPaymentsWrite, false},
+
+		{"", PermInvoicesRead, false},
+		{"superuser", PermInvoicesRead, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, RoleHasPermission(tt.role, tt.permission), "%s %s", tt.role, tt.permission)
+	}
+}
+
+func TestRoles_CanReadEverything(t *testing.T) {
+	for _, role := range []string{RoleAdmin, RoleClerk, RoleAccountant, RoleAuditor} {
+		for _, p := range readPermissions {
+			assert.True(t, RoleHasPermission(role, p), "%s %s", role, p)
+		}
+	}
+}
+
+func TestUser_Can(t *testing.T) {
+	admin := User{Role: RoleAdmin, IsActive: true}
+	assert.True(t, admin.Can(PermUsersManage))
+
+	admin.IsActive = false
+	assert.False(t, admin.Can(PermInvoicesRead), "deactivated users can do nothing")
+}
+
+func TestIsValidRole(t *testing.T) {
+	for _, role := range []string{RoleAdmin, RoleClerk, RoleAccountant, RoleAuditor} {
+		assert.True(t, IsValidRole(role), role)
+	}
+	assert.False(t, IsValidRole("owner"))
+	assert.False(t, IsValidRole(""))
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Kinds of statement entries. On a day with several entries, invoices come
+// first, then credit notes, then payments.
+const (
+	StatementEntryInvoice    = "invoice"
+	StatementEntryCreditNote = "credit_note"
+	StatementEntryPayment    = "payment"
+)
+
+var statementEntryOrder = map[string]int{
+	StatementEntryInvoice:    0,
+	StatementEntryCreditNote: 1,
+	StatementEntryPayment:    2,
+}
+
+// StatementFilter selects the activity of one customer in one currency from
+// the day of From to the day of To, both included.
+type StatementFilter struct {
+	CustomerID uint
+	Currency   string
+	From       time.Time
+	To         time.Time
+}
+
+// StatementEntry is one invoice, payment or credit note on a statement.
+// Invoices are debits, payments and credit notes are credits. Balance is
+// the running balance after the entry.
+type StatementEntry struct {
+	Type          string
+	ID            uint
+	Date          time.Time
+	Reference     string
+	Description   string
+	InvoiceID     uint
+	InvoiceNumber string
+	Debit         Money
+	Credit        Money
+	Balance       Money
+}
+
+// Statement is a customer's statement of account: what they owed before
+// From, every entry up to To and what they owed after each of them.
+type Statement struct {
+	Customer       Customer
+	Currency       string
+	From           time.Time
+	To             time.Time
+	OpeningBalance Money
+	Entries        []StatementEntry
+}
+
+// Settle orders the entries by date and fills in the running balance.
+func (s *Statement) Settle() {
+	sort.SliceStable(s.Entries, func(i, j int) bool {
+		a, b := s.Entries[i], s.Entries[j]
+		if !truncateDay(a.Date).Equal(truncateDay(b.Date)) {
+			return a.Date.Before(b.Date)
+		}
+		if a.Type != b.Type {
+			return statementEntryOrder[a.Type] < statementEntryOrder[b.Type]
+		}
+		return a.ID < b.ID
+	})
+
+	balance := s.OpeningBalance
+	for i := range s.Entries {
+		balance = balance.Add(s.Entries[i].Debit).Sub(s.Entries[i].Credit)
+		s.Entries[i].Balance = balance
+	}
+}
+
+// TotalDebits is the sum of the invoices on the statement.
+func (s Statement) TotalDebits() Money {
+	var total Money
+	for _, e := range s.Entries {
+		total = total.Add(e.Debit)
+	}
+	return total
+}
+
+// TotalCredits is the sum of the payments and credit notes on the statement.
+func (s Statement) TotalCredits() Money {
+	var total Money
+	for _, e := range s.Entries {
+		total = total.Add(e.Credit)
+	}
+	return total
+}
+
+// ClosingBalance is what the customer owed at the end of the statement.
+func (s Statement) ClosingBalance() Money {
+	return s.OpeningBalance.Add(s.TotalDebits()).Sub(s.TotalCredits())
+}
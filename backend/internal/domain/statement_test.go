@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatement_Settle(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 5, d, 0, 0, 0, 0, time.UTC) }
+
+	s := Statement{
+		OpeningBalance: NewMoney(100),
+		Entries: []StatementEntry{
+			{Type: StatementEntryPayment, ID: 7, Date: day(10).Add(9 * time.Hour), Credit: NewMoney(150)},
+			{Type: StatementEntryInvoice, ID: 3, Date: day(10), Debit: NewMoney(200)},
+			{Type: StatementEntryCreditNote, ID: 1, Date: day(12), Credit: NewMoney(20)},
+			{Type: StatementEntryInvoice, ID: 2, Date: day(2), Debit: NewMoney(50)},
+			{Type: StatementEntryPayment, ID: 5, Date: day(10), Credit: NewMoney(10)},
+		},
+	}
+
+	s.Settle()
+
+	var order []string
+	var balances []Money
+	for _, e := range s.Entries {
+		order = append(order, e.Type)
+		balances = append(balances, e.Balance)
+	}
+	// on the same day invoices come before payments, payments by ID
+	assert.Equal(t, []string{StatementEntryInvoice, StatementEntryInvoice, StatementEntryPayment, StatementEntryPayment, StatementEntryCreditNote}, order)
+	assert.Equal(t, uint(5), s.Entries[2].ID)
+	assert.Equal(t, []Money{NewMoney(150), NewMoney(350), NewMoney(340), NewMoney(190), NewMoney(170)}, balances)
+
+	assert.Equal(t, NewMoney(250), s.TotalDebits())
+	assert.Equal(t, NewMoney(180), s.TotalCredits())
+	assert.Equal(t, NewMoney(170), s.ClosingBalance())
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// Tax rate types. Zero-rated and exempt lines both carry no tax, but they are
+// reported separately so they stay distinguishable in the breakdown.
+const (
+	TaxTypeStandard = "standard"
+	TaxTypeReduced  = "reduced"
+	TaxTypeZero     = "zero"
+	TaxTypeExempt   = "exempt"
+)
+
+type TaxRate struct {
+	ID        uint
+	Code      string
+	Name      string
+	Type      string
+	Rate      float64 // percentage, e.g. 10 for 10%
+	IsDefault bool
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Apply returns the tax for the given amount, rounded half up to the nearest
+// minor unit.
+func (t TaxRate) Apply(amount Money) Money {
+	if t.Type == TaxTypeExempt || t.Type == TaxTypeZero {
+		return Money{}
+	}
+
+	return amount.Percent(t.Rate, RoundHalfUp)
+}
+
+// TaxBreakdown is the tax of an invoice grouped by rate.
+type TaxBreakdown struct {
+	TaxRateID     *uint
+	Code          string
+	Rate          float64
+	TaxableAmount Money
+	TaxAmount     Money
+}
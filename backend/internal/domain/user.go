@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Kinds of tokens handed out on login. Access tokens authenticate requests,
+// refresh tokens only buy a new pair of tokens.
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// User is a member of staff who may sign in to the API.
+type User struct {
+	ID           uint
+	TenantID     uint
+	Name         string
+	Email        string
+	PasswordHash string
+	Role         string
+	IsActive     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NormalizeEmail trims and lower-cases an email address, so users sign in
+// with whatever case they typed.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+type userContextKey struct{}
+
+// ContextWithUser returns a copy of ctx carrying the authenticated user.
+func ContextWithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the authenticated user of ctx, if any.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(User)
+	return user, ok
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	service services.AuditService
+}
+
+func NewAuditHandler(service services.AuditService) *AuditHandler {
+	return &AuditHandler{service: service}
+}
+
+func (h *AuditHandler) GetAuditLog(c *gin.Context) {
+	var req dto.GetAuditLogRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetAuditLog(c.Request.Context(), req)
+	if err != nil {
+		switch err {
+		case utils.ErrInvalidAuditEntity, utils.ErrAuditEntityRequired:
+			response.ValidationErrorResponse(c, err)
+		default:
+			response.InternalServerErrorResponse(c, err)
+		}
+		return
+	}
+
+	response.OKResponse(c, "successfully get audit log", resp)
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/mapper"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/middleware"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHandler struct {
+	service services.AuthService
+}
+
+func NewAuthHandler(service services.AuthService) *AuthHandler {
+	return &AuthHandler{service: service}
+}
+
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.Login(c.Request.Context(), req)
+	if err != nil {
+		authErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Logged in successfully", resp)
+}
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.Refresh(c.Request.Context(), req)
+	if err != nil {
+		authErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Token refreshed successfully", resp)
+}
+
+// Me returns the user the request was authenticated as.
+func (h *AuthHandler) Me(c *gin.Context) {
+	user, ok := middleware.CurrentUser(c)
+	if !ok {
+		response.UnauthorizedResponse(c)
+		return
+	}
+
+	response.OKResponse(c, "successfully get current user", mapper.ToUserResponse(user))
+}
+
+// authErrorResponse maps the errors shared by the auth endpoints.
+func authErrorResponse(c *gin.Context, err error) {
+	switch err {
+	case utils.ErrInvalidCredentials:
+		response.ErrorResponse(c, http.StatusUnauthorized, "INVALID_CREDENTIALS", "Invalid email or password")
+	case utils.ErrInvalidToken:
+		response.ErrorResponse(c, http.StatusUnauthorized, "INVALID_TOKEN", "Token is invalid or has expired")
+	default:
+		response.InternalServerErrorResponse(c, err)
+	}
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreditNoteHandler struct {
+	service services.CreditNoteService
+}
+
+func NewCreditNoteHandler(service services.CreditNoteService) *CreditNoteHandler {
+	return &CreditNoteHandler{service: service}
+}
+
+func (h *CreditNoteHandler) ListCreditNotes(c *gin.Context) {
+	var req dto.GetCreditNoteFilterRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetAllCreditNotes(c.Request.Context(), req)
+	if err != nil {
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get credit notes", resp)
+}
+
+func (h *CreditNoteHandler) GetCreditNote(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("credit_note_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetCreditNoteByID(c.Request.Context(), uint(id))
+	if err != nil {
+		creditNoteErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get credit note", resp)
+}
+
+func (h *CreditNoteHandler) CreateCreditNote(c *gin.Context) {
+	var req dto.CreateCreditNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.CreateCreditNote(c.Request.Context(), req)
+	if err != nil {
+		creditNoteErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "Credit note created successfully", resp)
+}
+
+func (h *CreditNoteHandler) UpdateCreditNote(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("credit_note_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.UpdateCreditNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.UpdateCreditNote(c.Request.Context(), uint(id), req); err != nil {
+		creditNoteErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Credit note updated successfully", nil)
+}
+
+func (h *CreditNoteHandler) IssueCreditNote(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("credit_note_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.IssueCreditNote(c.Request.Context(), uint(id)); err != nil {
+		creditNoteErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Credit note issued successfully", nil)
+}
+
+func (h *CreditNoteHandler) DeleteCreditNote(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("credit_note_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.DeleteCreditNote(c.Request.Context(), uint(id)); err != nil {
+		creditNoteErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Credit note deleted successfully", nil)
+}
+
+// GetCreditNotePDF streams the credit note as a PDF, like GetInvoicePDF.
+func (h *CreditNoteHandler) GetCreditNotePDF(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("credit_note_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	doc, err := h.service.RenderCreditNotePDF(c.Request.Context(), uint(id))
+	if err != nil {
+		creditNoteErrorResponse(c, err)
+		return
+	}
+
+	disposition := "inline"
+	if c.Query("download") == "true" {
+		disposition = "attachment"
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("%s; filename=%q", disposition, doc.FileName))
+	c.Data(http.StatusOK, doc.ContentType, doc.Content)
+}
+
+// creditNoteErrorResponse maps the errors shared by the credit note endpoints.
+func creditNoteErrorResponse(c *gin.Context, err error) {
+	switch err {
+	case utils.ErrInvalidCreditNoteStatus, utils.ErrInvalidCreditNoteLine, utils.ErrItemNotFound, utils.ErrTaxRateNotFound,
+		domain.ErrInvalidMoney, domain.ErrNonPositiveCredit:
+		response.ValidationErrorResponse(c, err)
+	case utils.ErrCreditNoteNotFound:
+		response.NotFoundResponse(c, "credit note")
+	case utils.ErrInvoiceNotFound:
+		response.NotFoundResponse(c, "invoice")
+	case utils.ErrCreditExceedsInvoice, domain.ErrOverCredit:
+		response.ErrorResponse(c, http.StatusUnprocessableEntity, "OVER_CREDIT", "Credit exceeds what is left on the invoice", err.Error())
+	case domain.ErrInvoiceNotCreditable:
+		response.ErrorResponse(c, http.StatusConflict, "INVOICE_NOT_CREDITABLE", "Invoice does not accept credit notes", err.Error())
+	case utils.ErrCreditNoteLocked:
+		response.ErrorResponse(c, http.StatusConflict, "CREDIT_NOTE_LOCKED", "Credit note is already issued", err.Error())
+	default:
+		response.InternalServerErrorResponse(c, err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"invoice-system/internal/applications/ports/services"
 	"invoice-system/internal/infra/adapter/http/response"
 	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -24,13 +26,18 @@ func (h *CustomerHandler) CreateCustomer(c *gin.Context) {
 		return
 	}
 
-	err := h.service.Create(req)
+	err := h.service.Create(c.Request.Context(), req)
 	if err != nil {
 		if err == utils.ErrCustomerAlreadyExists {
 			response.ConflictResponse(c, "Customer already exists", nil)
 			return
 		}
 
+		if err == utils.ErrInvalidCurrency {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
 		response.InternalServerErrorResponse(c, err)
 		return
 	}
@@ -38,13 +45,126 @@ func (h *CustomerHandler) CreateCustomer(c *gin.Context) {
 	response.CreatedResponse(c, "Customer created successfully", nil)
 }
 
-// GetAllCustomers retrieves all customers
+// GetAllCustomers retrieves a page of customers
 func (h *CustomerHandler) GetAllCustomers(c *gin.Context) {
-	customers, err := h.service.FindCustomers()
+	var req dto.GetCustomerFilterRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	customers, err := h.service.FindCustomers(c.Request.Context(), req)
 	if err != nil {
+		if err == utils.ErrInvalidSortField {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
 		response.InternalServerErrorResponse(c, err)
 		return
 	}
 
 	response.OKResponse(c, "Customers retrieved successfully", customers)
 }
+
+func (h *CustomerHandler) GetCustomer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("customer_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	customer, err := h.service.GetCustomerByID(c.Request.Context(), uint(id))
+	if err != nil {
+		if err == utils.ErrCustomerNotFound {
+			response.NotFoundResponse(c, "customer")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Customer retrieved successfully", customer)
+}
+
+func (h *CustomerHandler) UpdateCustomer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("customer_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.UpdateCustomerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+	req.ID = uint(id)
+
+	err = h.service.Update(c.Request.Context(), req)
+	if err != nil {
+		switch err {
+		case utils.ErrCustomerNotFound:
+			response.NotFoundResponse(c, "customer")
+			return
+		case utils.ErrCustomerAlreadyExists:
+			response.ConflictResponse(c, "Customer already exists", nil)
+			return
+		case utils.ErrInvalidCurrency:
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Customer updated successfully", nil)
+}
+
+func (h *CustomerHandler) DeleteCustomer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("customer_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	err = h.service.Delete(c.Request.Context(), uint(id))
+	if err != nil {
+		switch err {
+		case utils.ErrCustomerNotFound:
+			response.NotFoundResponse(c, "customer")
+			return
+		case utils.ErrCustomerHasUnpaidInvoices:
+			response.ErrorResponse(c, http.StatusConflict, "CUSTOMER_HAS_UNPAID_INVOICES", "Customer cannot be deleted", err.Error())
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Customer deleted successfully", nil)
+}
+
+func (h *CustomerHandler) RestoreCustomer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("customer_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	err = h.service.Restore(c.Request.Context(), uint(id))
+	if err != nil {
+		if err == utils.ErrCustomerNotFound {
+			response.NotFoundResponse(c, "customer")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Customer restored successfully", nil)
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"errors"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExchangeRateHandler struct {
+	service services.ExchangeRateService
+}
+
+func NewExchangeRateHandler(service services.ExchangeRateService) *ExchangeRateHandler {
+	return &ExchangeRateHandler{service: service}
+}
+
+func (h *ExchangeRateHandler) GetRates(c *gin.Context) {
+	rates, err := h.service.GetRates(c.Request.Context(), c.Query("currency"))
+	if err != nil {
+		if err == utils.ErrInvalidCurrency {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get exchange rates", rates)
+}
+
+func (h *ExchangeRateHandler) CreateRate(c *gin.Context) {
+	var req dto.ExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.CreateRate(c.Request.Context(), req); err != nil {
+		if err == utils.ErrInvalidCurrency {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "exchange rate saved successfully", nil)
+}
+
+// ImportRates accepts a CSV either as a multipart "file" field or as the raw
+// request body.
+func (h *ExchangeRateHandler) ImportRates(c *gin.Context) {
+	var body io.Reader = c.Request.Body
+
+	if file, err := c.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+		defer f.Close()
+		body = f
+	}
+
+	resp, err := h.service.ImportRates(c.Request.Context(), body)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidRatesImport) {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "exchange rates imported successfully", resp)
+}
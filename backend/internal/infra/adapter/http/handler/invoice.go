@@ -3,9 +3,13 @@ package handler
 import (
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/middleware"
 	"invoice-system/internal/infra/adapter/http/response"
 	"invoice-system/internal/utils"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -51,6 +55,19 @@ func (h *InvoiceHandler) ListInvoices(c *gin.Context) {
 	}
 
 	req.Status = c.Query("status")
+	req.Currency = strings.ToUpper(c.Query("currency"))
+
+	if overdue := c.Query("overdue"); overdue != "" {
+		if v, err := strconv.ParseBool(overdue); err == nil {
+			req.Overdue = v
+		}
+	}
+
+	if daysOverdue := c.Query("days_overdue_gte"); daysOverdue != "" {
+		if days, err := strconv.Atoi(daysOverdue); err == nil && days >= 0 {
+			req.DaysOverdueGte = &days
+		}
+	}
 
 	if cursor := c.Query("cursor"); cursor != "" {
 		if t, err := time.Parse(time.RFC3339, cursor); err == nil {
@@ -70,7 +87,7 @@ func (h *InvoiceHandler) ListInvoices(c *gin.Context) {
 		}
 	}
 
-	resp, err := h.service.GetAllInvoices(req)
+	resp, err := h.service.GetAllInvoices(c.Request.Context(), req)
 
 	if err != nil {
 		response.InternalServerErrorResponse(c, err)
@@ -87,13 +104,19 @@ func (h *InvoiceHandler) CreateInvoice(c *gin.Context) {
 		return
 	}
 
-	err := h.service.CreateInvoice(req)
+	resp, err := h.service.CreateInvoice(c.Request.Context(), req)
 	if err != nil {
+		switch err {
+		case utils.ErrTaxRateNotFound, utils.ErrInvalidCurrency, utils.ErrCustomerNotFound, utils.ErrInvalidInvoiceStatus, utils.ErrItemNotFound, utils.ErrItemPriceMissing, domain.ErrInvalidMoney:
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
 		response.InternalServerErrorResponse(c, err)
 		return
 	}
 
-	response.CreatedResponse(c, "Invoice created successfully", nil)
+	response.CreatedResponse(c, "Invoice created successfully", resp)
 }
 
 func (h *InvoiceHandler) GetInvoiceDetails(c *gin.Context) {
@@ -105,7 +128,7 @@ func (h *InvoiceHandler) GetInvoiceDetails(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.service.GetInvoiceByID(uint(invId))
+	resp, err := h.service.GetInvoiceByID(c.Request.Context(), uint(invId))
 	if err != nil {
 		if err == utils.ErrInvoiceNotFound {
 			response.NotFoundResponse(c, "invoice not found")
@@ -115,6 +138,7 @@ func (h *InvoiceHandler) GetInvoiceDetails(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", invoiceETag(resp.Version))
 	response.OKResponse(c, "successfully get invoice details", resp)
 }
 
@@ -133,12 +157,194 @@ func (h *InvoiceHandler) UpdateInvoice(c *gin.Context) {
 		return
 	}
 
-	err = h.service.UpdateInvoice(uint(id), req)
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" {
+		version, ok := parseInvoiceETag(ifMatch)
+		if !ok {
+			response.ErrorResponse(c, http.StatusBadRequest, "INVALID_IF_MATCH", "If-Match must be the ETag of the invoice")
+			return
+		}
+		req.Version = &version
+	}
+
+	err = h.service.UpdateInvoice(c.Request.Context(), uint(id), req)
 
 	if err != nil {
+		switch err {
+		case utils.ErrTaxRateNotFound, utils.ErrInvalidCurrency, utils.ErrCustomerNotFound, utils.ErrItemNotFound, utils.ErrItemPriceMissing, domain.ErrInvalidMoney:
+			response.ValidationErrorResponse(c, err)
+			return
+		case utils.ErrInvoiceNotFound:
+			response.NotFoundResponse(c, "invoice")
+			return
+		case utils.ErrInvoiceLocked:
+			response.ErrorResponse(c, http.StatusConflict, "INVOICE_LOCKED", "Invoice cannot be edited", err.Error())
+			return
+		case utils.ErrInvalidStatusTransition:
+			invalidTransitionResponse(c, err)
+			return
+		case utils.ErrInvoiceVersionRequired:
+			response.ErrorResponse(c, http.StatusPreconditionRequired, "PRECONDITION_REQUIRED", "Send If-Match or the version of the invoice being edited", err.Error())
+			return
+		case utils.ErrInvoiceVersionConflict:
+			response.ConflictResponse(c, "Invoice was changed by someone else, reload it and try again", nil)
+			return
+		}
+
 		response.InternalServerErrorResponse(c, err)
 		return
 	}
 
 	response.OKResponse(c, "Invoice updated successfully", nil)
 }
+
+// invoiceETag is the entity tag of a version of an invoice.
+func invoiceETag(version uint) string {
+	return `"` + strconv.FormatUint(uint64(version), 10) + `"`
+}
+
+// parseInvoiceETag reads the version back from an If-Match header. Weak tags
+// are accepted too, since the version covers the whole invoice.
+func parseInvoiceETag(header string) (uint, bool) {
+	tag := strings.TrimPrefix(strings.TrimSpace(header), "W/")
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+
+	version, err := strconv.ParseUint(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version == 0 {
+		return 0, false
+	}
+
+	return uint(version), true
+}
+
+func (h *InvoiceHandler) UpdateInvoiceStatus(c *gin.Context) {
+	invoiceID := c.Param("invoice_id")
+
+	id, err := strconv.Atoi(invoiceID)
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.UpdateInvoiceStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	// voiding through the status endpoint needs the same permission as the
+	// void endpoint
+	if domain.NormalizeInvoiceStatus(req.Status) == domain.InvoiceStatusVoid {
+		if user, ok := middleware.CurrentUser(c); !ok || !user.Can(domain.PermInvoicesVoid) {
+			response.ForbiddenResponse(c)
+			return
+		}
+	}
+
+	err = h.service.UpdateInvoiceStatus(c.Request.Context(), uint(id), req)
+	if err != nil {
+		switch err {
+		case utils.ErrInvalidInvoiceStatus:
+			response.ValidationErrorResponse(c, err)
+			return
+		case utils.ErrInvoiceNotFound:
+			response.NotFoundResponse(c, "invoice")
+			return
+		case utils.ErrInvalidStatusTransition:
+			invalidTransitionResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Invoice status updated successfully", nil)
+}
+
+// DuplicateInvoice copies the invoice into a new draft. The body is optional.
+func (h *InvoiceHandler) DuplicateInvoice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.DuplicateInvoiceRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+	}
+
+	resp, err := h.service.DuplicateInvoice(c.Request.Context(), uint(id), req)
+	if err != nil {
+		switch err {
+		case utils.ErrInvoiceNotFound:
+			response.NotFoundResponse(c, "invoice")
+			return
+		case utils.ErrTaxRateNotFound, utils.ErrInvalidCurrency, utils.ErrCustomerNotFound, utils.ErrItemNotFound, utils.ErrItemPriceMissing, domain.ErrInvalidMoney:
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "Invoice duplicated successfully", resp)
+}
+
+func (h *InvoiceHandler) VoidInvoice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.VoidInvoice(c.Request.Context(), uint(id)); err != nil {
+		switch err {
+		case utils.ErrInvoiceNotFound:
+			response.NotFoundResponse(c, "invoice")
+			return
+		case utils.ErrInvalidStatusTransition:
+			invalidTransitionResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Invoice voided successfully", nil)
+}
+
+func (h *InvoiceHandler) DeleteInvoice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.DeleteInvoice(c.Request.Context(), uint(id)); err != nil {
+		switch err {
+		case utils.ErrInvoiceNotFound:
+			response.NotFoundResponse(c, "invoice")
+			return
+		case utils.ErrInvoiceHasSettlements:
+			response.ErrorResponse(c, http.StatusConflict, "INVOICE_SETTLED", "Invoice cannot be deleted", err.Error())
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Invoice deleted successfully", nil)
+}
+
+func invalidTransitionResponse(c *gin.Context, err error) {
+	response.ErrorResponse(c, http.StatusConflict, "INVALID_STATUS_TRANSITION", "Invoice status cannot be changed", err.Error())
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"fmt"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InvoicePDFHandler struct {
+	service services.InvoicePDFService
+}
+
+func NewInvoicePDFHandler(service services.InvoicePDFService) *InvoicePDFHandler {
+	return &InvoicePDFHandler{service: service}
+}
+
+// GetInvoicePDF streams the invoice as a PDF. Pass ?download=true to have
+// browsers save it instead of displaying it.
+func (h *InvoicePDFHandler) GetInvoicePDF(c *gin.Context) {
+	invoiceID, err := strconv.Atoi(c.Param("invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	doc, err := h.service.RenderInvoicePDF(c.Request.Context(), uint(invoiceID))
+	if err != nil {
+		if err == utils.ErrInvoiceNotFound {
+			response.NotFoundResponse(c, "invoice")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	disposition := "inline"
+	if c.Query("download") == "true" {
+		disposition = "attachment"
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("%s; filename=%q", disposition, doc.FileName))
+	c.Data(http.StatusOK, doc.ContentType, doc.Content)
+}
@@ -4,9 +4,11 @@ import (
 	"invoice-system/internal/applications/dto"
 	"invoice-system/internal/applications/mapper"
 	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/adapter/http/response"
 	"invoice-system/internal/utils"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -33,10 +35,10 @@ func (h *ItemHandler) GetItems(c *gin.Context) {
 		}
 	}
 
-	items, err := h.service.GetAllItems(req.NameOrType, req.Limit)
+	items, err := h.service.GetAllItems(c.Request.Context(), req.NameOrType, req.Limit)
 
 	if err != nil {
-		response.InternalServerErrorResponse(nil, err)
+		response.InternalServerErrorResponse(c, err)
 		return
 	}
 
@@ -51,11 +53,15 @@ func (h *ItemHandler) CreateItem(c *gin.Context) {
 		return
 	}
 
-	err := h.service.AddItem(req)
+	err := h.service.AddItem(c.Request.Context(), req)
 
 	if err != nil {
-		if err == utils.ErrItemAlreadyExists {
-			response.ConflictResponse(c, "item with the same name already exists", err)
+		switch err {
+		case utils.ErrItemAlreadyExists:
+			response.ConflictResponse(c, "item with the same SKU already exists", err)
+			return
+		case domain.ErrInvalidMoney:
+			response.ValidationErrorResponse(c, err)
 			return
 		}
 
@@ -65,3 +71,92 @@ func (h *ItemHandler) CreateItem(c *gin.Context) {
 
 	response.CreatedResponse(c, "item created successfully", nil)
 }
+
+func (h *ItemHandler) GetItem(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	item, err := h.service.GetItemByID(c.Request.Context(), uint(id))
+	if err != nil {
+		if err == utils.ErrItemNotFound {
+			response.NotFoundResponse(c, "item")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "success getting item", mapper.ToDTOItemResponse(item))
+}
+
+func (h *ItemHandler) UpdateItem(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.DTOUpdateItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+	req.ID = uint(id)
+
+	if err := h.service.UpdateItem(c.Request.Context(), req); err != nil {
+		switch err {
+		case utils.ErrItemNotFound:
+			response.NotFoundResponse(c, "item")
+			return
+		case utils.ErrItemAlreadyExists:
+			response.ConflictResponse(c, "item with the same SKU already exists", err)
+			return
+		case domain.ErrInvalidMoney:
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "item updated successfully", nil)
+}
+
+func (h *ItemHandler) GetItemPrice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.ItemPriceRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	date := req.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	price, err := h.service.ResolvePrice(c.Request.Context(), uint(id), req.CustomerID, date)
+	if err != nil {
+		switch err {
+		case utils.ErrItemNotFound:
+			response.NotFoundResponse(c, "item")
+		case utils.ErrCustomerNotFound:
+			response.ValidationErrorResponse(c, err)
+		default:
+			response.InternalServerErrorResponse(c, err)
+		}
+		return
+	}
+
+	response.OKResponse(c, "success getting item price", mapper.ToItemPriceResponse(price, req.CustomerID, date))
+}
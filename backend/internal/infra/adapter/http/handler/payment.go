@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentHandler struct {
+	service services.PaymentService
+}
+
+func NewPaymentHandler(service services.PaymentService) *PaymentHandler {
+	return &PaymentHandler{service: service}
+}
+
+func (h *PaymentHandler) GetInvoicePayments(c *gin.Context) {
+	invoiceID, err := strconv.Atoi(c.Param("invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetInvoicePayments(c.Request.Context(), uint(invoiceID))
+	if err != nil {
+		if err == utils.ErrInvoiceNotFound {
+			response.NotFoundResponse(c, "invoice")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get invoice payments", resp)
+}
+
+func (h *PaymentHandler) CreatePayment(c *gin.Context) {
+	invoiceID, err := strconv.Atoi(c.Param("invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.CreatePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.CreatePayment(c.Request.Context(), uint(invoiceID), req)
+	if err != nil {
+		switch err {
+		case utils.ErrInvalidPaymentMethod, domain.ErrNonPositivePayment:
+			response.ValidationErrorResponse(c, err)
+			return
+		case utils.ErrInvoiceNotFound:
+			response.NotFoundResponse(c, "invoice")
+			return
+		case domain.ErrOverpayment:
+			response.ErrorResponse(c, http.StatusUnprocessableEntity, "OVERPAYMENT", "Payment exceeds the balance due", err.Error())
+			return
+		case domain.ErrInvoiceNotPayable:
+			response.ErrorResponse(c, http.StatusConflict, "INVOICE_NOT_PAYABLE", "Invoice does not accept payments", err.Error())
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "Payment recorded successfully", resp)
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PriceListHandler struct {
+	service services.PriceListService
+}
+
+func NewPriceListHandler(service services.PriceListService) *PriceListHandler {
+	return &PriceListHandler{service: service}
+}
+
+func (h *PriceListHandler) GetPriceLists(c *gin.Context) {
+	lists, err := h.service.GetAllPriceLists(c.Request.Context())
+	if err != nil {
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get price lists", lists)
+}
+
+func (h *PriceListHandler) GetPriceList(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("price_list_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	list, err := h.service.GetPriceListByID(c.Request.Context(), uint(id))
+	if err != nil {
+		if err == utils.ErrPriceListNotFound {
+			response.NotFoundResponse(c, "price list")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get price list", list)
+}
+
+func (h *PriceListHandler) CreatePriceList(c *gin.Context) {
+	var req dto.PriceListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	list, err := h.service.CreatePriceList(c.Request.Context(), req)
+	if err != nil {
+		switch err {
+		case utils.ErrInvalidPriceListWindow:
+			response.ValidationErrorResponse(c, err)
+		case utils.ErrPriceListAlreadyExists:
+			response.ConflictResponse(c, "price list with the same name already exists", nil)
+		default:
+			response.InternalServerErrorResponse(c, err)
+		}
+		return
+	}
+
+	response.CreatedResponse(c, "price list created successfully", list)
+}
+
+func (h *PriceListHandler) UpdatePriceList(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("price_list_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.PriceListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.UpdatePriceList(c.Request.Context(), uint(id), req); err != nil {
+		switch err {
+		case utils.ErrPriceListNotFound:
+			response.NotFoundResponse(c, "price list")
+		case utils.ErrInvalidPriceListWindow:
+			response.ValidationErrorResponse(c, err)
+		case utils.ErrPriceListAlreadyExists:
+			response.ConflictResponse(c, "price list with the same name already exists", nil)
+		default:
+			response.InternalServerErrorResponse(c, err)
+		}
+		return
+	}
+
+	response.OKResponse(c, "price list updated successfully", nil)
+}
+
+func (h *PriceListHandler) SetItemPrice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("price_list_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.PriceListItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.SetItemPrice(c.Request.Context(), uint(id), req); err != nil {
+		switch err {
+		case utils.ErrPriceListNotFound:
+			response.NotFoundResponse(c, "price list")
+		case utils.ErrItemNotFound, domain.ErrInvalidMoney:
+			response.ValidationErrorResponse(c, err)
+		default:
+			response.InternalServerErrorResponse(c, err)
+		}
+		return
+	}
+
+	response.OKResponse(c, "item price saved successfully", nil)
+}
+
+func (h *PriceListHandler) RemoveItemPrice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("price_list_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	itemID, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.RemoveItemPrice(c.Request.Context(), uint(id), uint(itemID)); err != nil {
+		if err == utils.ErrItemNotFound {
+			response.NotFoundResponse(c, "price list item")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "item price removed successfully", nil)
+}
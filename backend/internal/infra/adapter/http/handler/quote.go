@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type QuoteHandler struct {
+	service services.QuoteService
+}
+
+func NewQuoteHandler(service services.QuoteService) *QuoteHandler {
+	return &QuoteHandler{service: service}
+}
+
+func (h *QuoteHandler) ListQuotes(c *gin.Context) {
+	var req dto.GetQuoteFilterRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetAllQuotes(c.Request.Context(), req)
+	if err != nil {
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get quotes", resp)
+}
+
+func (h *QuoteHandler) GetQuote(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("quote_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetQuoteByID(c.Request.Context(), uint(id))
+	if err != nil {
+		quoteErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get quote", resp)
+}
+
+func (h *QuoteHandler) CreateQuote(c *gin.Context) {
+	var req dto.QuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.CreateQuote(c.Request.Context(), req)
+	if err != nil {
+		quoteErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "Quote created successfully", resp)
+}
+
+func (h *QuoteHandler) UpdateQuote(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("quote_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.QuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.UpdateQuote(c.Request.Context(), uint(id), req); err != nil {
+		quoteErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Quote updated successfully", nil)
+}
+
+func (h *QuoteHandler) UpdateQuoteStatus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("quote_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.UpdateQuoteStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.UpdateQuoteStatus(c.Request.Context(), uint(id), req); err != nil {
+		quoteErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Quote status updated successfully", nil)
+}
+
+// ConvertQuote creates an invoice from the quote. The body is optional.
+func (h *QuoteHandler) ConvertQuote(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("quote_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.ConvertQuoteRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+	}
+
+	resp, err := h.service.ConvertQuote(c.Request.Context(), uint(id), req)
+	if err != nil {
+		quoteErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "Quote converted into an invoice", resp)
+}
+
+// quoteErrorResponse maps the errors shared by the quote endpoints.
+func quoteErrorResponse(c *gin.Context, err error) {
+	switch err {
+	case utils.ErrInvalidQuoteStatus, utils.ErrInvalidQuoteExpiry, utils.ErrInvalidInvoiceStatus, utils.ErrTaxRateNotFound,
+		utils.ErrInvalidCurrency, utils.ErrCustomerNotFound, utils.ErrItemNotFound, utils.ErrItemPriceMissing, domain.ErrInvalidMoney:
+		response.ValidationErrorResponse(c, err)
+	case utils.ErrQuoteNotFound:
+		response.NotFoundResponse(c, "quote")
+	case utils.ErrInvalidQuoteTransition:
+		response.ErrorResponse(c, http.StatusConflict, "INVALID_STATUS_TRANSITION", "Quote status cannot be changed", err.Error())
+	case utils.ErrQuoteLocked:
+		response.ErrorResponse(c, http.StatusConflict, "QUOTE_LOCKED", "Quote is no longer a draft", err.Error())
+	case utils.ErrQuoteExpired:
+		response.ErrorResponse(c, http.StatusConflict, "QUOTE_EXPIRED", "Quote has expired", err.Error())
+	case utils.ErrQuoteNotConvertible:
+		response.ErrorResponse(c, http.StatusConflict, "QUOTE_NOT_CONVERTIBLE", "Quote can't be converted in its current status", err.Error())
+	case utils.ErrQuoteAlreadyConverted:
+		response.ConflictResponse(c, "quote has already been converted into an invoice", nil)
+	default:
+		response.InternalServerErrorResponse(c, err)
+	}
+}
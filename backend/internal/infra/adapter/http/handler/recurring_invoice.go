@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecurringInvoiceHandler struct {
+	service services.RecurringInvoiceService
+}
+
+func NewRecurringInvoiceHandler(service services.RecurringInvoiceService) *RecurringInvoiceHandler {
+	return &RecurringInvoiceHandler{service: service}
+}
+
+func (h *RecurringInvoiceHandler) ListRecurringInvoices(c *gin.Context) {
+	var req dto.GetRecurringInvoiceFilterRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetAllRecurringInvoices(c.Request.Context(), req)
+	if err != nil {
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get recurring invoices", resp)
+}
+
+func (h *RecurringInvoiceHandler) GetRecurringInvoice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("recurring_invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetRecurringInvoiceByID(c.Request.Context(), uint(id))
+	if err != nil {
+		recurringInvoiceErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get recurring invoice", resp)
+}
+
+func (h *RecurringInvoiceHandler) CreateRecurringInvoice(c *gin.Context) {
+	var req dto.CreateRecurringInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.CreateRecurringInvoice(c.Request.Context(), req)
+	if err != nil {
+		recurringInvoiceErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "Recurring invoice created successfully", resp)
+}
+
+func (h *RecurringInvoiceHandler) UpdateRecurringInvoice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("recurring_invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.UpdateRecurringInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.UpdateRecurringInvoice(c.Request.Context(), uint(id), req); err != nil {
+		recurringInvoiceErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Recurring invoice updated successfully", nil)
+}
+
+func (h *RecurringInvoiceHandler) UpdateRecurringInvoiceStatus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("recurring_invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.UpdateRecurringInvoiceStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.UpdateRecurringInvoiceStatus(c.Request.Context(), uint(id), req); err != nil {
+		recurringInvoiceErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Recurring invoice status updated successfully", nil)
+}
+
+func (h *RecurringInvoiceHandler) DeleteRecurringInvoice(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("recurring_invoice_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	if err := h.service.DeleteRecurringInvoice(c.Request.Context(), uint(id)); err != nil {
+		recurringInvoiceErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "Recurring invoice deleted successfully", nil)
+}
+
+// recurringInvoiceErrorResponse maps the errors shared by the recurring
+// invoice endpoints.
+func recurringInvoiceErrorResponse(c *gin.Context, err error) {
+	switch err {
+	case utils.ErrInvalidRecurringSchedule, utils.ErrInvalidRecurringStatus, utils.ErrInvalidInvoiceStatus, utils.ErrTaxRateNotFound,
+		utils.ErrInvalidCurrency, utils.ErrCustomerNotFound, utils.ErrItemNotFound, utils.ErrItemPriceMissing, domain.ErrInvalidMoney:
+		response.ValidationErrorResponse(c, err)
+	case utils.ErrRecurringInvoiceNotFound:
+		response.NotFoundResponse(c, "recurring invoice")
+	case utils.ErrRecurringInvoiceCompleted:
+		response.ErrorResponse(c, http.StatusConflict, "RECURRING_INVOICE_COMPLETED", "Recurring invoice has completed its schedule", err.Error())
+	case utils.ErrRecurringRunConflict:
+		response.ConflictResponse(c, "recurring invoice is being generated, try again", nil)
+	default:
+		response.InternalServerErrorResponse(c, err)
+	}
+}
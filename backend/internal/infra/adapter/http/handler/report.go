@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportHandler struct {
+	service services.ReportService
+}
+
+func NewReportHandler(service services.ReportService) *ReportHandler {
+	return &ReportHandler{service: service}
+}
+
+func (h *ReportHandler) GetInvoiceTotals(c *gin.Context) {
+	from, to, err := parseDateRangeQuery(c)
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	req := dto.ReportFilterRequest{From: from, To: to}
+
+	resp, err := h.service.GetInvoiceTotals(c.Request.Context(), req)
+	if err != nil {
+		if errors.Is(err, utils.ErrExchangeRateNotFound) {
+			response.ErrorResponse(c, http.StatusUnprocessableEntity, "EXCHANGE_RATE_MISSING", "Missing exchange rate", err.Error())
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get invoice totals", resp)
+}
+
+// GetAgingReport returns the receivables aging as JSON, or as a CSV file
+// with ?format=csv.
+func (h *ReportHandler) GetAgingReport(c *gin.Context) {
+	asOf, err := parseDateParam(c, "as_of")
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	req := dto.AgingReportRequest{AsOf: asOf}
+
+	if customerID := c.Query("customer_id"); customerID != "" {
+		id, err := strconv.ParseUint(customerID, 10, 0)
+		if err != nil {
+			response.ValidationErrorResponse(c, err)
+			return
+		}
+		cid := uint(id)
+		req.CustomerID = &cid
+	}
+
+	switch c.DefaultQuery("format", "json") {
+	case "json":
+		resp, err := h.service.GetAgingReport(c.Request.Context(), req)
+		if err != nil {
+			response.InternalServerErrorResponse(c, err)
+			return
+		}
+
+		response.OKResponse(c, "successfully get aging report", resp)
+	case "csv":
+		doc, err := h.service.ExportAgingReportCSV(c.Request.Context(), req)
+		if err != nil {
+			response.InternalServerErrorResponse(c, err)
+			return
+		}
+
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", doc.FileName))
+		c.Data(http.StatusOK, doc.ContentType, doc.Content)
+	default:
+		response.ValidationErrorResponse(c, errors.New("format must be json or csv"))
+	}
+}
+
+// GetRevenueReport returns what was billed, paid and is still unpaid per
+// period and currency.
+func (h *ReportHandler) GetRevenueReport(c *gin.Context) {
+	req, err := periodReportRequest(c)
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetRevenueReport(c.Request.Context(), req)
+	if err != nil {
+		reportErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get revenue report", resp)
+}
+
+// GetTaxReport returns the tax billed per period, currency and tax rate.
+func (h *ReportHandler) GetTaxReport(c *gin.Context) {
+	req, err := periodReportRequest(c)
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetTaxReport(c.Request.Context(), req)
+	if err != nil {
+		reportErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get tax report", resp)
+}
+
+func (h *ReportHandler) GetTopCustomers(c *gin.Context) {
+	req, err := topSalesRequest(c)
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetTopCustomers(c.Request.Context(), req)
+	if err != nil {
+		reportErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get top customers", resp)
+}
+
+func (h *ReportHandler) GetTopItems(c *gin.Context) {
+	req, err := topSalesRequest(c)
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.GetTopItems(c.Request.Context(), req)
+	if err != nil {
+		reportErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get top items", resp)
+}
+
+func periodReportRequest(c *gin.Context) (dto.PeriodReportRequest, error) {
+	from, to, err := parseDateRangeQuery(c)
+	if err != nil {
+		return dto.PeriodReportRequest{}, err
+	}
+
+	return dto.PeriodReportRequest{
+		From:     from,
+		To:       to,
+		Period:   c.Query("period"),
+		Currency: c.Query("currency"),
+	}, nil
+}
+
+func topSalesRequest(c *gin.Context) (dto.TopSalesRequest, error) {
+	from, to, err := parseDateRangeQuery(c)
+	if err != nil {
+		return dto.TopSalesRequest{}, err
+	}
+
+	req := dto.TopSalesRequest{
+		From:     from,
+		To:       to,
+		Currency: c.Query("currency"),
+		SortBy:   c.Query("sort_by"),
+	}
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil {
+			req.Limit = limit
+		}
+	}
+
+	return req, nil
+}
+
+func reportErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, utils.ErrInvalidReportPeriod), errors.Is(err, utils.ErrInvalidSortField):
+		response.ValidationErrorResponse(c, err)
+	default:
+		response.InternalServerErrorResponse(c, err)
+	}
+}
+
+// parseDateRangeQuery reads the from and to query parameters of a report.
+func parseDateRangeQuery(c *gin.Context) (from, to *time.Time, err error) {
+	if from, err = parseDateParam(c, "from"); err != nil {
+		return nil, nil, err
+	}
+	if to, err = parseDateParam(c, "to"); err != nil {
+		return nil, nil, err
+	}
+
+	return from, to, nil
+}
+
+// parseDateParam reads a date query parameter given either as YYYY-MM-DD,
+// a day in local time, or as an RFC3339 timestamp. A missing parameter is
+// nil, a value in neither format is an error.
+func parseDateParam(c *gin.Context, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, utils.ErrInvalidDate)
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StatementHandler struct {
+	service services.StatementService
+}
+
+func NewStatementHandler(service services.StatementService) *StatementHandler {
+	return &StatementHandler{service: service}
+}
+
+// GetCustomerStatement returns the customer's statement of account as JSON,
+// or as a file with ?format=csv or ?format=pdf.
+func (h *StatementHandler) GetCustomerStatement(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("customer_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	from, to, err := parseDateRangeQuery(c)
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	req := dto.StatementRequest{
+		From:     from,
+		To:       to,
+		Currency: c.Query("currency"),
+	}
+
+	var doc dto.DocumentResponse
+	switch c.DefaultQuery("format", "json") {
+	case "json":
+		resp, err := h.service.GetCustomerStatement(c.Request.Context(), uint(id), req)
+		if err != nil {
+			statementErrorResponse(c, err)
+			return
+		}
+
+		response.OKResponse(c, "successfully get customer statement", resp)
+		return
+	case "csv":
+		doc, err = h.service.ExportStatementCSV(c.Request.Context(), uint(id), req)
+	case "pdf":
+		doc, err = h.service.RenderStatementPDF(c.Request.Context(), uint(id), req)
+	default:
+		response.ValidationErrorResponse(c, errors.New("format must be json, csv or pdf"))
+		return
+	}
+
+	if err != nil {
+		statementErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", doc.FileName))
+	c.Data(http.StatusOK, doc.ContentType, doc.Content)
+}
+
+func statementErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, utils.ErrCustomerNotFound):
+		response.NotFoundResponse(c, "customer")
+	case errors.Is(err, utils.ErrInvalidStatementPeriod):
+		response.ValidationErrorResponse(c, err)
+	default:
+		response.InternalServerErrorResponse(c, err)
+	}
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TaxHandler struct {
+	service services.TaxService
+}
+
+func NewTaxHandler(service services.TaxService) *TaxHandler {
+	return &TaxHandler{service: service}
+}
+
+func (h *TaxHandler) GetTaxRates(c *gin.Context) {
+	rates, err := h.service.GetAllTaxRates(c.Request.Context())
+	if err != nil {
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get tax rates", rates)
+}
+
+func (h *TaxHandler) GetTaxRate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("tax_rate_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	rate, err := h.service.GetTaxRateByID(c.Request.Context(), uint(id))
+	if err != nil {
+		if err == utils.ErrTaxRateNotFound {
+			response.NotFoundResponse(c, "tax rate")
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get tax rate", rate)
+}
+
+func (h *TaxHandler) CreateTaxRate(c *gin.Context) {
+	var req dto.TaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	rate, err := h.service.CreateTaxRate(c.Request.Context(), req)
+	if err != nil {
+		if err == utils.ErrTaxRateAlreadyExists {
+			response.ConflictResponse(c, "tax rate with the same code already exists", nil)
+			return
+		}
+
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "tax rate created successfully", rate)
+}
+
+func (h *TaxHandler) UpdateTaxRate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("tax_rate_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.TaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	err = h.service.UpdateTaxRate(c.Request.Context(), uint(id), req)
+	if err != nil {
+		switch err {
+		case utils.ErrTaxRateNotFound:
+			response.NotFoundResponse(c, "tax rate")
+		case utils.ErrTaxRateAlreadyExists:
+			response.ConflictResponse(c, "tax rate with the same code already exists", nil)
+		default:
+			response.InternalServerErrorResponse(c, err)
+		}
+		return
+	}
+
+	response.OKResponse(c, "tax rate updated successfully", nil)
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/infra/adapter/http/response"
+	"invoice-system/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserHandler struct {
+	service services.UserService
+}
+
+func NewUserHandler(service services.UserService) *UserHandler {
+	return &UserHandler{service: service}
+}
+
+func (h *UserHandler) GetUsers(c *gin.Context) {
+	resp, err := h.service.GetAllUsers(c.Request.Context())
+	if err != nil {
+		response.InternalServerErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "successfully get users", resp)
+}
+
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	var req dto.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.CreateUser(c.Request.Context(), req)
+	if err != nil {
+		userErrorResponse(c, err)
+		return
+	}
+
+	response.CreatedResponse(c, "User created successfully", resp)
+}
+
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	var req dto.UpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationErrorResponse(c, err)
+		return
+	}
+
+	resp, err := h.service.UpdateUser(c.Request.Context(), uint(id), req)
+	if err != nil {
+		userErrorResponse(c, err)
+		return
+	}
+
+	response.OKResponse(c, "User updated successfully", resp)
+}
+
+// userErrorResponse maps the errors shared by the user endpoints.
+func userErrorResponse(c *gin.Context, err error) {
+	switch err {
+	case utils.ErrInvalidRole, utils.ErrPasswordTooShort:
+		response.ValidationErrorResponse(c, err)
+	case utils.ErrUserNotFound:
+		response.NotFoundResponse(c, "user")
+	case utils.ErrUserAlreadyExists:
+		response.ConflictResponse(c, err.Error(), nil)
+	case utils.ErrLastAdmin:
+		response.ErrorResponse(c, http.StatusConflict, "LAST_ADMIN", "Cannot remove the last admin", err.Error())
+	default:
+		response.InternalServerErrorResponse(c, err)
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Authenticate rejects requests without a valid access token in the
+// Authorization header. The user the token was issued to is put on the
+// request context, where CurrentUser and domain.UserFromContext find it, and
+// the request is scoped to the user's organization. A TenantHeader naming
+// another organization is refused.
+func Authenticate(auth services.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := bearerToken(c.GetHeader("Authorization"))
+		if !ok {
+			response.UnauthorizedResponse(c)
+			c.Abort()
+			return
+		}
+
+		user, err := auth.Authenticate(c.Request.Context(), token)
+		if err != nil {
+			response.UnauthorizedResponse(c)
+			c.Abort()
+			return
+		}
+
+		tenantID, ok, valid := headerTenant(c)
+		if !valid {
+			invalidTenantResponse(c)
+			c.Abort()
+			return
+		}
+		if ok && tenantID != user.TenantID {
+			response.ErrorResponse(c, http.StatusForbidden, "TENANT_MISMATCH", "The user does not belong to this organization")
+			c.Abort()
+			return
+		}
+
+		ctx := domain.ContextWithUser(c.Request.Context(), user)
+		c.Request = c.Request.WithContext(domain.ContextWithTenant(ctx, user.TenantID))
+		c.Next()
+	}
+}
+
+// CurrentUser returns the user Authenticate let the request through for.
+func CurrentUser(c *gin.Context) (domain.User, bool) {
+	return domain.UserFromContext(c.Request.Context())
+}
+
+// bearerToken extracts the token of a "Bearer <token>" header value.
+func bearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(header), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"invoice-system/internal/applications/dto"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubAuthService accepts the single token "good".
+type stubAuthService struct{}
+
+func (stubAuthService) Login(context.Context, dto.LoginRequest) (dto.TokenResponse, error) {
+	return dto.TokenResponse{}, nil
+}
+
+func (stubAuthService) Refresh(context.Context, dto.RefreshTokenRequest) (dto.TokenResponse, error) {
+	return dto.TokenResponse{}, nil
+}
+
+func (stubAuthService) Authenticate(_ context.Context, token string) (domain.User, error) {
+	if token != "good" {
+		return domain.User{}, utils.ErrInvalidToken
+	}
+	return domain.User{ID: 7, TenantID: 1, Email: "admin@example.com"}, nil
+}
+
+func (stubAuthService) BootstrapUser(context.Context, string, string, string) error {
+	return nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/private", Authenticate(stubAuthService{}), func(c *gin.Context) {
+		user, ok := domain.UserFromContext(c.Request.Context())
+		if !ok {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.String(http.StatusOK, user.Email)
+	})
+
+	tests := []struct {
+		name           string
+		header         string
+		expectedStatus int
+	}{
+		{"no header", "", http.StatusUnauthorized},
+		{"not a bearer token", "Basic Z29vZA==", http.StatusUnauthorized},
+		{"empty bearer token", "Bearer ", http.StatusUnauthorized},
+		{"invalid token", "Bearer bad", http.StatusUnauthorized},
+		{"valid token", "Bearer good", http.StatusOK},
+		{"scheme is case-insensitive", "bearer good", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/private", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "admin@example.com", w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission lets a request through only when the authenticated user
+// has permission. It must come after Authenticate.
+func RequirePermission(permission domain.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := CurrentUser(c)
+		if !ok {
+			response.UnauthorizedResponse(c)
+			c.Abort()
+			return
+		}
+
+		if !user.Can(permission) {
+			response.ForbiddenResponse(c)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"invoice-system/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// withUser stands in for Authenticate, putting user on the request context.
+func withUser(user *domain.User) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user != nil {
+			c.Request = c.Request.WithContext(domain.ContextWithUser(c.Request.Context(), *user))
+		}
+		c.Next()
+	}
+}
+
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		user           *domain.User
+		expectedStatus int
+	}{
+		{"not authenticated", nil, http.StatusUnauthorized},
+		{"auditor cannot void", &domain.User{Role: domain.RoleAuditor, IsActive: true}, http.StatusForbidden},
+		{"clerk cannot void", &domain.User{Role: domain.RoleClerk, IsActive: true}, http.StatusForbidden},
+		{"accountant can void", &domain.User{Role: domain.RoleAccountant, IsActive: true}, http.StatusOK},
+		{"admin can void", &domain.User{Role: domain.RoleAdmin, IsActive: true}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.POST("/void", withUser(tt.user), RequirePermission(domain.PermInvoicesVoid), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/void", nil))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
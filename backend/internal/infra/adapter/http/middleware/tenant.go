@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/adapter/http/response"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TenantHeader names the organization a request is for. Signed-in requests
+// are for the organization of their user and may leave it out.
+const TenantHeader = "X-Tenant-ID"
+
+// ResolveTenant scopes requests that aren't signed in yet, such as logins,
+// to the organization in the TenantHeader, if there is one.
+func ResolveTenant() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenantID, ok, valid := headerTenant(c)
+		if !valid {
+			invalidTenantResponse(c)
+			c.Abort()
+			return
+		}
+
+		if ok {
+			c.Request = c.Request.WithContext(domain.ContextWithTenant(c.Request.Context(), tenantID))
+		}
+		c.Next()
+	}
+}
+
+// headerTenant parses the TenantHeader. ok tells whether there is one and
+// valid whether it is an organization ID.
+func headerTenant(c *gin.Context) (tenantID uint, ok, valid bool) {
+	header := strings.TrimSpace(c.GetHeader(TenantHeader))
+	if header == "" {
+		return 0, false, true
+	}
+
+	id, err := strconv.ParseUint(header, 10, 64)
+	if err != nil || id == 0 {
+		return 0, true, false
+	}
+
+	return uint(id), true, true
+}
+
+func invalidTenantResponse(c *gin.Context) {
+	response.ErrorResponse(c, http.StatusBadRequest, "INVALID_TENANT", TenantHeader+" must be an organization ID")
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"invoice-system/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// tenantOf answers with the organization the request was scoped to.
+func tenantOf(c *gin.Context) {
+	tenantID, ok := domain.TenantFromContext(c.Request.Context())
+	if !ok {
+		c.String(http.StatusOK, "none")
+		return
+	}
+	c.String(http.StatusOK, fmt.Sprint(tenantID))
+}
+
+func TestResolveTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/login", ResolveTenant(), tenantOf)
+
+	tests := []struct {
+		name           string
+		header         string
+		expectedStatus int
+		expectedTenant string
+	}{
+		{"no header", "", http.StatusOK, "none"},
+		{"organization ID", "2", http.StatusOK, "2"},
+		{"not a number", "acme", http.StatusBadRequest, ""},
+		{"zero", "0", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			if tt.header != "" {
+				req.Header.Set(TenantHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedTenant, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAuthenticate_ScopesToUserTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/private", Authenticate(stubAuthService{}), tenantOf)
+
+	tests := []struct {
+		name           string
+		header         string
+		expectedStatus int
+	}{
+		{"no header", "", http.StatusOK},
+		{"own organization", "1", http.StatusOK},
+		{"another organization", "2", http.StatusForbidden},
+		{"not a number", "acme", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/private", nil)
+			req.Header.Set("Authorization", "Bearer good")
+			if tt.header != "" {
+				req.Header.Set(TenantHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "1", w.Body.String())
+			}
+		})
+	}
+}
@@ -1,13 +1,15 @@
 package router
 
 import (
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/adapter/http/handler"
+	"invoice-system/internal/infra/adapter/http/middleware"
 	"invoice-system/internal/infra/adapter/http/response"
 
 	"github.com/gin-gonic/gin"
 )
 
-func SetupRoutes(r *gin.Engine, customerHandler *handler.CustomerHandler, invoiceHandler *handler.InvoiceHandler, itemHandler *handler.ItemHandler) {
+func SetupRoutes(r *gin.Engine, customerHandler *handler.CustomerHandler, invoiceHandler *handler.InvoiceHandler, itemHandler *handler.ItemHandler, taxHandler *handler.TaxHandler, exchangeRateHandler *handler.ExchangeRateHandler, reportHandler *handler.ReportHandler, paymentHandler *handler.PaymentHandler, invoicePDFHandler *handler.InvoicePDFHandler, priceListHandler *handler.PriceListHandler, creditNoteHandler *handler.CreditNoteHandler, quoteHandler *handler.QuoteHandler, recurringInvoiceHandler *handler.RecurringInvoiceHandler, statementHandler *handler.StatementHandler, authHandler *handler.AuthHandler, userHandler *handler.UserHandler, auditHandler *handler.AuditHandler, authenticate gin.HandlerFunc) {
 	api := r.Group("/api/v1")
 
 	// Health check endpoint
@@ -18,25 +20,127 @@ func SetupRoutes(r *gin.Engine, customerHandler *handler.CustomerHandler, invoic
 		})
 	})
 
+	auth := api.Group("/auth", middleware.ResolveTenant())
+	{
+		auth.POST("/login", authHandler.Login)
+		auth.POST("/refresh", authHandler.Refresh)
+	}
+
+	// everything below needs an access token; each group needs the read
+	// permission of its resource and writes need their own permission on top
+	protected := api.Group("", authenticate)
+	protected.GET("/auth/me", authHandler.Me)
+	can := middleware.RequirePermission
+
+	users := protected.Group("/users", can(domain.PermUsersManage))
+	{
+		users.GET("", userHandler.GetUsers)
+		users.POST("", userHandler.CreateUser)
+		users.PUT("/:user_id", userHandler.UpdateUser)
+	}
+
 	// Customer routes
-	customers := api.Group("/customers")
+	customers := protected.Group("/customers", can(domain.PermCustomersRead))
 	{
-		customers.POST("", customerHandler.CreateCustomer)
+		customers.POST("", can(domain.PermCustomersWrite), customerHandler.CreateCustomer)
 		customers.GET("", customerHandler.GetAllCustomers)
+		customers.GET("/:customer_id", customerHandler.GetCustomer)
+		customers.PUT("/:customer_id", can(domain.PermCustomersWrite), customerHandler.UpdateCustomer)
+		customers.DELETE("/:customer_id", can(domain.PermCustomersWrite), customerHandler.DeleteCustomer)
+		customers.POST("/:customer_id/restore", can(domain.PermCustomersWrite), customerHandler.RestoreCustomer)
+		customers.GET("/:customer_id/statement", can(domain.PermInvoicesRead), statementHandler.GetCustomerStatement)
 	}
 
 	// invoice routes
-	invoices := api.Group("/invoices")
+	invoices := protected.Group("/invoices", can(domain.PermInvoicesRead))
 	{
 		invoices.GET("", invoiceHandler.ListInvoices)
-		invoices.POST("", invoiceHandler.CreateInvoice)
+		invoices.POST("", can(domain.PermInvoicesWrite), invoiceHandler.CreateInvoice)
 		invoices.GET("/:invoice_id", invoiceHandler.GetInvoiceDetails)
-		invoices.PUT("/:invoice_id", invoiceHandler.UpdateInvoice)
+		invoices.PUT("/:invoice_id", can(domain.PermInvoicesWrite), invoiceHandler.UpdateInvoice)
+		invoices.DELETE("/:invoice_id", can(domain.PermInvoicesDelete), invoiceHandler.DeleteInvoice)
+		invoices.POST("/:invoice_id/status", can(domain.PermInvoicesWrite), invoiceHandler.UpdateInvoiceStatus)
+		invoices.POST("/:invoice_id/void", can(domain.PermInvoicesVoid), invoiceHandler.VoidInvoice)
+		invoices.POST("/:invoice_id/duplicate", can(domain.PermInvoicesWrite), invoiceHandler.DuplicateInvoice)
+		invoices.GET("/:invoice_id/pdf", invoicePDFHandler.GetInvoicePDF)
+		invoices.GET("/:invoice_id/payments", can(domain.PermPaymentsRead), paymentHandler.GetInvoicePayments)
+		invoices.POST("/:invoice_id/payments", can(domain.PermPaymentsWrite), paymentHandler.CreatePayment)
 	}
 
-	items := api.Group("/items")
+	creditNotes := protected.Group("/credit-notes", can(domain.PermCreditNotesRead))
+	{
+		creditNotes.GET("", creditNoteHandler.ListCreditNotes)
+		creditNotes.POST("", can(domain.PermCreditNotesWrite), creditNoteHandler.CreateCreditNote)
+		creditNotes.GET("/:credit_note_id", creditNoteHandler.GetCreditNote)
+		creditNotes.PUT("/:credit_note_id", can(domain.PermCreditNotesWrite), creditNoteHandler.UpdateCreditNote)
+		creditNotes.DELETE("/:credit_note_id", can(domain.PermCreditNotesWrite), creditNoteHandler.DeleteCreditNote)
+		creditNotes.POST("/:credit_note_id/issue", can(domain.PermCreditNotesWrite), creditNoteHandler.IssueCreditNote)
+		creditNotes.GET("/:credit_note_id/pdf", creditNoteHandler.GetCreditNotePDF)
+	}
+
+	quotes := protected.Group("/quotes", can(domain.PermQuotesRead))
+	{
+		quotes.GET("", quoteHandler.ListQuotes)
+		quotes.POST("", can(domain.PermQuotesWrite), quoteHandler.CreateQuote)
+		quotes.GET("/:quote_id", quoteHandler.GetQuote)
+		quotes.PUT("/:quote_id", can(domain.PermQuotesWrite), quoteHandler.UpdateQuote)
+		quotes.POST("/:quote_id/status", can(domain.PermQuotesWrite), quoteHandler.UpdateQuoteStatus)
+		quotes.POST("/:quote_id/convert", can(domain.PermQuotesWrite), can(domain.PermInvoicesWrite), quoteHandler.ConvertQuote)
+	}
+
+	recurring := protected.Group("/recurring-invoices", can(domain.PermRecurringRead))
+	{
+		recurring.GET("", recurringInvoiceHandler.ListRecurringInvoices)
+		recurring.POST("", can(domain.PermRecurringWrite), recurringInvoiceHandler.CreateRecurringInvoice)
+		recurring.GET("/:recurring_invoice_id", recurringInvoiceHandler.GetRecurringInvoice)
+		recurring.PUT("/:recurring_invoice_id", can(domain.PermRecurringWrite), recurringInvoiceHandler.UpdateRecurringInvoice)
+		recurring.DELETE("/:recurring_invoice_id", can(domain.PermRecurringWrite), recurringInvoiceHandler.DeleteRecurringInvoice)
+		recurring.POST("/:recurring_invoice_id/status", can(domain.PermRecurringWrite), recurringInvoiceHandler.UpdateRecurringInvoiceStatus)
+	}
+
+	items := protected.Group("/items", can(domain.PermCatalogRead))
 	{
 		items.GET("", itemHandler.GetItems)
-		items.POST("", itemHandler.CreateItem)
+		items.POST("", can(domain.PermCatalogWrite), itemHandler.CreateItem)
+		items.GET("/:item_id", itemHandler.GetItem)
+		items.PUT("/:item_id", can(domain.PermCatalogWrite), itemHandler.UpdateItem)
+		items.GET("/:item_id/price", itemHandler.GetItemPrice)
+	}
+
+	priceLists := protected.Group("/price-lists", can(domain.PermCatalogRead))
+	{
+		priceLists.GET("", priceListHandler.GetPriceLists)
+		priceLists.POST("", can(domain.PermCatalogWrite), priceListHandler.CreatePriceList)
+		priceLists.GET("/:price_list_id", priceListHandler.GetPriceList)
+		priceLists.PUT("/:price_list_id", can(domain.PermCatalogWrite), priceListHandler.UpdatePriceList)
+		priceLists.POST("/:price_list_id/items", can(domain.PermCatalogWrite), priceListHandler.SetItemPrice)
+		priceLists.DELETE("/:price_list_id/items/:item_id", can(domain.PermCatalogWrite), priceListHandler.RemoveItemPrice)
+	}
+
+	taxRates := protected.Group("/tax-rates", can(domain.PermCatalogRead))
+	{
+		taxRates.GET("", taxHandler.GetTaxRates)
+		taxRates.POST("", can(domain.PermCatalogWrite), taxHandler.CreateTaxRate)
+		taxRates.GET("/:tax_rate_id", taxHandler.GetTaxRate)
+		taxRates.PUT("/:tax_rate_id", can(domain.PermCatalogWrite), taxHandler.UpdateTaxRate)
 	}
+
+	exchangeRates := protected.Group("/exchange-rates", can(domain.PermExchangeRatesRead))
+	{
+		exchangeRates.GET("", exchangeRateHandler.GetRates)
+		exchangeRates.POST("", can(domain.PermExchangeRatesWrite), exchangeRateHandler.CreateRate)
+		exchangeRates.POST("/import", can(domain.PermExchangeRatesWrite), exchangeRateHandler.ImportRates)
+	}
+
+	reports := protected.Group("/reports", can(domain.PermReportsRead))
+	{
+		reports.GET("/invoice-totals", reportHandler.GetInvoiceTotals)
+		reports.GET("/aging", reportHandler.GetAgingReport)
+		reports.GET("/revenue", reportHandler.GetRevenueReport)
+		reports.GET("/tax", reportHandler.GetTaxReport)
+		reports.GET("/top-customers", reportHandler.GetTopCustomers)
+		reports.GET("/top-items", reportHandler.GetTopItems)
+	}
+
+	protected.GET("/audit", can(domain.PermAuditRead), auditHandler.GetAuditLog)
 }
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"reflect"
+
+	"gorm.io/gorm"
+)
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) repository.AuditRepository {
+	return &auditRepository{db: db}
+}
+
+// GetAuditEntries implements repository.AuditRepository. The latest
+// entries come first.
+func (r *auditRepository) GetAuditEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, domain.Pagination, error) {
+	applyFilters := func(db *gorm.DB) *gorm.DB {
+		if filter.EntityType != "" {
+			db = db.Where("entity_type = ?", filter.EntityType)
+		}
+
+		if filter.EntityID != nil {
+			db = db.Where("entity_id = ?", *filter.EntityID)
+		}
+
+		return db
+	}
+
+	page, limit, offset := domain.NormalizePage(filter.Page, filter.Limit)
+
+	var totalItems int64
+	if err := applyFilters(r.db.WithContext(ctx).Model(&models.AuditLog{})).Count(&totalItems).Error; err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	var logs []models.AuditLog
+	err := applyFilters(r.db.WithContext(ctx).Model(&models.AuditLog{})).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&logs).Error
+	if err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+
+	result := make([]domain.AuditEntry, 0, len(logs))
+	for _, m := range logs {
+		result = append(result, mapper.ToDomainAuditEntry(m))
+	}
+
+	return result, domain.NewPagination(totalItems, page, limit), nil
+}
+
+// auditSnapshot is the state of a record as the audit log compares it: its
+// JSON form, without the tenant and the timestamps, which every write
+// touches. A nil record has no snapshot.
+func auditSnapshot(record interface{}) (map[string]interface{}, error) {
+	if record == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot audited record: %w", err)
+	}
+
+	var snapshot map[string]interface{}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to snapshot audited record: %w", err)
+	}
+
+	for _, field := range []string{"tenant_id", "created_at", "updated_at"} {
+		delete(snapshot, field)
+	}
+
+	return snapshot, nil
+}
+
+// auditTenant is the tenant of the audited record, so that an entry written
+// outside of a tenant's context, such as by a background job, still belongs
+// to the organization that owns the record. It is zero when neither record
+// has one.
+func auditTenant(records ...interface{}) uint {
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+
+		rv := reflect.Indirect(reflect.ValueOf(record))
+		if rv.Kind() != reflect.Struct {
+			continue
+		}
+
+		if field := rv.FieldByName("TenantID"); field.IsValid() && field.Kind() == reflect.Uint && field.Uint() != 0 {
+			return uint(field.Uint())
+		}
+	}
+
+	return 0
+}
+
+// recordAudit writes an audit entry for a change from before to after, two
+// records or nil for one that didn't or no longer exists. It must be given
+// the transaction making the change, so the entry is kept exactly when the
+// change is. The actor is the user on the transaction's context, and the
+// entry belongs to the tenant of the record.
+func recordAudit(tx *gorm.DB, entityType string, entityID uint, action string, before, after interface{}) error {
+	from, err := auditSnapshot(before)
+	if err != nil {
+		return err
+	}
+
+	to, err := auditSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	entry := domain.AuditEntry{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    domain.AuditDiff(from, to),
+	}
+	if user, ok := domain.UserFromContext(tx.Statement.Context); ok {
+		entry.ActorID = &user.ID
+		entry.ActorEmail = user.Email
+	}
+
+	m := mapper.ToModelAuditLog(entry)
+	m.TenantID = auditTenant(after, before)
+	if err := tx.Create(&m).Error; err != nil {
+		return fmt.Errorf("failed to record %s %s: %w", entityType, action, err)
+	}
+
+	return nil
+}
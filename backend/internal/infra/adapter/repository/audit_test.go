@@ -0,0 +1,160 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog_Invoice(t *testing.T) {
+	db := setupTestDB(t)
+	r := repository.NewInvoiceRepository(db, testInvoiceNumbering)
+	audit := repository.NewAuditRepository(db)
+
+	clerk := domain.User{ID: 7, Email: "clerk@example.com"}
+	ctx := domain.ContextWithUser(context.Background(), clerk)
+
+	customer := models.Customer{Name: "Eve"}
+	db.Create(&customer)
+	item := models.Item{Name: "Desk"}
+	db.Create(&item)
+
+	issueDate := time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local)
+	invoice := domain.Invoice{
+		IssueDate:  issueDate,
+		DueDate:    issueDate.AddDate(0, 0, 14),
+		CustomerID: customer.ID,
+		Currency:   "IDR",
+		Status:     domain.InvoiceStatusDraft,
+	}
+	if err := r.CreateInvoice(ctx, &invoice); err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+
+	invoice.Subject = "Office"
+	invoice.Items = []domain.InvoiceItem{{ItemID: item.ID, Quantity: 2, Price: domain.NewMoney(500), TotalPrice: domain.NewMoney(1000)}}
+	invoice.CalculateTotals()
+	if err := r.UpdateInvoice(ctx, invoice.ID, invoice); err != nil {
+		t.Fatalf("failed to update invoice: %v", err)
+	}
+
+	if err := r.UpdateInvoiceStatus(ctx, invoice.ID, domain.InvoiceStatusDraft, domain.InvoiceStatusIssued); err != nil {
+		t.Fatalf("failed to issue invoice: %v", err)
+	}
+
+	// a rejected change leaves no entry behind
+	assert.Equal(t, utils.ErrInvalidStatusTransition, r.UpdateInvoiceStatus(ctx, invoice.ID, domain.InvoiceStatusDraft, domain.InvoiceStatusVoid))
+
+	if err := r.DeleteInvoice(context.Background(), invoice.ID); err != nil {
+		t.Fatalf("failed to delete invoice: %v", err)
+	}
+
+	entries, page, err := audit.GetAuditEntries(context.Background(), domain.AuditFilter{EntityType: domain.AuditEntityInvoice, EntityID: &invoice.ID, Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), page.TotalItems)
+	if !assert.Len(t, entries, 4) {
+		return
+	}
+
+	deleted, issued, edited, created := entries[0], entries[1], entries[2], entries[3]
+
+	assert.Equal(t, domain.AuditActionCreate, created.Action)
+	assert.Equal(t, invoice.InvoiceNumber, created.Changes["invoice_number"].After)
+	assert.Nil(t, created.Changes["invoice_number"].Before)
+	if assert.NotNil(t, created.ActorID) {
+		assert.Equal(t, clerk.ID, *created.ActorID)
+	}
+	assert.Equal(t, clerk.Email, created.ActorEmail)
+
+	assert.Equal(t, domain.AuditActionUpdate, edited.Action)
+	assert.Equal(t, domain.AuditChange{Before: "", After: "Office"}, edited.Changes["subject"])
+	assert.Contains(t, edited.Changes, "items")
+	assert.NotContains(t, edited.Changes, "status")
+
+	assert.Equal(t, domain.AuditActionUpdate, issued.Action)
+	assert.Equal(t, domain.AuditChange{Before: domain.InvoiceStatusDraft, After: domain.InvoiceStatusIssued}, issued.Changes["status"])
+	assert.NotContains(t, issued.Changes, "updated_at")
+
+	// changes made without a signed-in user have no actor
+	assert.Equal(t, domain.AuditActionDelete, deleted.Action)
+	assert.Nil(t, deleted.ActorID)
+	assert.Equal(t, domain.InvoiceStatusIssued, deleted.Changes["status"].Before)
+	assert.Nil(t, deleted.Changes["status"].After)
+}
+
+func TestAuditLog_Customer(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewCustomerRepository(db)
+	audit := repository.NewAuditRepository(db)
+
+	a := createTenantCustomer(t, context.Background(), db, "a@example.com")
+	b := createTenantCustomer(t, context.Background(), db, "b@example.com")
+
+	a.Name = "Renamed"
+	assert.NoError(t, repo.UpdateCustomer(context.Background(), a))
+
+	// the failed update is rolled back together with its entry
+	b.Email = a.Email
+	assert.Equal(t, utils.ErrCustomerAlreadyExists, repo.UpdateCustomer(context.Background(), b))
+
+	assert.NoError(t, repo.DeleteCustomer(context.Background(), a.ID))
+	assert.NoError(t, repo.RestoreCustomer(context.Background(), a.ID))
+
+	entries, _, err := audit.GetAuditEntries(context.Background(), domain.AuditFilter{EntityType: domain.AuditEntityCustomer, EntityID: &a.ID, Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	actions := make([]string, len(entries))
+	for i, e := range entries {
+		actions[i] = e.Action
+	}
+	assert.Equal(t, []string{domain.AuditActionRestore, domain.AuditActionDelete, domain.AuditActionUpdate, domain.AuditActionCreate}, actions)
+	assert.Equal(t, domain.AuditChange{Before: "Customer a@example.com", After: "Renamed"}, entries[2].Changes["name"])
+	assert.Len(t, entries[2].Changes, 1)
+
+	entries, _, err = audit.GetAuditEntries(context.Background(), domain.AuditFilter{EntityType: domain.AuditEntityCustomer, EntityID: &b.ID, Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, domain.AuditActionCreate, entries[0].Action)
+	}
+}
+
+func TestAuditLog_Filters(t *testing.T) {
+	db := setupTestDB(t)
+	items := repository.NewItemRepository(db)
+	audit := repository.NewAuditRepository(db)
+
+	for _, sku := range []string{"SKU-1", "SKU-2", "SKU-3"} {
+		if err := items.AddItem(tenantA, domain.Item{Name: "Item " + sku, SKU: sku}); err != nil {
+			t.Fatalf("failed to add item: %v", err)
+		}
+	}
+	createTenantCustomer(t, tenantA, db, "c@example.com")
+	createTenantCustomer(t, tenantB, db, "d@example.com")
+
+	all, page, err := audit.GetAuditEntries(tenantA, domain.AuditFilter{Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	assert.Len(t, all, 4)
+	assert.Equal(t, int64(4), page.TotalItems)
+
+	onlyItems, page, err := audit.GetAuditEntries(tenantA, domain.AuditFilter{EntityType: domain.AuditEntityItem, Limit: 2, Page: 2})
+	assert.NoError(t, err)
+	assert.Len(t, onlyItems, 1)
+	assert.Equal(t, int64(3), page.TotalItems)
+	assert.Equal(t, 2, page.TotalPages)
+	if assert.Len(t, onlyItems, 1) {
+		assert.Equal(t, "SKU-1", onlyItems[0].Changes["sku"].After)
+	}
+
+	// other organizations' entries stay out of sight
+	others, _, err := audit.GetAuditEntries(tenantB, domain.AuditFilter{Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, others, 1) {
+		assert.Equal(t, domain.AuditEntityCustomer, others[0].EntityType)
+	}
+}
@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type creditNoteRepository struct {
+	db        *gorm.DB
+	numbering domain.NumberingScheme
+}
+
+func NewCreditNoteRepository(db *gorm.DB, numbering domain.NumberingScheme) repository.CreditNoteRepository {
+	return &creditNoteRepository{db: db, numbering: numbering}
+}
+
+// GetAllCreditNotes implements repository.CreditNoteRepository.
+func (r *creditNoteRepository) GetAllCreditNotes(ctx context.Context, filter domain.CreditNoteFilter) ([]domain.CreditNote, domain.Pagination, error) {
+	applyFilters := func(db *gorm.DB) *gorm.DB {
+		if filter.InvoiceID != nil {
+			db = db.Where("invoice_id = ?", *filter.InvoiceID)
+		}
+
+		if filter.CustomerID != nil {
+			db = db.Where("customer_id = ?", *filter.CustomerID)
+		}
+
+		if filter.Status != "" {
+			db = db.Where("status = ?", filter.Status)
+		}
+
+		return db
+	}
+
+	page, limit, offset := domain.NormalizePage(filter.Page, filter.Limit)
+
+	var totalItems int64
+	if err := applyFilters(r.db.WithContext(ctx).Model(&models.CreditNote{})).Count(&totalItems).Error; err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to count credit notes: %w", err)
+	}
+
+	var creditNotes []models.CreditNote
+	err := applyFilters(r.db.WithContext(ctx).Model(&models.CreditNote{})).
+		Preload("Customer").
+		Preload("Invoice").
+		Order("issue_date DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&creditNotes).Error
+	if err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to get credit notes: %w", err)
+	}
+
+	result := make([]domain.CreditNote, 0, len(creditNotes))
+	for _, m := range creditNotes {
+		result = append(result, mapper.ToDomainCreditNote(m))
+	}
+
+	return result, domain.NewPagination(totalItems, page, limit), nil
+}
+
+// GetCreditNoteByID implements repository.CreditNoteRepository.
+func (r *creditNoteRepository) GetCreditNoteByID(ctx context.Context, id uint) (domain.CreditNote, error) {
+	var m models.CreditNote
+
+	err := r.db.WithContext(ctx).Preload("Customer").Preload("Invoice").Preload("Items.Item").First(&m, id).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return domain.CreditNote{}, utils.ErrCreditNoteNotFound
+		}
+
+		return domain.CreditNote{}, fmt.Errorf("failed to get credit note by ID: %w", err)
+	}
+
+	return mapper.ToDomainCreditNote(m), nil
+}
+
+// CreateCreditNote implements repository.CreditNoteRepository.
+func (r *creditNoteRepository) CreateCreditNote(ctx context.Context, creditNote *domain.CreditNote) error {
+	m := mapper.ToModelCreditNote(*creditNote)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		number, err := allocateDocumentNumber(tx, r.numbering, creditNote.IssueDate)
+		if err != nil {
+			return err
+		}
+		m.CreditNoteNumber = number
+
+		if m.Status == domain.CreditNoteStatusIssued {
+			if err := applyCreditToInvoice(tx, m.InvoiceID, m.TotalAmount); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Omit("Items").Create(&m).Error; err != nil {
+			return fmt.Errorf("create credit note failed: %w", err)
+		}
+
+		for idx := range m.Items {
+			m.Items[idx].CreditNoteID = m.ID
+		}
+
+		if len(m.Items) > 0 {
+			if err := tx.Create(&m.Items).Error; err != nil {
+				return fmt.Errorf("create credit note items failed: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	creditNote.ID = m.ID
+	creditNote.CreditNoteNumber = m.CreditNoteNumber
+	return nil
+}
+
+// UpdateCreditNote implements repository.CreditNoteRepository. The lines are
+// replaced as a whole since they carry no identity of their own.
+func (r *creditNoteRepository) UpdateCreditNote(ctx context.Context, id uint, creditNote domain.CreditNote) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockDraftCreditNote(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("credit_note_id = ?", id).Delete(&models.CreditNoteItem{}).Error; err != nil {
+			return fmt.Errorf("failed to remove credit note items: %w", err)
+		}
+
+		items := make([]models.CreditNoteItem, 0, len(creditNote.Items))
+		for _, it := range creditNote.Items {
+			item := mapper.ToModelCreditNoteItem(it)
+			item.ID = 0
+			item.CreditNoteID = id
+			items = append(items, item)
+		}
+
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return fmt.Errorf("failed to create credit note items: %w", err)
+			}
+		}
+
+		return tx.Model(&existing).Updates(map[string]interface{}{
+			"issue_date":   creditNote.IssueDate,
+			"reason":       creditNote.Reason,
+			"subtotal":     creditNote.Subtotal,
+			"tax":          creditNote.Tax,
+			"total_amount": creditNote.TotalAmount,
+			"total_items":  creditNote.TotalItems,
+			"updated_at":   time.Now(),
+		}).Error
+	})
+}
+
+// IssueCreditNote implements repository.CreditNoteRepository.
+func (r *creditNoteRepository) IssueCreditNote(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockDraftCreditNote(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := applyCreditToInvoice(tx, existing.InvoiceID, existing.TotalAmount); err != nil {
+			return err
+		}
+
+		return tx.Model(&existing).Updates(map[string]interface{}{
+			"status":     domain.CreditNoteStatusIssued,
+			"updated_at": time.Now(),
+		}).Error
+	})
+}
+
+// DeleteCreditNote implements repository.CreditNoteRepository. Only drafts
+// can be deleted; an issued credit note has already changed the invoice.
+func (r *creditNoteRepository) DeleteCreditNote(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := lockDraftCreditNote(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&existing).Error; err != nil {
+			return fmt.Errorf("failed to delete credit note: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetCreditedQuantities implements repository.CreditNoteRepository. Drafts
+// count as well, so two drafts can't together credit more than was invoiced.
+func (r *creditNoteRepository) GetCreditedQuantities(ctx context.Context, invoiceID, excludeID uint) (map[uint]int, error) {
+	var rows []struct {
+		InvoiceItemID uint
+		Quantity      int
+	}
+
+	err := r.db.WithContext(ctx).Model(&models.CreditNoteItem{}).
+		Select("credit_note_items.invoice_item_id, SUM(credit_note_items.quantity) AS quantity").
+		Joins("JOIN credit_notes ON credit_notes.id = credit_note_items.credit_note_id").
+		Where("credit_notes.invoice_id = ? AND credit_notes.id <> ? AND credit_notes.deleted_at IS NULL", invoiceID, excludeID).
+		Where("credit_note_items.invoice_item_id IS NOT NULL").
+		Group("credit_note_items.invoice_item_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credited quantities: %w", err)
+	}
+
+	result := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		result[row.InvoiceItemID] = row.Quantity
+	}
+
+	return result, nil
+}
+
+// lockDraftCreditNote loads the credit note for update and makes sure it is
+// still a draft.
+func lockDraftCreditNote(tx *gorm.DB, id uint) (models.CreditNote, error) {
+	var m models.CreditNote
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&m, id).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return models.CreditNote{}, utils.ErrCreditNoteNotFound
+		}
+		return models.CreditNote{}, fmt.Errorf("failed to load credit note: %w", err)
+	}
+
+	if m.Status != domain.CreditNoteStatusDraft {
+		return models.CreditNote{}, utils.ErrCreditNoteLocked
+	}
+
+	return m, nil
+}
+
+// applyCreditToInvoice reduces the balance due of the invoice by amount and
+// audits the change. The invoice row stays locked until the caller's
+// transaction ends.
+func applyCreditToInvoice(tx *gorm.DB, invoiceID uint, amount domain.Money) error {
+	var invModel models.Invoice
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&invModel, invoiceID).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return utils.ErrInvoiceNotFound
+		}
+		return fmt.Errorf("failed to load invoice: %w", err)
+	}
+	before := invModel
+
+	invoice := mapper.ToDomainInvoice(invModel)
+	if err := invoice.ApplyCredit(amount); err != nil {
+		return err
+	}
+
+	if err := tx.Model(&invModel).Updates(map[string]interface{}{
+		"amount_credited": invoice.AmountCredited,
+		"status":          invoice.Status,
+		"updated_at":      time.Now(),
+		"version":         gorm.Expr("version + 1"),
+	}).Error; err != nil {
+		return fmt.Errorf("failed to update invoice balance: %w", err)
+	}
+
+	return auditInvoiceUpdate(tx, before)
+}
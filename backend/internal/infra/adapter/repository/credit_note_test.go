@@ -0,0 +1,150 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testCreditNoteNumbering = domain.NumberingScheme{
+	Series:  domain.SeriesCreditNote,
+	Pattern: "CN/{YYYY}/{seq:5}",
+	Reset:   domain.SequenceResetYearly,
+}
+
+func TestCreditNoteRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.CreditNote{}, &models.CreditNoteItem{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewCreditNoteRepository(db, testCreditNoteNumbering)
+
+	inv := TestInvoice{
+		InvoiceNumber: "INV-C1",
+		CustomerID:    1,
+		Status:        domain.InvoiceStatusIssued,
+		TotalAmount:   domain.NewMoney(110),
+	}
+	db.Create(&inv)
+
+	line := models.InvoiceItem{InvoiceID: inv.ID, ItemID: 1, Quantity: 2, Price: domain.NewMoney(50), TotalPrice: domain.NewMoney(100)}
+	db.Create(&line)
+
+	issueDate := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+	newCreditNote := func(status string, quantity int, amount domain.Money) domain.CreditNote {
+		return domain.CreditNote{
+			InvoiceID:   inv.ID,
+			CustomerID:  1,
+			IssueDate:   issueDate,
+			Currency:    "IDR",
+			Status:      status,
+			TotalAmount: amount,
+			Items: []domain.CreditNoteItem{{
+				InvoiceItemID: &line.ID,
+				ItemID:        1,
+				Quantity:      quantity,
+				Price:         domain.NewMoney(50),
+				TotalPrice:    domain.NewMoney(50),
+			}},
+		}
+	}
+
+	draft := newCreditNote(domain.CreditNoteStatusDraft, 1, domain.NewMoney(55))
+	assert.NoError(t, repo.CreateCreditNote(context.Background(), &draft))
+	assert.Equal(t, "CN/2025/00001", draft.CreditNoteNumber)
+
+	// drafts don't touch the invoice
+	var stored TestInvoice
+	db.First(&stored, inv.ID)
+	assert.True(t, stored.AmountCredited.IsZero())
+
+	issued := newCreditNote(domain.CreditNoteStatusIssued, 1, domain.NewMoney(55))
+	assert.NoError(t, repo.CreateCreditNote(context.Background(), &issued))
+	assert.Equal(t, "CN/2025/00002", issued.CreditNoteNumber)
+
+	db.First(&stored, inv.ID)
+	assert.Equal(t, domain.NewMoney(55), stored.AmountCredited)
+	assert.Equal(t, domain.InvoiceStatusIssued, stored.Status)
+
+	credited, err := repo.GetCreditedQuantities(context.Background(), inv.ID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint]int{line.ID: 2}, credited)
+
+	credited, err = repo.GetCreditedQuantities(context.Background(), inv.ID, draft.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint]int{line.ID: 1}, credited)
+
+	// issued credit notes are locked
+	assert.Equal(t, utils.ErrCreditNoteLocked, repo.UpdateCreditNote(context.Background(), issued.ID, issued))
+	assert.Equal(t, utils.ErrCreditNoteLocked, repo.DeleteCreditNote(context.Background(), issued.ID))
+
+	// issuing the draft settles the rest of the invoice
+	assert.NoError(t, repo.IssueCreditNote(context.Background(), draft.ID))
+	db.First(&stored, inv.ID)
+	assert.Equal(t, domain.NewMoney(110), stored.AmountCredited)
+	assert.Equal(t, domain.InvoiceStatusPaid, stored.Status)
+
+	// the invoice no longer accepts credit
+	late := newCreditNote(domain.CreditNoteStatusIssued, 1, domain.NewMoney(1))
+	assert.Equal(t, domain.ErrInvoiceNotCreditable, repo.CreateCreditNote(context.Background(), &late))
+
+	found, err := repo.GetCreditNoteByID(context.Background(), draft.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.CreditNoteStatusIssued, found.Status)
+	assert.Len(t, found.Items, 1)
+
+	list, pagination, err := repo.GetAllCreditNotes(context.Background(), domain.CreditNoteFilter{InvoiceID: &inv.ID})
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, int64(2), pagination.TotalItems)
+
+	_, err = repo.GetCreditNoteByID(context.Background(), 999)
+	assert.Equal(t, utils.ErrCreditNoteNotFound, err)
+}
+
+func TestCreditNoteRepository_UpdateAndDeleteDraft(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.CreditNote{}, &models.CreditNoteItem{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewCreditNoteRepository(db, testCreditNoteNumbering)
+
+	inv := TestInvoice{InvoiceNumber: "INV-C2", CustomerID: 1, Status: domain.InvoiceStatusIssued, TotalAmount: domain.NewMoney(100)}
+	db.Create(&inv)
+
+	draft := domain.CreditNote{
+		InvoiceID:   inv.ID,
+		CustomerID:  1,
+		IssueDate:   time.Now(),
+		Status:      domain.CreditNoteStatusDraft,
+		TotalAmount: domain.NewMoney(10),
+		Items:       []domain.CreditNoteItem{{ItemID: 1, Quantity: 1, Price: domain.NewMoney(10), TotalPrice: domain.NewMoney(10)}},
+	}
+	assert.NoError(t, repo.CreateCreditNote(context.Background(), &draft))
+
+	update := draft
+	update.Reason = "Damaged"
+	update.TotalAmount = domain.NewMoney(40)
+	update.Items = []domain.CreditNoteItem{
+		{ItemID: 1, Quantity: 2, Price: domain.NewMoney(10), TotalPrice: domain.NewMoney(20)},
+		{ItemID: 2, Quantity: 1, Price: domain.NewMoney(20), TotalPrice: domain.NewMoney(20)},
+	}
+	assert.NoError(t, repo.UpdateCreditNote(context.Background(), draft.ID, update))
+
+	found, err := repo.GetCreditNoteByID(context.Background(), draft.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Damaged", found.Reason)
+	assert.Equal(t, domain.NewMoney(40), found.TotalAmount)
+	assert.Len(t, found.Items, 2)
+
+	assert.NoError(t, repo.DeleteCreditNote(context.Background(), draft.ID))
+	_, err = repo.GetCreditNoteByID(context.Background(), draft.ID)
+	assert.Equal(t, utils.ErrCreditNoteNotFound, err)
+}
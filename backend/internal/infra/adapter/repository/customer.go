@@ -1,13 +1,17 @@
 package repository
 
 import (
+	"context"
+	"fmt"
 	"invoice-system/internal/applications/ports/repository"
 	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/db/mapper"
 	"invoice-system/internal/infra/db/models"
 	"invoice-system/internal/utils"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type customerRepository struct {
@@ -19,33 +23,225 @@ func NewCustomerRepository(db *gorm.DB) repository.CustomerRepository {
 }
 
 // CreateCustomer implements repository.CustomerRepository.
-func (c *customerRepository) CreateCustomer(customer *domain.Customer) error {
+func (c *customerRepository) CreateCustomer(ctx context.Context, customer *domain.Customer) error {
 	m := mapper.ToModelCustomer(*customer)
 
-	if err := c.db.Create(&m).Error; err != nil {
-		if utils.IsDuplicateKeyError(err) {
-			return utils.ErrCustomerAlreadyExists
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&m).Error; err != nil {
+			if utils.IsDuplicateKeyError(err) {
+				return utils.ErrCustomerAlreadyExists
+			}
+
+			return err
 		}
 
-		return err
-	}
+		return recordAudit(tx, domain.AuditEntityCustomer, m.ID, domain.AuditActionCreate, nil, m)
+	})
+}
 
-	return nil
+// customerSortColumns maps the sort options to the columns they order by.
+var customerSortColumns = map[string]string{
+	domain.CustomerSortName:               "customers.name",
+	domain.CustomerSortEmail:              "customers.email",
+	domain.CustomerSortCreatedAt:          "customers.created_at",
+	domain.CustomerSortInvoiceCount:       "invoice_count",
+	domain.CustomerSortOutstandingBalance: "outstanding_balance",
 }
 
-// FindCustomers implements repository.CustomerRepository.
-func (c *customerRepository) FindCustomers() ([]domain.Customer, error) {
-	var models []models.Customer
+// FindCustomers implements repository.CustomerRepository. The invoice
+// aggregates are computed by a grouped subquery instead of preloading every
+// invoice.
+func (c *customerRepository) FindCustomers(ctx context.Context, filter domain.CustomerFilter) ([]domain.Customer, domain.Pagination, error) {
+	page, limit, offset := domain.NormalizePage(filter.Page, filter.Limit)
+
+	applySearch := func(db *gorm.DB) *gorm.DB {
+		if filter.Search != "" {
+			like := "%" + filter.Search + "%"
+			db = db.Where("customers.name LIKE ? OR customers.email LIKE ? OR customers.phone LIKE ?", like, like, like)
+		}
+		return db
+	}
+
+	var totalItems int64
+	if err := applySearch(c.db.WithContext(ctx).Model(&models.Customer{})).Count(&totalItems).Error; err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to count customers: %w", err)
+	}
+
+	sortColumn, ok := customerSortColumns[filter.SortBy]
+	if !ok {
+		sortColumn = customerSortColumns[domain.CustomerSortName]
+	}
+	withStats := filter.WithStats ||
+		filter.SortBy == domain.CustomerSortInvoiceCount ||
+		filter.SortBy == domain.CustomerSortOutstandingBalance
+
+	direction := "ASC"
+	if filter.SortDesc {
+		direction = "DESC"
+	}
+
+	db := applySearch(c.db.WithContext(ctx).Model(&models.Customer{}))
+	if withStats {
+		stats := c.db.WithContext(ctx).Model(&models.Invoice{}).
+			Select("customer_id, COUNT(*) AS invoice_count, "+
+				"SUM(CASE WHEN status IN ? THEN total_amount - amount_paid - amount_credited ELSE 0 END) AS outstanding_balance",
+				domain.OutstandingInvoiceStatuses()).
+			Group("customer_id")
+
+		db = db.Select("customers.*, COALESCE(stats.invoice_count, 0) AS invoice_count, "+
+			"COALESCE(stats.outstanding_balance, 0) AS outstanding_balance").
+			Joins("LEFT JOIN (?) AS stats ON stats.customer_id = customers.id", stats)
+	}
 
-	err := c.db.Preload("Invoices").Find(&models).Error
+	var rows []struct {
+		models.Customer
+		InvoiceCount       int64
+		OutstandingBalance domain.Money
+	}
+
+	err := db.Order(sortColumn + " " + direction).
+		Order("customers.id " + direction).
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error
 	if err != nil {
-		return nil, err
+		return nil, domain.Pagination{}, fmt.Errorf("failed to find customers: %w", err)
+	}
+
+	customers := make([]domain.Customer, 0, len(rows))
+	for _, row := range rows {
+		customer := mapper.ToDomainCustomer(row.Customer)
+		if withStats {
+			customer.Stats = &domain.CustomerStats{
+				InvoiceCount:       row.InvoiceCount,
+				OutstandingBalance: row.OutstandingBalance,
+			}
+		}
+		customers = append(customers, customer)
 	}
 
-	var customers []domain.Customer
-	for _, m := range models {
-		customers = append(customers, mapper.ToDomainCustomer(m))
+	return customers, domain.NewPagination(totalItems, page, limit), nil
+}
+
+// GetCustomerByID implements repository.CustomerRepository.
+func (c *customerRepository) GetCustomerByID(ctx context.Context, id uint) (domain.Customer, error) {
+	var m models.Customer
+
+	if err := c.db.WithContext(ctx).First(&m, id).Error; err != nil {
+		if utils.IsNotFound(err) {
+			return domain.Customer{}, utils.ErrCustomerNotFound
+		}
+
+		return domain.Customer{}, fmt.Errorf("failed to get customer by ID: %w", err)
+	}
+
+	return mapper.ToDomainCustomer(m), nil
+}
+
+// UpdateCustomer implements repository.CustomerRepository.
+func (c *customerRepository) UpdateCustomer(ctx context.Context, customer domain.Customer) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := lockCustomer(tx, customer.ID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Customer{}).
+			Where("id = ?", customer.ID).
+			Updates(map[string]interface{}{
+				"name":          customer.Name,
+				"email":         customer.Email,
+				"phone":         customer.Phone,
+				"address":       customer.Address,
+				"tax_rate_id":   customer.TaxRateID,
+				"currency":      customer.Currency,
+				"price_list_id": customer.PriceListID,
+				"updated_at":    time.Now(),
+			}).Error
+		if err != nil {
+			if utils.IsDuplicateKeyError(err) {
+				return utils.ErrCustomerAlreadyExists
+			}
+
+			return fmt.Errorf("failed to update customer: %w", err)
+		}
+
+		var after models.Customer
+		if err := tx.First(&after, customer.ID).Error; err != nil {
+			return fmt.Errorf("failed to reload customer: %w", err)
+		}
+
+		return recordAudit(tx, domain.AuditEntityCustomer, customer.ID, domain.AuditActionUpdate, before, after)
+	})
+}
+
+// DeleteCustomer implements repository.CustomerRepository. The row is only
+// soft deleted so the customer's invoices keep their reference.
+func (c *customerRepository) DeleteCustomer(ctx context.Context, id uint) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, err := lockCustomer(tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&models.Customer{}, id).Error; err != nil {
+			return fmt.Errorf("failed to delete customer: %w", err)
+		}
+
+		return recordAudit(tx, domain.AuditEntityCustomer, id, domain.AuditActionDelete, before, nil)
+	})
+}
+
+// RestoreCustomer implements repository.CustomerRepository. Restoring a
+// customer that isn't deleted is a no-op.
+func (c *customerRepository) RestoreCustomer(ctx context.Context, id uint) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var m models.Customer
+		if err := tx.Unscoped().First(&m, id).Error; err != nil {
+			if utils.IsNotFound(err) {
+				return utils.ErrCustomerNotFound
+			}
+
+			return fmt.Errorf("failed to get customer by ID: %w", err)
+		}
+
+		if !m.DeletedAt.Valid {
+			return nil
+		}
+
+		if err := tx.Unscoped().Model(&m).Update("deleted_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to restore customer: %w", err)
+		}
+
+		return recordAudit(tx, domain.AuditEntityCustomer, id, domain.AuditActionRestore, nil, m)
+	})
+}
+
+// lockCustomer loads the customer to be changed in tx and keeps its row
+// locked until tx ends.
+func lockCustomer(tx *gorm.DB, id uint) (models.Customer, error) {
+	var m models.Customer
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&m, id).Error; err != nil {
+		if utils.IsNotFound(err) {
+			return models.Customer{}, utils.ErrCustomerNotFound
+		}
+
+		return models.Customer{}, fmt.Errorf("failed to get customer by ID: %w", err)
+	}
+
+	return m, nil
+}
+
+// CountOutstandingInvoices implements repository.CustomerRepository.
+func (c *customerRepository) CountOutstandingInvoices(ctx context.Context, customerID uint) (int64, error) {
+	var count int64
+
+	err := c.db.WithContext(ctx).Model(&models.Invoice{}).
+		Where("customer_id = ? AND status IN ?", customerID, domain.OutstandingInvoiceStatuses()).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count outstanding invoices: %w", err)
 	}
 
-	return customers, nil
+	return count, nil
 }
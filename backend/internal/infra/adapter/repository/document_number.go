@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"fmt"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// allocateDocumentNumber hands out the next number of scheme's series for a
+// document dated date. It must run inside the transaction that stores the
+// document: the upsert locks the sequence row until that transaction ends,
+// so concurrent creates queue up instead of getting the same number, and a
+// rolled back create gives its number back.
+func allocateDocumentNumber(tx *gorm.DB, scheme domain.NumberingScheme, date time.Time) (string, error) {
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	seq := models.DocumentSequence{
+		Series:    scheme.Series,
+		Period:    scheme.Period(date),
+		LastValue: 1,
+		UpdatedAt: time.Now(),
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "tenant_id"}, {Name: "series"}, {Name: "period"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_value": gorm.Expr("last_value + 1"),
+			"updated_at": seq.UpdatedAt,
+		}),
+	}).Create(&seq).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to advance %s sequence: %w", scheme.Series, err)
+	}
+
+	var last int64
+	err = tx.Model(&models.DocumentSequence{}).
+		Where("series = ? AND period = ?", seq.Series, seq.Period).
+		Pluck("last_value", &last).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s sequence: %w", scheme.Series, err)
+	}
+
+	return scheme.Format(date, last)
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) repository.ExchangeRateRepository {
+	return &exchangeRateRepository{db: db}
+}
+
+// GetRates implements repository.ExchangeRateRepository.
+func (e *exchangeRateRepository) GetRates(ctx context.Context, baseCurrency, currency string) ([]domain.ExchangeRate, error) {
+	var rates []models.ExchangeRate
+
+	db := e.db.WithContext(ctx).Where("base_currency = ?", baseCurrency)
+	if currency != "" {
+		db = db.Where("currency = ?", currency)
+	}
+
+	if err := db.Order("rate_date DESC, currency ASC").Find(&rates).Error; err != nil {
+		return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+
+	result := make([]domain.ExchangeRate, 0, len(rates))
+	for _, r := range rates {
+		result = append(result, mapper.ToDomainExchangeRate(r))
+	}
+
+	return result, nil
+}
+
+// GetRateOn implements repository.ExchangeRateRepository.
+func (e *exchangeRateRepository) GetRateOn(ctx context.Context, baseCurrency, currency string, date time.Time) (domain.ExchangeRate, error) {
+	var rate models.ExchangeRate
+
+	err := e.db.WithContext(ctx).
+		Where("base_currency = ? AND currency = ? AND rate_date <= ?", baseCurrency, currency, utils.DateOnly(date)).
+		Order("rate_date DESC").
+		First(&rate).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return domain.ExchangeRate{}, utils.ErrExchangeRateNotFound
+		}
+
+		return domain.ExchangeRate{}, fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+
+	return mapper.ToDomainExchangeRate(rate), nil
+}
+
+// UpsertRates implements repository.ExchangeRateRepository. A rate that was
+// already imported for the same pair and day is overwritten.
+func (e *exchangeRateRepository) UpsertRates(ctx context.Context, rates []domain.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	rows := make([]models.ExchangeRate, 0, len(rates))
+	for _, r := range rates {
+		m := mapper.ToModelExchangeRate(r)
+		m.RateDate = utils.DateOnly(m.RateDate)
+		rows = append(rows, m)
+	}
+
+	return e.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "base_currency"}, {Name: "currency"}, {Name: "rate_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&rows).Error
+}
@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExchangeRateRepository_GetRateOn(t *testing.T) {
+	db := setupTaxTestDB(t)
+	if err := db.AutoMigrate(&models.ExchangeRate{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewExchangeRateRepository(db)
+
+	day := func(d int) time.Time { return time.Date(2025, 10, d, 0, 0, 0, 0, time.Local) }
+
+	err := repo.UpsertRates(context.Background(), []domain.ExchangeRate{
+		{BaseCurrency: "IDR", Currency: "USD", Rate: 16000, RateDate: day(1)},
+		{BaseCurrency: "IDR", Currency: "USD", Rate: 16100, RateDate: day(10)},
+	})
+	assert.NoError(t, err)
+
+	// importing the same day again overwrites the rate
+	err = repo.UpsertRates(context.Background(), []domain.ExchangeRate{
+		{BaseCurrency: "IDR", Currency: "USD", Rate: 16200, RateDate: day(10)},
+	})
+	assert.NoError(t, err)
+
+	rates, err := repo.GetRates(context.Background(), "IDR", "USD")
+	assert.NoError(t, err)
+	assert.Len(t, rates, 2)
+
+	rate, err := repo.GetRateOn(context.Background(), "IDR", "USD", day(5).Add(15*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 16000.0, rate.Rate)
+
+	rate, err = repo.GetRateOn(context.Background(), "IDR", "USD", day(10).Add(9*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 16200.0, rate.Rate)
+
+	_, err = repo.GetRateOn(context.Background(), "IDR", "EUR", day(10))
+	assert.Equal(t, utils.ErrExchangeRateNotFound, err)
+}
+
+func TestReportRepository_GetInvoiceTotalsByCurrencyDay(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewReportRepository(db)
+
+	noon := func(d int) time.Time { return time.Date(2025, 10, d, 12, 0, 0, 0, time.Local) }
+
+	invoices := []TestInvoice{
+		{InvoiceNumber: "INV-R1", IssueDate: noon(1), CustomerID: 1, Currency: "IDR", Status: domain.InvoiceStatusIssued, Subtotal: domain.NewMoney(100), Tax: domain.NewMoney(10), TotalAmount: domain.NewMoney(110)},
+		{InvoiceNumber: "INV-R2", IssueDate: noon(1), CustomerID: 1, Currency: "IDR", Status: domain.InvoiceStatusIssued, Subtotal: domain.NewMoney(200), Tax: domain.NewMoney(20), TotalAmount: domain.NewMoney(220)},
+		{InvoiceNumber: "INV-R3", IssueDate: noon(1), CustomerID: 1, Currency: "USD", Status: domain.InvoiceStatusPaid, Subtotal: domain.MustParseMoney("10.50"), Tax: domain.MustParseMoney("1.05"), TotalAmount: domain.MustParseMoney("11.55")},
+		{InvoiceNumber: "INV-R4", IssueDate: noon(20), CustomerID: 1, Currency: "USD", Status: domain.InvoiceStatusPaid, Subtotal: domain.NewMoney(5), Tax: domain.MustParseMoney("0.50"), TotalAmount: domain.MustParseMoney("5.50")},
+		// drafts and voided invoices were never billed
+		{InvoiceNumber: "INV-R5", IssueDate: noon(1), CustomerID: 1, Currency: "IDR", Status: domain.InvoiceStatusDraft, Subtotal: domain.NewMoney(400), Tax: domain.NewMoney(40), TotalAmount: domain.NewMoney(440)},
+		{InvoiceNumber: "INV-R6", IssueDate: noon(1), CustomerID: 1, Currency: "USD", Status: domain.InvoiceStatusVoid, Subtotal: domain.NewMoney(50), Tax: domain.NewMoney(5), TotalAmount: domain.NewMoney(55)},
+	}
+	assert.NoError(t, db.Create(&invoices).Error)
+
+	to := noon(15)
+	totals, err := repo.GetInvoiceTotalsByCurrencyDay(context.Background(), domain.ReportFilter{To: &to})
+	assert.NoError(t, err)
+	assert.Len(t, totals, 2)
+
+	assert.Equal(t, "IDR", totals[0].Currency)
+	assert.Equal(t, int64(2), totals[0].InvoiceCount)
+	assert.Equal(t, domain.NewMoney(330), totals[0].TotalAmount)
+	assert.Equal(t, "2025-10-01", totals[0].IssueDate.Format("2006-01-02"))
+
+	assert.Equal(t, "USD", totals[1].Currency)
+	assert.Equal(t, domain.MustParseMoney("11.55"), totals[1].TotalAmount)
+}
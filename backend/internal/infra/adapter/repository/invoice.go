@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"invoice-system/internal/applications/ports/repository"
@@ -8,7 +9,6 @@ import (
 	"invoice-system/internal/infra/db/mapper"
 	"invoice-system/internal/infra/db/models"
 	"invoice-system/internal/utils"
-	"math"
 	"time"
 
 	"gorm.io/gorm"
@@ -16,15 +16,16 @@ import (
 )
 
 type invoiceRepository struct {
-	db *gorm.DB
+	db        *gorm.DB
+	numbering domain.NumberingScheme
 }
 
-func NewInvoiceRepository(db *gorm.DB) repository.InvoiceRepository {
-	return &invoiceRepository{db: db}
+func NewInvoiceRepository(db *gorm.DB, numbering domain.NumberingScheme) repository.InvoiceRepository {
+	return &invoiceRepository{db: db, numbering: numbering}
 }
 
 // GetAllInvoices implements repository.InvoiceRepository.
-func (i *invoiceRepository) GetAllInvoices(filters domain.InvoiceFilter) ([]domain.Invoice, domain.Pagination, error) {
+func (i *invoiceRepository) GetAllInvoices(ctx context.Context, filters domain.InvoiceFilter) ([]domain.Invoice, domain.Pagination, error) {
 	// helper to apply all filters consistently
 	applyFilters := func(db *gorm.DB) *gorm.DB {
 		// filter invoice id
@@ -64,29 +65,32 @@ func (i *invoiceRepository) GetAllInvoices(filters domain.InvoiceFilter) ([]doma
 			db = db.Where("status = ?", filters.Status)
 		}
 
+		// filter currency
+		if filters.Currency != "" {
+			db = db.Where("invoices.currency = ?", filters.Currency)
+		}
+
+		// filter overdue, by the due date so invoices the overdue job hasn't
+		// flagged yet are included
+		if cutoff, ok := filters.OverdueCutoff(); ok {
+			db = db.Where("invoices.status IN ? AND invoices.due_date < ?", domain.OutstandingInvoiceStatuses(), cutoff)
+		}
+
 		return db
 	}
 
 	// pagination params
-	limit := filters.Limit
-	if limit <= 0 {
-		limit = 10
-	}
-	page := filters.Page
-	if page <= 0 {
-		page = 1
-	}
-	offset := (page - 1) * limit
+	page, limit, offset := domain.NormalizePage(filters.Page, filters.Limit)
 
 	// COUNT total items with filters
 	var totalItems int64
-	countDB := applyFilters(i.db.Model(&models.Invoice{}))
+	countDB := applyFilters(i.db.WithContext(ctx).Model(&models.Invoice{}))
 	if err := countDB.Count(&totalItems).Error; err != nil {
 		return nil, domain.Pagination{}, fmt.Errorf("failed to count invoices: %w", err)
 	}
 
 	// Fetch data with preloads and ordering
-	dataDB := applyFilters(i.db.Model(&models.Invoice{})).
+	dataDB := applyFilters(i.db.WithContext(ctx).Model(&models.Invoice{})).
 		Preload("Customer").
 		Preload("Items.Item").
 		Order("invoices.created_at DESC").
@@ -102,25 +106,7 @@ func (i *invoiceRepository) GetAllInvoices(filters domain.InvoiceFilter) ([]doma
 	}
 
 	// compute pagination metadata
-	totalPages := int(math.Ceil(float64(totalItems) / float64(limit)))
-	var prevPage *int
-	var nextPage *int
-	if page > 1 {
-		p := page - 1
-		prevPage = &p
-	}
-	if page < totalPages {
-		n := page + 1
-		nextPage = &n
-	}
-	pagination := domain.Pagination{
-		TotalItems:  totalItems,
-		TotalPages:  totalPages,
-		CurrentPage: page,
-		PrevPage:    prevPage,
-		NextPage:    nextPage,
-		Limit:       limit,
-	}
+	pagination := domain.NewPagination(totalItems, page, limit)
 
 	// map models to domain
 	result := make([]domain.Invoice, 0, len(invoices))
@@ -131,23 +117,26 @@ func (i *invoiceRepository) GetAllInvoices(filters domain.InvoiceFilter) ([]doma
 	return result, pagination, nil
 }
 
-func (i *invoiceRepository) CreateInvoice(invoice domain.Invoice) error {
-	invModel := mapper.ToModelInvoice(invoice)
+func (i *invoiceRepository) CreateInvoice(ctx context.Context, invoice *domain.Invoice) error {
+	invModel := mapper.ToModelInvoice(*invoice)
+	invModel.Version = 1
 
-	err := i.db.Transaction(func(tx *gorm.DB) error {
-
-		last, err := i.getLastInvoiceCode()
+	err := i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 
+		number, err := allocateDocumentNumber(tx, i.numbering, invoice.IssueDate)
 		if err != nil {
-			return fmt.Errorf("failed get last invoice code : %w", err)
+			return err
 		}
-
-		// generate code invoice
-		codeInvice := utils.GenerateNextInvoiceCode(last)
-		invModel.InvoiceNumber = codeInvice
+		invModel.InvoiceNumber = number
 
 		// Buat invoice
 		if err := tx.Omit("Items").Create(&invModel).Error; err != nil {
+			if invModel.QuoteID != nil && utils.IsDuplicateKeyError(err) {
+				return utils.ErrQuoteAlreadyConverted
+			}
+			if invModel.RecurringID != nil && utils.IsDuplicateKeyError(err) {
+				return utils.ErrRecurringRunExists
+			}
 			return fmt.Errorf("create invoice failed: %w", err)
 		}
 
@@ -163,20 +152,32 @@ func (i *invoiceRepository) CreateInvoice(invoice domain.Invoice) error {
 			}
 		}
 
-		return nil
+		if invModel.QuoteID != nil {
+			if err := markQuoteConverted(tx, *invModel.QuoteID, invModel.ID); err != nil {
+				return err
+			}
+		}
+
+		return recordAudit(tx, domain.AuditEntityInvoice, invModel.ID, domain.AuditActionCreate, nil, invModel)
 	})
 
 	if err != nil {
+		if errors.Is(err, utils.ErrQuoteAlreadyConverted) || errors.Is(err, utils.ErrRecurringRunExists) {
+			return err
+		}
 		return fmt.Errorf("failed to create invoice: %w", err)
 	}
 
+	invoice.ID = invModel.ID
+	invoice.InvoiceNumber = invModel.InvoiceNumber
+	invoice.Version = invModel.Version
 	return nil
 }
 
-func (i *invoiceRepository) GetInvoiceByID(id uint) (domain.Invoice, error) {
+func (i *invoiceRepository) GetInvoiceByID(ctx context.Context, id uint) (domain.Invoice, error) {
 	var invModel models.Invoice
 
-	err := i.db.Preload("Customer").Preload("Items.Item").First(&invModel, id).Error
+	err := i.db.WithContext(ctx).Preload("Customer").Preload("Items.Item").First(&invModel, id).Error
 	if err != nil {
 		if utils.IsNotFound(err) {
 			return domain.Invoice{}, utils.ErrInvoiceNotFound
@@ -188,13 +189,22 @@ func (i *invoiceRepository) GetInvoiceByID(id uint) (domain.Invoice, error) {
 	return mapper.ToDomainInvoice(invModel), nil
 }
 
-func (i *invoiceRepository) UpdateInvoice(id uint, invoice domain.Invoice) error {
-	return i.db.Transaction(func(tx *gorm.DB) error {
+// UpdateInvoice implements repository.InvoiceRepository. invoice.Version
+// must be the version the edit was made to; if the invoice has changed since,
+// nothing is written and ErrInvoiceVersionConflict is returned. The version
+// is part of the WHERE clause of the final update, so of two concurrent edits
+// to the same version only the first one succeeds.
+func (i *invoiceRepository) UpdateInvoice(ctx context.Context, id uint, invoice domain.Invoice) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Ambil invoice lama beserta items
 		var existing models.Invoice
 		if err := tx.Preload("Items").First(&existing, id).Error; err != nil {
 			return fmt.Errorf("invoice not found: %w", err)
 		}
+		if existing.Version != invoice.Version {
+			return utils.ErrInvoiceVersionConflict
+		}
+		before := existing
 
 		// Mapping item_id lama -> model
 		existingItems := make(map[uint]models.InvoiceItem)
@@ -202,16 +212,18 @@ func (i *invoiceRepository) UpdateInvoice(id uint, invoice domain.Invoice) error
 			existingItems[it.ItemID] = it
 		}
 
-		var subtotal float64
 		for _, newItem := range invoice.Items {
-			total := newItem.Price * float64(newItem.Quantity)
-
 			if oldItem, ok := existingItems[newItem.ItemID]; ok {
 				// Update item lama
-				if err := tx.Model(&oldItem).Updates(models.InvoiceItem{
-					Quantity:   newItem.Quantity,
-					Price:      newItem.Price,
-					TotalPrice: total,
+				if err := tx.Model(&oldItem).Updates(map[string]interface{}{
+					"quantity":    newItem.Quantity,
+					"unit":        newItem.Unit,
+					"price":       newItem.Price,
+					"total_price": newItem.TotalPrice,
+					"tax_rate_id": newItem.TaxRateID,
+					"tax_code":    newItem.TaxCode,
+					"tax_rate":    newItem.TaxRate,
+					"tax_amount":  newItem.TaxAmount,
 				}).Error; err != nil {
 					return err
 				}
@@ -222,13 +234,17 @@ func (i *invoiceRepository) UpdateInvoice(id uint, invoice domain.Invoice) error
 					InvoiceID:  id,
 					ItemID:     newItem.ItemID,
 					Quantity:   newItem.Quantity,
+					Unit:       newItem.Unit,
 					Price:      newItem.Price,
-					TotalPrice: total,
+					TotalPrice: newItem.TotalPrice,
+					TaxRateID:  newItem.TaxRateID,
+					TaxCode:    newItem.TaxCode,
+					TaxRate:    newItem.TaxRate,
+					TaxAmount:  newItem.TaxAmount,
 				}).Error; err != nil {
 					return err
 				}
 			}
-			subtotal += total
 		}
 
 		// Hapus item yang ada di DB tapi tidak ada di request
@@ -238,30 +254,222 @@ func (i *invoiceRepository) UpdateInvoice(id uint, invoice domain.Invoice) error
 			}
 		}
 
-		// Update total invoice
+		// Update total invoice; zero fields, such as an empty currency, keep
+		// what the invoice already has
 		if err := tx.Model(&existing).Updates(models.Invoice{
-			IssueDate:   invoice.IssueDate,
-			DueDate:     invoice.DueDate,
-			Subject:     invoice.Subject,
-			CustomerID:  invoice.CustomerID,
-			Status:      invoice.Status,
-			Subtotal:    subtotal,
-			Tax:         subtotal * (10.0 / 100.0),
-			TotalAmount: subtotal + (subtotal * (10.0 / 100.0)),
-			TotalItems:  len(invoice.Items),
-			UpdatedAt:   time.Now(),
+			IssueDate:  invoice.IssueDate,
+			DueDate:    invoice.DueDate,
+			Subject:    invoice.Subject,
+			CustomerID: invoice.CustomerID,
+			Currency:   invoice.Currency,
+			Status:     invoice.Status,
+			UpdatedAt:  time.Now(),
 		}).Error; err != nil {
 			return err
 		}
 
+		// totals may legitimately be zero (e.g. tax on exempt lines), so they
+		// are written with a map instead of the struct above
+		result := tx.Model(&models.Invoice{}).
+			Where("id = ? AND version = ?", id, invoice.Version).
+			Updates(map[string]interface{}{
+				"subtotal":     invoice.Subtotal,
+				"tax":          invoice.Tax,
+				"total_amount": invoice.TotalAmount,
+				"total_items":  invoice.TotalItems,
+				"version":      gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return utils.ErrInvoiceVersionConflict
+		}
+
+		var after models.Invoice
+		if err := tx.Preload("Items").First(&after, id).Error; err != nil {
+			return fmt.Errorf("failed to reload invoice: %w", err)
+		}
+
+		return recordAudit(tx, domain.AuditEntityInvoice, id, domain.AuditActionUpdate, before, after)
+	})
+}
+
+// UpdateInvoiceStatus implements repository.InvoiceRepository. The current
+// status is part of the WHERE clause so two concurrent transitions can't both
+// succeed.
+func (i *invoiceRepository) UpdateInvoiceStatus(ctx context.Context, id uint, from, to string) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var before models.Invoice
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ?", from).Limit(1).Find(&before, id).Error
+		if err != nil {
+			return fmt.Errorf("failed to load invoice: %w", err)
+		}
+
+		result := tx.Model(&models.Invoice{}).
+			Where("id = ? AND status = ?", id, from).
+			Updates(statusUpdates(to, time.Now()))
+		if result.Error != nil {
+			return fmt.Errorf("failed to update invoice status: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return utils.ErrInvalidStatusTransition
+		}
+
+		return auditInvoiceUpdate(tx, before)
+	})
+}
+
+// MarkOverdueInvoices implements repository.InvoiceRepository. The invoices
+// to flag are locked before they are updated, so when several replicas run
+// it at once each invoice is flagged, and audited, exactly once and the
+// others find nothing left to do.
+func (i *invoiceRepository) MarkOverdueInvoices(ctx context.Context, asOf, now time.Time) (int64, error) {
+	var flagged int64
+
+	err := i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		statuses := []string{domain.InvoiceStatusIssued, domain.InvoiceStatusPartiallyPaid}
+
+		var due []models.Invoice
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status IN ? AND due_date < ?", statuses, asOf).
+			Find(&due).Error
+		if err != nil || len(due) == 0 {
+			return err
+		}
+
+		ids := make([]uint, len(due))
+		for idx, inv := range due {
+			ids[idx] = inv.ID
+		}
+
+		result := tx.Model(&models.Invoice{}).
+			Where("id IN ? AND status IN ?", ids, statuses).
+			Updates(statusUpdates(domain.InvoiceStatusOverdue, now))
+		if result.Error != nil {
+			return result.Error
+		}
+		flagged = result.RowsAffected
+
+		var overdue []models.Invoice
+		if err := tx.Find(&overdue, ids).Error; err != nil {
+			return err
+		}
+
+		after := make(map[uint]models.Invoice, len(overdue))
+		for _, inv := range overdue {
+			after[inv.ID] = inv
+		}
+		for _, before := range due {
+			if err := recordAudit(tx, domain.AuditEntityInvoice, before.ID, domain.AuditActionUpdate, before, after[before.ID]); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark overdue invoices: %w", err)
+	}
+
+	return flagged, nil
 }
 
-func (i *invoiceRepository) getLastInvoiceCode() (string, error) {
-	var last string
+// DeleteInvoice implements repository.InvoiceRepository. A draft was never
+// sent to the customer, so it and its items are removed for good and the
+// quote it came from may be converted again. Any other invoice is only
+// soft-deleted, items included, so its number stays taken. An invoice with
+// payments or issued credit notes is never deleted, since they would be
+// left pointing at it; it has to be voided instead.
+func (i *invoiceRepository) DeleteInvoice(ctx context.Context, id uint) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.Invoice
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Items").First(&existing, id).Error
+		if err != nil {
+			if utils.IsNotFound(err) {
+				return utils.ErrInvoiceNotFound
+			}
+			return fmt.Errorf("failed to load invoice: %w", err)
+		}
+
+		var payments, creditNotes int64
+		if err := tx.Model(&models.Payment{}).Where("invoice_id = ?", id).Count(&payments).Error; err != nil {
+			return fmt.Errorf("failed to count invoice payments: %w", err)
+		}
+		err = tx.Model(&models.CreditNote{}).
+			Where("invoice_id = ? AND status = ?", id, domain.CreditNoteStatusIssued).
+			Count(&creditNotes).Error
+		if err != nil {
+			return fmt.Errorf("failed to count invoice credit notes: %w", err)
+		}
+		if payments > 0 || creditNotes > 0 {
+			return utils.ErrInvoiceHasSettlements
+		}
 
-	err := i.db.Table("invoices").Select("invoice_number").Order("invoice_number DESC").Limit(1).Clauses(clause.Locking{Strength: "UPDATE"}).Scan(&last).Error
+		if err := recordAudit(tx, domain.AuditEntityInvoice, id, domain.AuditActionDelete, existing, nil); err != nil {
+			return err
+		}
+
+		if existing.Status != domain.InvoiceStatusDraft {
+			if err := tx.Where("invoice_id = ?", id).Delete(&models.InvoiceItem{}).Error; err != nil {
+				return fmt.Errorf("failed to delete invoice items: %w", err)
+			}
+			if err := tx.Delete(&existing).Error; err != nil {
+				return fmt.Errorf("failed to delete invoice: %w", err)
+			}
+			return nil
+		}
+
+		if existing.QuoteID != nil {
+			err := tx.Model(&models.Quote{}).
+				Where("id = ? AND invoice_id = ?", *existing.QuoteID, id).
+				Update("invoice_id", nil).Error
+			if err != nil {
+				return fmt.Errorf("failed to release quote: %w", err)
+			}
+		}
+
+		if err := tx.Unscoped().Where("invoice_id = ?", id).Delete(&models.InvoiceItem{}).Error; err != nil {
+			return fmt.Errorf("failed to delete invoice items: %w", err)
+		}
+		if err := tx.Unscoped().Delete(&existing).Error; err != nil {
+			return fmt.Errorf("failed to delete invoice: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// auditInvoiceUpdate records the change of an invoice from before to what it
+// is now in tx. It is for changes that leave the lines alone, so neither
+// side carries them.
+func auditInvoiceUpdate(tx *gorm.DB, before models.Invoice) error {
+	var after models.Invoice
+	if err := tx.First(&after, before.ID).Error; err != nil {
+		return fmt.Errorf("failed to reload invoice: %w", err)
+	}
+
+	return recordAudit(tx, domain.AuditEntityInvoice, before.ID, domain.AuditActionUpdate, before, after)
+}
+
+// statusUpdates returns the columns to set when an invoice moves to status.
+// The first time an invoice goes overdue is kept in overdue_at and the time
+// it is voided in voided_at. Like every change, it raises the version.
+func statusUpdates(status string, now time.Time) map[string]interface{} {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": now,
+		"version":    gorm.Expr("version + 1"),
+	}
+
+	if status == domain.InvoiceStatusOverdue {
+		updates["overdue_at"] = gorm.Expr("COALESCE(overdue_at, ?)", now)
+	}
+	if status == domain.InvoiceStatusVoid {
+		updates["voided_at"] = now
+	}
 
-	return last, err
+	return updates
 }
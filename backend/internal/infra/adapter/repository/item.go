@@ -1,12 +1,17 @@
 package repository
 
 import (
+	"context"
+	"fmt"
 	"invoice-system/internal/applications/ports/repository"
 	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/db/mapper"
 	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type itemRepository struct {
@@ -20,10 +25,10 @@ func NewItemRepository(db *gorm.DB) repository.ItemRepository {
 }
 
 // GetAllItems implements repository.ItemRepository.
-func (i *itemRepository) GetAllItems(NameOrType string, limit uint) ([]domain.Item, error) {
+func (i *itemRepository) GetAllItems(ctx context.Context, NameOrType string, limit uint) ([]domain.Item, error) {
 	var models []models.Item
 
-	db := i.db
+	db := i.db.WithContext(ctx)
 
 	if limit <= 0 {
 		limit = 10
@@ -32,7 +37,8 @@ func (i *itemRepository) GetAllItems(NameOrType string, limit uint) ([]domain.It
 	db = db.Limit(int(limit))
 
 	if NameOrType != "" {
-		db = db.Where("name LIKE ? OR type LIKE ?", "%"+NameOrType+"%", "%"+NameOrType+"%")
+		like := "%" + NameOrType + "%"
+		db = db.Where("name LIKE ? OR type LIKE ? OR sku LIKE ?", like, like, like)
 	}
 
 	err := db.Where("is_active = ?", 1).Find(&models).Error
@@ -48,8 +54,101 @@ func (i *itemRepository) GetAllItems(NameOrType string, limit uint) ([]domain.It
 	return items, nil
 }
 
-func (i *itemRepository) AddItem(item domain.Item) error {
+// GetItemByID implements repository.ItemRepository.
+func (i *itemRepository) GetItemByID(ctx context.Context, id uint) (domain.Item, error) {
+	var m models.Item
+
+	if err := i.db.WithContext(ctx).First(&m, id).Error; err != nil {
+		if utils.IsNotFound(err) {
+			return domain.Item{}, utils.ErrItemNotFound
+		}
+
+		return domain.Item{}, fmt.Errorf("failed to get item by ID: %w", err)
+	}
+
+	return mapper.ToDomainItem(m), nil
+}
+
+// GetItemsByIDs implements repository.ItemRepository. Inactive items are
+// included so invoices that still reference them can be edited; ids that
+// don't exist are simply missing from the result.
+func (i *itemRepository) GetItemsByIDs(ctx context.Context, ids []uint) (map[uint]domain.Item, error) {
+	items := make(map[uint]domain.Item, len(ids))
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	var ms []models.Item
+	if err := i.db.WithContext(ctx).Where("id IN ?", ids).Find(&ms).Error; err != nil {
+		return nil, fmt.Errorf("failed to get items: %w", err)
+	}
+
+	for _, m := range ms {
+		items[m.ID] = mapper.ToDomainItem(m)
+	}
+
+	return items, nil
+}
+
+// AddItem implements repository.ItemRepository.
+func (i *itemRepository) AddItem(ctx context.Context, item domain.Item) error {
 	model := mapper.ToModelItem(item)
 
-	return i.db.Create(&model).Error
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			if utils.IsDuplicateKeyError(err) {
+				return utils.ErrItemAlreadyExists
+			}
+
+			return err
+		}
+
+		return recordAudit(tx, domain.AuditEntityItem, model.ID, domain.AuditActionCreate, nil, model)
+	})
+}
+
+// UpdateItem implements repository.ItemRepository. Invoice lines keep the
+// price they were created with, so changing the catalog never rewrites
+// existing invoices.
+func (i *itemRepository) UpdateItem(ctx context.Context, item domain.Item) error {
+	m := mapper.ToModelItem(item)
+
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var before models.Item
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&before, item.ID).Error; err != nil {
+			if utils.IsNotFound(err) {
+				return utils.ErrItemNotFound
+			}
+
+			return fmt.Errorf("failed to get item by ID: %w", err)
+		}
+
+		err := tx.Model(&models.Item{}).
+			Where("id = ?", item.ID).
+			Updates(map[string]interface{}{
+				"sku":         m.SKU,
+				"name":        m.Name,
+				"type":        m.Type,
+				"description": m.Description,
+				"unit_price":  m.UnitPrice,
+				"unit":        m.Unit,
+				"is_active":   m.IsActive,
+				"tax_rate_id": m.TaxRateID,
+				"updated_at":  time.Now(),
+			}).Error
+		if err != nil {
+			if utils.IsDuplicateKeyError(err) {
+				return utils.ErrItemAlreadyExists
+			}
+
+			return fmt.Errorf("failed to update item: %w", err)
+		}
+
+		var after models.Item
+		if err := tx.First(&after, item.ID).Error; err != nil {
+			return fmt.Errorf("failed to reload item: %w", err)
+		}
+
+		return recordAudit(tx, domain.AuditEntityItem, item.ID, domain.AuditActionUpdate, before, after)
+	})
 }
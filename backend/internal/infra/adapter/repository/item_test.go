@@ -1,10 +1,14 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"invoice-system/internal/domain"
+	infradb "invoice-system/internal/infra/db"
+	"invoice-system/internal/infra/db/mapper"
 	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -15,8 +19,11 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	if err != nil {
 		t.Fatalf("failed to open sqlite in-memory: %v", err)
 	}
+	if err := db.Use(infradb.Tenancy{}); err != nil {
+		t.Fatalf("failed to register tenancy: %v", err)
+	}
 
-	if err := db.AutoMigrate(&models.Item{}); err != nil {
+	if err := db.AutoMigrate(&models.Item{}, &models.AuditLog{}); err != nil {
 		t.Fatalf("failed to migrate models: %v", err)
 	}
 
@@ -40,7 +47,7 @@ func TestGetAllItems(t *testing.T) {
 	r := NewItemRepository(db)
 
 	t.Run("limit applied", func(t *testing.T) {
-		result, err := r.GetAllItems("", 2)
+		result, err := r.GetAllItems(context.Background(), "", 2)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -50,7 +57,7 @@ func TestGetAllItems(t *testing.T) {
 	})
 
 	t.Run("filter by name", func(t *testing.T) {
-		result, err := r.GetAllItems("Lap", 10)
+		result, err := r.GetAllItems(context.Background(), "Lap", 10)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -63,7 +70,7 @@ func TestGetAllItems(t *testing.T) {
 	})
 
 	t.Run("filter by type", func(t *testing.T) {
-		result, err := r.GetAllItems("Furniture", 10)
+		result, err := r.GetAllItems(context.Background(), "Furniture", 10)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -73,7 +80,7 @@ func TestGetAllItems(t *testing.T) {
 	})
 
 	t.Run("exclude inactive items", func(t *testing.T) {
-		result, err := r.GetAllItems("", 10)
+		result, err := r.GetAllItems(context.Background(), "", 10)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -95,7 +102,7 @@ func TestAddItem(t *testing.T) {
 	}
 
 	t.Run("success insert", func(t *testing.T) {
-		err := r.AddItem(item)
+		err := r.AddItem(context.Background(), item)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -107,3 +114,76 @@ func TestAddItem(t *testing.T) {
 		}
 	})
 }
+
+func TestAddItem_DuplicateSKU(t *testing.T) {
+	db := setupTestDB(t)
+	r := NewItemRepository(db)
+
+	if err := r.AddItem(context.Background(), domain.Item{SKU: "SVC-001", Name: "Consulting", Type: "Service"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// items without a SKU must not collide with each other
+	for i := 0; i < 2; i++ {
+		if err := r.AddItem(context.Background(), domain.Item{Name: "Misc", Type: "Service"}); err != nil {
+			t.Fatalf("expected no error for item without SKU, got %v", err)
+		}
+	}
+
+	err := r.AddItem(context.Background(), domain.Item{SKU: "SVC-001", Name: "Other", Type: "Service"})
+	if err != utils.ErrItemAlreadyExists {
+		t.Fatalf("expected ErrItemAlreadyExists, got %v", err)
+	}
+}
+
+func TestGetItemsByIDs(t *testing.T) {
+	db := setupTestDB(t)
+	r := NewItemRepository(db)
+
+	priced := models.Item{Name: "Consulting", Type: "Service", UnitPrice: domain.MustParseMoney("250.50"), Unit: "hour"}
+	other := models.Item{Name: "Hosting", Type: "Service"}
+	db.Create(&priced)
+	db.Create(&other)
+
+	items, err := r.GetItemsByIDs(context.Background(), []uint{priced.ID, 999})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(items))
+	}
+
+	got := items[priced.ID]
+	if got.UnitPrice != domain.MustParseMoney("250.50") || got.Unit != "hour" {
+		t.Fatalf("unexpected catalog data: %+v", got)
+	}
+}
+
+func TestUpdateItem(t *testing.T) {
+	db := setupTestDB(t)
+	r := NewItemRepository(db)
+
+	m := models.Item{Name: "Consulting", Type: "Service", UnitPrice: domain.NewMoney(250), Unit: "hour"}
+	db.Create(&m)
+
+	item := mapper.ToDomainItem(m)
+	item.UnitPrice = domain.NewMoney(300)
+	item.SKU = "SVC-002"
+
+	if err := r.UpdateItem(context.Background(), item); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, err := r.GetItemByID(context.Background(), m.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.UnitPrice != domain.NewMoney(300) || updated.SKU != "SVC-002" {
+		t.Fatalf("item not updated: %+v", updated)
+	}
+
+	item.ID = 999
+	if err := r.UpdateItem(context.Background(), item); err != utils.ErrItemNotFound {
+		t.Fatalf("expected ErrItemNotFound, got %v", err)
+	}
+}
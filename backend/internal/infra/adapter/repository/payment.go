@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type paymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) repository.PaymentRepository {
+	return &paymentRepository{db: db}
+}
+
+// GetPaymentsByInvoiceID implements repository.PaymentRepository.
+func (p *paymentRepository) GetPaymentsByInvoiceID(ctx context.Context, invoiceID uint) ([]domain.Payment, error) {
+	var payments []models.Payment
+
+	err := p.db.WithContext(ctx).Where("invoice_id = ?", invoiceID).
+		Order("payment_date ASC, id ASC").
+		Find(&payments).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payments: %w", err)
+	}
+
+	result := make([]domain.Payment, 0, len(payments))
+	for _, m := range payments {
+		result = append(result, mapper.ToDomainPayment(m))
+	}
+
+	return result, nil
+}
+
+// CreatePayment implements repository.PaymentRepository.
+func (p *paymentRepository) CreatePayment(ctx context.Context, payment *domain.Payment) (domain.Invoice, error) {
+	var invoice domain.Invoice
+
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var invModel models.Invoice
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&invModel, payment.InvoiceID).Error
+		if err != nil {
+			if utils.IsNotFound(err) {
+				return utils.ErrInvoiceNotFound
+			}
+			return fmt.Errorf("failed to load invoice: %w", err)
+		}
+		before := invModel
+
+		invoice = mapper.ToDomainInvoice(invModel)
+		if err := invoice.ApplyPayment(payment.Amount); err != nil {
+			return err
+		}
+
+		m := mapper.ToModelPayment(*payment)
+		if err := tx.Create(&m).Error; err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+
+		if err := tx.Model(&invModel).Updates(map[string]interface{}{
+			"amount_paid": invoice.AmountPaid,
+			"status":      invoice.Status,
+			"updated_at":  time.Now(),
+			"version":     gorm.Expr("version + 1"),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update invoice balance: %w", err)
+		}
+
+		*payment = mapper.ToDomainPayment(m)
+		return auditInvoiceUpdate(tx, before)
+	})
+	if err != nil {
+		return domain.Invoice{}, err
+	}
+
+	return invoice, nil
+}
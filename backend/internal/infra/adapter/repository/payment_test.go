@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentRepository_CreatePayment(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.Payment{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewPaymentRepository(db)
+
+	inv := TestInvoice{
+		InvoiceNumber: "INV-P1",
+		CustomerID:    1,
+		Status:        domain.InvoiceStatusIssued,
+		TotalAmount:   domain.MustParseMoney("110.00"),
+	}
+	db.Create(&inv)
+
+	first := domain.Payment{InvoiceID: inv.ID, Amount: domain.NewMoney(60), PaymentDate: time.Now(), Method: domain.PaymentMethodCash}
+	invoice, err := repo.CreatePayment(context.Background(), &first)
+	assert.NoError(t, err)
+	assert.NotZero(t, first.ID)
+	assert.Equal(t, domain.InvoiceStatusPartiallyPaid, invoice.Status)
+	assert.Equal(t, domain.NewMoney(50), invoice.BalanceDue())
+
+	// more than what is left is rejected and nothing is written
+	tooMuch := domain.Payment{InvoiceID: inv.ID, Amount: domain.MustParseMoney("50.01"), PaymentDate: time.Now(), Method: domain.PaymentMethodCash}
+	_, err = repo.CreatePayment(context.Background(), &tooMuch)
+	assert.Equal(t, domain.ErrOverpayment, err)
+
+	rest := domain.Payment{InvoiceID: inv.ID, Amount: domain.NewMoney(50), PaymentDate: time.Now(), Method: domain.PaymentMethodBankTransfer, Reference: "TRX-1"}
+	invoice, err = repo.CreatePayment(context.Background(), &rest)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusPaid, invoice.Status)
+
+	var stored TestInvoice
+	db.First(&stored, inv.ID)
+	assert.Equal(t, domain.InvoiceStatusPaid, stored.Status)
+	assert.Equal(t, domain.NewMoney(110), stored.AmountPaid)
+
+	payments, err := repo.GetPaymentsByInvoiceID(context.Background(), inv.ID)
+	assert.NoError(t, err)
+	assert.Len(t, payments, 2)
+	assert.Equal(t, "TRX-1", payments[1].Reference)
+
+	_, err = repo.CreatePayment(context.Background(), &domain.Payment{InvoiceID: 999, Amount: domain.NewMoney(1), Method: domain.PaymentMethodCash})
+	assert.Equal(t, utils.ErrInvoiceNotFound, err)
+}
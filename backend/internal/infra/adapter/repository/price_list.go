@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type priceListRepository struct {
+	db *gorm.DB
+}
+
+func NewPriceListRepository(db *gorm.DB) repository.PriceListRepository {
+	return &priceListRepository{db: db}
+}
+
+// GetAllPriceLists implements repository.PriceListRepository.
+func (p *priceListRepository) GetAllPriceLists(ctx context.Context) ([]domain.PriceList, error) {
+	var lists []models.PriceList
+
+	if err := p.db.WithContext(ctx).Order("valid_from DESC, name ASC").Find(&lists).Error; err != nil {
+		return nil, fmt.Errorf("failed to get price lists: %w", err)
+	}
+
+	result := make([]domain.PriceList, 0, len(lists))
+	for _, l := range lists {
+		result = append(result, mapper.ToDomainPriceList(l))
+	}
+
+	return result, nil
+}
+
+// GetPriceListByID implements repository.PriceListRepository. The list is
+// returned with its item prices.
+func (p *priceListRepository) GetPriceListByID(ctx context.Context, id uint) (domain.PriceList, error) {
+	var list models.PriceList
+
+	err := p.db.WithContext(ctx).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("item_id ASC") }).
+		First(&list, id).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return domain.PriceList{}, utils.ErrPriceListNotFound
+		}
+
+		return domain.PriceList{}, fmt.Errorf("failed to get price list by ID: %w", err)
+	}
+
+	return mapper.ToDomainPriceList(list), nil
+}
+
+// CreatePriceList implements repository.PriceListRepository.
+func (p *priceListRepository) CreatePriceList(ctx context.Context, priceList *domain.PriceList) error {
+	m := mapper.ToModelPriceList(*priceList)
+	m.ValidFrom = utils.DateOnly(m.ValidFrom)
+	m.ValidTo = dateOnlyPtr(m.ValidTo)
+
+	if err := p.db.WithContext(ctx).Create(&m).Error; err != nil {
+		if utils.IsDuplicateKeyError(err) {
+			return utils.ErrPriceListAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create price list: %w", err)
+	}
+
+	*priceList = mapper.ToDomainPriceList(m)
+	return nil
+}
+
+// UpdatePriceList implements repository.PriceListRepository. Closing a list
+// by setting ValidTo and starting a new one keeps the old prices as history.
+func (p *priceListRepository) UpdatePriceList(ctx context.Context, priceList domain.PriceList) error {
+	result := p.db.WithContext(ctx).Model(&models.PriceList{}).
+		Where("id = ?", priceList.ID).
+		Updates(map[string]interface{}{
+			"name":        priceList.Name,
+			"description": priceList.Description,
+			"is_default":  priceList.IsDefault,
+			"valid_from":  utils.DateOnly(priceList.ValidFrom),
+			"valid_to":    dateOnlyPtr(priceList.ValidTo),
+			"updated_at":  time.Now(),
+		})
+	if result.Error != nil {
+		if utils.IsDuplicateKeyError(result.Error) {
+			return utils.ErrPriceListAlreadyExists
+		}
+
+		return fmt.Errorf("failed to update price list: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return utils.ErrPriceListNotFound
+	}
+
+	return nil
+}
+
+// UpsertPriceListItem implements repository.PriceListRepository. Setting the
+// price of an item that is already on the list overwrites it.
+func (p *priceListRepository) UpsertPriceListItem(ctx context.Context, item domain.PriceListItem) error {
+	m := mapper.ToModelPriceListItem(item)
+
+	return p.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "price_list_id"}, {Name: "item_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"unit_price", "updated_at"}),
+	}).Create(&m).Error
+}
+
+// DeletePriceListItem implements repository.PriceListRepository.
+func (p *priceListRepository) DeletePriceListItem(ctx context.Context, priceListID, itemID uint) error {
+	result := p.db.WithContext(ctx).
+		Where("price_list_id = ? AND item_id = ?", priceListID, itemID).
+		Delete(&models.PriceListItem{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete price list item: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return utils.ErrItemNotFound
+	}
+
+	return nil
+}
+
+// GetEffectivePrices implements repository.PriceListRepository.
+func (p *priceListRepository) GetEffectivePrices(ctx context.Context, itemIDs []uint, priceListID *uint, date time.Time) ([]domain.PriceListPrice, error) {
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+
+	day := utils.DateOnly(date)
+
+	db := p.db.WithContext(ctx).Model(&models.PriceListItem{}).
+		Select("price_list_items.price_list_id, price_list_items.item_id, price_list_items.unit_price, "+
+			"price_lists.is_default, price_lists.valid_from").
+		Joins("JOIN price_lists ON price_lists.id = price_list_items.price_list_id AND price_lists.deleted_at IS NULL").
+		Where("price_list_items.item_id IN ?", itemIDs).
+		Where("price_lists.valid_from <= ? AND (price_lists.valid_to IS NULL OR price_lists.valid_to >= ?)", day, day)
+
+	if priceListID != nil {
+		db = db.Where("price_lists.is_default = ? OR price_lists.id = ?", true, *priceListID)
+	} else {
+		db = db.Where("price_lists.is_default = ?", true)
+	}
+
+	var prices []domain.PriceListPrice
+	if err := db.Scan(&prices).Error; err != nil {
+		return nil, fmt.Errorf("failed to get effective prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+func dateOnlyPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	day := utils.DateOnly(*t)
+	return &day
+}
@@ -0,0 +1,67 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceListRepository_GetEffectivePrices(t *testing.T) {
+	db := setupTaxTestDB(t)
+	if err := db.AutoMigrate(&models.PriceList{}, &models.PriceListItem{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewPriceListRepository(db)
+
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.Local) }
+	juneEnd := day(6, 30)
+
+	first := domain.PriceList{Name: "2025 H1", IsDefault: true, ValidFrom: day(1, 1), ValidTo: &juneEnd}
+	second := domain.PriceList{Name: "2025 H2", IsDefault: true, ValidFrom: day(7, 1)}
+	wholesale := domain.PriceList{Name: "Wholesale", ValidFrom: day(1, 1)}
+	for _, l := range []*domain.PriceList{&first, &second, &wholesale} {
+		assert.NoError(t, repo.CreatePriceList(context.Background(), l))
+	}
+
+	err := repo.CreatePriceList(context.Background(), &domain.PriceList{Name: "Wholesale", ValidFrom: day(1, 1)})
+	assert.Equal(t, utils.ErrPriceListAlreadyExists, err)
+
+	assert.NoError(t, repo.UpsertPriceListItem(context.Background(), domain.PriceListItem{PriceListID: first.ID, ItemID: 1, UnitPrice: domain.NewMoney(100)}))
+	assert.NoError(t, repo.UpsertPriceListItem(context.Background(), domain.PriceListItem{PriceListID: second.ID, ItemID: 1, UnitPrice: domain.NewMoney(110)}))
+	assert.NoError(t, repo.UpsertPriceListItem(context.Background(), domain.PriceListItem{PriceListID: wholesale.ID, ItemID: 1, UnitPrice: domain.NewMoney(80)}))
+
+	// setting the price again overwrites it
+	assert.NoError(t, repo.UpsertPriceListItem(context.Background(), domain.PriceListItem{PriceListID: second.ID, ItemID: 1, UnitPrice: domain.NewMoney(115)}))
+
+	prices, err := repo.GetEffectivePrices(context.Background(), []uint{1}, nil, day(6, 30).Add(18*time.Hour))
+	assert.NoError(t, err)
+	if assert.Len(t, prices, 1) {
+		assert.Equal(t, first.ID, prices[0].PriceListID)
+		assert.Equal(t, domain.NewMoney(100), prices[0].UnitPrice)
+		assert.True(t, prices[0].IsDefault)
+	}
+
+	prices, err = repo.GetEffectivePrices(context.Background(), []uint{1}, nil, day(8, 1))
+	assert.NoError(t, err)
+	if assert.Len(t, prices, 1) {
+		assert.Equal(t, domain.NewMoney(115), prices[0].UnitPrice)
+	}
+
+	prices, err = repo.GetEffectivePrices(context.Background(), []uint{1}, &wholesale.ID, day(8, 1))
+	assert.NoError(t, err)
+	assert.Len(t, prices, 2)
+
+	list, err := repo.GetPriceListByID(context.Background(), second.ID)
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	_, err = repo.GetPriceListByID(context.Background(), 999)
+	assert.Equal(t, utils.ErrPriceListNotFound, err)
+}
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type quoteRepository struct {
+	db        *gorm.DB
+	numbering domain.NumberingScheme
+}
+
+func NewQuoteRepository(db *gorm.DB, numbering domain.NumberingScheme) repository.QuoteRepository {
+	return &quoteRepository{db: db, numbering: numbering}
+}
+
+// GetAllQuotes implements repository.QuoteRepository.
+func (r *quoteRepository) GetAllQuotes(ctx context.Context, filter domain.QuoteFilter) ([]domain.Quote, domain.Pagination, error) {
+	applyFilters := func(db *gorm.DB) *gorm.DB {
+		if filter.CustomerID != nil {
+			db = db.Where("customer_id = ?", *filter.CustomerID)
+		}
+
+		if filter.Status != "" {
+			db = db.Where("status = ?", filter.Status)
+		}
+
+		return db
+	}
+
+	page, limit, offset := domain.NormalizePage(filter.Page, filter.Limit)
+
+	var totalItems int64
+	if err := applyFilters(r.db.WithContext(ctx).Model(&models.Quote{})).Count(&totalItems).Error; err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to count quotes: %w", err)
+	}
+
+	var quotes []models.Quote
+	err := applyFilters(r.db.WithContext(ctx).Model(&models.Quote{})).
+		Preload("Customer").
+		Order("issue_date DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&quotes).Error
+	if err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to get quotes: %w", err)
+	}
+
+	result := make([]domain.Quote, 0, len(quotes))
+	for _, m := range quotes {
+		result = append(result, mapper.ToDomainQuote(m))
+	}
+
+	return result, domain.NewPagination(totalItems, page, limit), nil
+}
+
+// GetQuoteByID implements repository.QuoteRepository.
+func (r *quoteRepository) GetQuoteByID(ctx context.Context, id uint) (domain.Quote, error) {
+	var m models.Quote
+
+	err := r.db.WithContext(ctx).Preload("Customer").Preload("Items.Item").First(&m, id).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return domain.Quote{}, utils.ErrQuoteNotFound
+		}
+
+		return domain.Quote{}, fmt.Errorf("failed to get quote by ID: %w", err)
+	}
+
+	return mapper.ToDomainQuote(m), nil
+}
+
+// CreateQuote implements repository.QuoteRepository.
+func (r *quoteRepository) CreateQuote(ctx context.Context, quote *domain.Quote) error {
+	m := mapper.ToModelQuote(*quote)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		number, err := allocateDocumentNumber(tx, r.numbering, quote.IssueDate)
+		if err != nil {
+			return err
+		}
+		m.QuoteNumber = number
+
+		if err := tx.Omit("Items").Create(&m).Error; err != nil {
+			return fmt.Errorf("create quote failed: %w", err)
+		}
+
+		for idx := range m.Items {
+			m.Items[idx].QuoteID = m.ID
+		}
+
+		if len(m.Items) > 0 {
+			if err := tx.Create(&m.Items).Error; err != nil {
+				return fmt.Errorf("create quote items failed: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	quote.ID = m.ID
+	quote.QuoteNumber = m.QuoteNumber
+	return nil
+}
+
+// UpdateQuote implements repository.QuoteRepository.
+func (r *quoteRepository) UpdateQuote(ctx context.Context, id uint, quote domain.Quote) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.Quote
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, id).Error
+		if err != nil {
+			if utils.IsNotFound(err) {
+				return utils.ErrQuoteNotFound
+			}
+			return fmt.Errorf("failed to load quote: %w", err)
+		}
+
+		if existing.Status != domain.QuoteStatusDraft {
+			return utils.ErrQuoteLocked
+		}
+
+		if err := tx.Where("quote_id = ?", id).Delete(&models.QuoteItem{}).Error; err != nil {
+			return fmt.Errorf("failed to remove quote items: %w", err)
+		}
+
+		items := make([]models.QuoteItem, 0, len(quote.Items))
+		for _, it := range quote.Items {
+			item := mapper.ToModelQuoteItem(it)
+			item.ID = 0
+			item.QuoteID = id
+			items = append(items, item)
+		}
+
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return fmt.Errorf("failed to create quote items: %w", err)
+			}
+		}
+
+		return tx.Model(&existing).Updates(map[string]interface{}{
+			"customer_id":  quote.CustomerID,
+			"issue_date":   quote.IssueDate,
+			"expiry_date":  quote.ExpiryDate,
+			"subject":      quote.Subject,
+			"currency":     quote.Currency,
+			"subtotal":     quote.Subtotal,
+			"tax":          quote.Tax,
+			"total_amount": quote.TotalAmount,
+			"total_items":  quote.TotalItems,
+			"updated_at":   time.Now(),
+		}).Error
+	})
+}
+
+// UpdateQuoteStatus implements repository.QuoteRepository. Like invoices, the
+// current status is part of the WHERE clause.
+func (r *quoteRepository) UpdateQuoteStatus(ctx context.Context, id uint, from, to string) error {
+	result := r.db.WithContext(ctx).Model(&models.Quote{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update quote status: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return utils.ErrInvalidQuoteTransition
+	}
+
+	return nil
+}
+
+// markQuoteConverted records the invoice created from the quote and marks
+// the quote accepted, inside the transaction that creates the invoice.
+func markQuoteConverted(tx *gorm.DB, id, invoiceID uint) error {
+	result := tx.Model(&models.Quote{}).
+		Where("id = ? AND invoice_id IS NULL", id).
+		Updates(map[string]interface{}{
+			"invoice_id": invoiceID,
+			"status":     domain.QuoteStatusAccepted,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark quote converted: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return utils.ErrQuoteAlreadyConverted
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testQuoteNumbering = domain.NumberingScheme{
+	Series:  domain.SeriesQuote,
+	Pattern: "QUO/{YYYY}/{seq:5}",
+	Reset:   domain.SequenceResetYearly,
+}
+
+func TestQuoteRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.Quote{}, &models.QuoteItem{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewQuoteRepository(db, testQuoteNumbering)
+
+	customer := models.Customer{Name: "Jane Doe"}
+	db.Create(&customer)
+
+	issueDate := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+	quote := domain.Quote{
+		CustomerID:  customer.ID,
+		IssueDate:   issueDate,
+		ExpiryDate:  issueDate.AddDate(0, 0, 30),
+		Currency:    "IDR",
+		Status:      domain.QuoteStatusDraft,
+		TotalAmount: domain.NewMoney(100),
+		Items: []domain.QuoteItem{
+			{ItemID: 1, Quantity: 2, Price: domain.NewMoney(50), TotalPrice: domain.NewMoney(100)},
+		},
+	}
+	assert.NoError(t, repo.CreateQuote(context.Background(), &quote))
+	assert.NotZero(t, quote.ID)
+	assert.Equal(t, "QUO/2025/00001", quote.QuoteNumber)
+
+	// drafts can be edited
+	quote.Items = append(quote.Items, domain.QuoteItem{ItemID: 2, Quantity: 1, Price: domain.NewMoney(20), TotalPrice: domain.NewMoney(20)})
+	quote.TotalAmount = domain.NewMoney(120)
+	assert.NoError(t, repo.UpdateQuote(context.Background(), quote.ID, quote))
+
+	stored, err := repo.GetQuoteByID(context.Background(), quote.ID)
+	assert.NoError(t, err)
+	assert.Len(t, stored.Items, 2)
+	assert.Equal(t, domain.NewMoney(120), stored.TotalAmount)
+
+	// the status only moves from the expected one
+	assert.NoError(t, repo.UpdateQuoteStatus(context.Background(), quote.ID, domain.QuoteStatusDraft, domain.QuoteStatusSent))
+	assert.Equal(t, utils.ErrInvalidQuoteTransition, repo.UpdateQuoteStatus(context.Background(), quote.ID, domain.QuoteStatusDraft, domain.QuoteStatusSent))
+	assert.Equal(t, utils.ErrQuoteLocked, repo.UpdateQuote(context.Background(), quote.ID, quote))
+
+	// converting the quote links it to its invoice
+	invoice := domain.Invoice{
+		CustomerID: customer.ID,
+		IssueDate:  issueDate,
+		DueDate:    issueDate.AddDate(0, 0, 30),
+		Status:     domain.InvoiceStatusDraft,
+		QuoteID:    &quote.ID,
+	}
+	assert.NoError(t, repository.NewInvoiceRepository(db, testInvoiceNumbering).CreateInvoice(context.Background(), &invoice))
+
+	stored, err = repo.GetQuoteByID(context.Background(), quote.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.QuoteStatusAccepted, stored.Status)
+	if assert.NotNil(t, stored.InvoiceID) {
+		assert.Equal(t, invoice.ID, *stored.InvoiceID)
+	}
+
+	_, err = repo.GetQuoteByID(context.Background(), 999)
+	assert.Equal(t, utils.ErrQuoteNotFound, err)
+}
+
+func TestCreateInvoice_RejectsSecondConversion(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.Quote{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	r := repository.NewInvoiceRepository(db, testInvoiceNumbering)
+
+	quote := models.Quote{QuoteNumber: "QUO/2025/00003", CustomerID: 1, Status: domain.QuoteStatusSent}
+	db.Create(&quote)
+	linked := uint(99)
+	converted := models.Quote{QuoteNumber: "QUO/2025/00004", CustomerID: 1, Status: domain.QuoteStatusAccepted, InvoiceID: &linked}
+	db.Create(&converted)
+
+	newInvoice := func(quoteID uint) domain.Invoice {
+		return domain.Invoice{
+			CustomerID: 1,
+			IssueDate:  time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+			DueDate:    time.Date(2025, 12, 3, 0, 0, 0, 0, time.UTC),
+			Status:     domain.InvoiceStatusDraft,
+			QuoteID:    &quoteID,
+		}
+	}
+
+	first := newInvoice(quote.ID)
+	assert.NoError(t, r.CreateInvoice(context.Background(), &first))
+
+	second := newInvoice(quote.ID)
+	assert.Equal(t, utils.ErrQuoteAlreadyConverted, r.CreateInvoice(context.Background(), &second))
+
+	// the invoice isn't kept when the quote was converted by other means
+	third := newInvoice(converted.ID)
+	assert.Equal(t, utils.ErrQuoteAlreadyConverted, r.CreateInvoice(context.Background(), &third))
+
+	var count int64
+	db.Model(&TestInvoice{}).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type recurringInvoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewRecurringInvoiceRepository(db *gorm.DB) repository.RecurringInvoiceRepository {
+	return &recurringInvoiceRepository{db: db}
+}
+
+// GetAllRecurringInvoices implements repository.RecurringInvoiceRepository.
+func (r *recurringInvoiceRepository) GetAllRecurringInvoices(ctx context.Context, filter domain.RecurringInvoiceFilter) ([]domain.RecurringInvoice, domain.Pagination, error) {
+	applyFilters := func(db *gorm.DB) *gorm.DB {
+		if filter.CustomerID != nil {
+			db = db.Where("customer_id = ?", *filter.CustomerID)
+		}
+
+		if filter.Status != "" {
+			db = db.Where("status = ?", filter.Status)
+		}
+
+		return db
+	}
+
+	page, limit, offset := domain.NormalizePage(filter.Page, filter.Limit)
+
+	var totalItems int64
+	if err := applyFilters(r.db.WithContext(ctx).Model(&models.RecurringInvoice{})).Count(&totalItems).Error; err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to count recurring invoices: %w", err)
+	}
+
+	var recurring []models.RecurringInvoice
+	err := applyFilters(r.db.WithContext(ctx).Model(&models.RecurringInvoice{})).
+		Preload("Customer").
+		Order("next_run_date ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&recurring).Error
+	if err != nil {
+		return nil, domain.Pagination{}, fmt.Errorf("failed to get recurring invoices: %w", err)
+	}
+
+	result := make([]domain.RecurringInvoice, 0, len(recurring))
+	for _, m := range recurring {
+		result = append(result, mapper.ToDomainRecurringInvoice(m))
+	}
+
+	return result, domain.NewPagination(totalItems, page, limit), nil
+}
+
+// GetRecurringInvoiceByID implements repository.RecurringInvoiceRepository.
+func (r *recurringInvoiceRepository) GetRecurringInvoiceByID(ctx context.Context, id uint) (domain.RecurringInvoice, error) {
+	var m models.RecurringInvoice
+
+	err := r.db.WithContext(ctx).Preload("Customer").Preload("Items.Item").First(&m, id).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return domain.RecurringInvoice{}, utils.ErrRecurringInvoiceNotFound
+		}
+
+		return domain.RecurringInvoice{}, fmt.Errorf("failed to get recurring invoice by ID: %w", err)
+	}
+
+	return mapper.ToDomainRecurringInvoice(m), nil
+}
+
+// CreateRecurringInvoice implements repository.RecurringInvoiceRepository.
+func (r *recurringInvoiceRepository) CreateRecurringInvoice(ctx context.Context, recurring *domain.RecurringInvoice) error {
+	m := mapper.ToModelRecurringInvoice(*recurring)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Items").Create(&m).Error; err != nil {
+			return fmt.Errorf("create recurring invoice failed: %w", err)
+		}
+
+		for idx := range m.Items {
+			m.Items[idx].RecurringInvoiceID = m.ID
+		}
+
+		if len(m.Items) > 0 {
+			if err := tx.Create(&m.Items).Error; err != nil {
+				return fmt.Errorf("create recurring invoice items failed: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	recurring.ID = m.ID
+	return nil
+}
+
+// UpdateRecurringInvoice implements repository.RecurringInvoiceRepository.
+func (r *recurringInvoiceRepository) UpdateRecurringInvoice(ctx context.Context, id uint, recurring domain.RecurringInvoice) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.RecurringInvoice
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, id).Error
+		if err != nil {
+			if utils.IsNotFound(err) {
+				return utils.ErrRecurringInvoiceNotFound
+			}
+			return fmt.Errorf("failed to load recurring invoice: %w", err)
+		}
+
+		if existing.Status == domain.RecurringStatusCompleted {
+			return utils.ErrRecurringInvoiceCompleted
+		}
+
+		if err := tx.Where("recurring_invoice_id = ?", id).Delete(&models.RecurringInvoiceItem{}).Error; err != nil {
+			return fmt.Errorf("failed to remove recurring invoice items: %w", err)
+		}
+
+		items := make([]models.RecurringInvoiceItem, 0, len(recurring.Items))
+		for _, it := range recurring.Items {
+			item := mapper.ToModelRecurringInvoiceItem(it)
+			item.ID = 0
+			item.RecurringInvoiceID = id
+			items = append(items, item)
+		}
+
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return fmt.Errorf("failed to create recurring invoice items: %w", err)
+			}
+		}
+
+		return tx.Model(&existing).Updates(map[string]interface{}{
+			"customer_id":       recurring.CustomerID,
+			"subject":           recurring.Subject,
+			"currency":          recurring.Currency,
+			"end_date":          recurring.EndDate,
+			"max_occurrences":   recurring.MaxOccurrences,
+			"payment_term_days": recurring.PaymentTermDays,
+			"invoice_status":    recurring.InvoiceStatus,
+			"updated_at":        time.Now(),
+		}).Error
+	})
+}
+
+// DeleteRecurringInvoice implements repository.RecurringInvoiceRepository.
+// Invoices already generated are kept.
+func (r *recurringInvoiceRepository) DeleteRecurringInvoice(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.RecurringInvoice{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete recurring invoice: %w", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return utils.ErrRecurringInvoiceNotFound
+		}
+
+		if err := tx.Where("recurring_invoice_id = ?", id).Delete(&models.RecurringInvoiceItem{}).Error; err != nil {
+			return fmt.Errorf("failed to delete recurring invoice items: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetDueRecurringInvoices implements repository.RecurringInvoiceRepository.
+func (r *recurringInvoiceRepository) GetDueRecurringInvoices(ctx context.Context, date time.Time) ([]domain.RecurringInvoice, error) {
+	var recurring []models.RecurringInvoice
+
+	err := r.db.WithContext(ctx).Preload("Items").
+		Where("status = ? AND next_run_date <= ?", domain.RecurringStatusActive, date).
+		Order("next_run_date ASC, id ASC").
+		Find(&recurring).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due recurring invoices: %w", err)
+	}
+
+	result := make([]domain.RecurringInvoice, 0, len(recurring))
+	for _, m := range recurring {
+		result = append(result, mapper.ToDomainRecurringInvoice(m))
+	}
+
+	return result, nil
+}
+
+// UpdateRecurringSchedule implements repository.RecurringInvoiceRepository.
+// Like invoice status updates, the expected occurrence count is part of the
+// WHERE clause so two schedulers can't both advance the same run.
+func (r *recurringInvoiceRepository) UpdateRecurringSchedule(ctx context.Context, recurring domain.RecurringInvoice, fromOccurrences int) error {
+	result := r.db.WithContext(ctx).Model(&models.RecurringInvoice{}).
+		Where("id = ? AND occurrences = ?", recurring.ID, fromOccurrences).
+		Updates(map[string]interface{}{
+			"next_run_date": recurring.NextRunDate,
+			"last_run_date": recurring.LastRunDate,
+			"occurrences":   recurring.Occurrences,
+			"status":        recurring.Status,
+			"updated_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update recurring schedule: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return utils.ErrRecurringRunConflict
+	}
+
+	return nil
+}
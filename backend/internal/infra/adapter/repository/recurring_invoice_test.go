@@ -0,0 +1,130 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurringInvoiceRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.RecurringInvoice{}, &models.RecurringInvoiceItem{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewRecurringInvoiceRepository(db)
+
+	customer := models.Customer{Name: "Jane Doe"}
+	db.Create(&customer)
+	item := models.Item{Name: "Website Maintenance"}
+	db.Create(&item)
+
+	start := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	price := domain.NewMoney(500)
+	recurring := domain.RecurringInvoice{
+		CustomerID:      customer.ID,
+		Subject:         "Maintenance retainer",
+		Currency:        "IDR",
+		Interval:        domain.RecurringIntervalMonthly,
+		StartDate:       start,
+		NextRunDate:     start,
+		PaymentTermDays: 14,
+		InvoiceStatus:   domain.InvoiceStatusIssued,
+		Status:          domain.RecurringStatusActive,
+		Items:           []domain.RecurringInvoiceItem{{ItemID: item.ID, Quantity: 1, Price: &price}},
+	}
+	assert.NoError(t, repo.CreateRecurringInvoice(context.Background(), &recurring))
+	assert.NotZero(t, recurring.ID)
+
+	stored, err := repo.GetRecurringInvoiceByID(context.Background(), recurring.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.RecurringIntervalMonthly, stored.Interval)
+	if assert.Len(t, stored.Items, 1) {
+		assert.Equal(t, "Website Maintenance", stored.Items[0].ItemName)
+		assert.Equal(t, &price, stored.Items[0].Price)
+	}
+
+	// only active templates with a run due are picked up
+	due, err := repo.GetDueRecurringInvoices(context.Background(), start.AddDate(0, 0, -1))
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+
+	due, err = repo.GetDueRecurringInvoices(context.Background(), start)
+	assert.NoError(t, err)
+	if assert.Len(t, due, 1) {
+		assert.Len(t, due[0].Items, 1)
+	}
+
+	// the schedule only advances from the expected count
+	advanced := stored
+	advanced.Advance()
+	assert.NoError(t, repo.UpdateRecurringSchedule(context.Background(), advanced, 0))
+	assert.Equal(t, utils.ErrRecurringRunConflict, repo.UpdateRecurringSchedule(context.Background(), advanced, 0))
+
+	stored, err = repo.GetRecurringInvoiceByID(context.Background(), recurring.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stored.Occurrences)
+	assert.Equal(t, time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC), stored.NextRunDate.UTC())
+
+	// lines and settings can be replaced until the schedule completes
+	stored.Items = []domain.RecurringInvoiceItem{{ItemID: item.ID, Quantity: 2}, {ItemID: item.ID, Quantity: 1}}
+	stored.Subject = "Maintenance and hosting"
+	assert.NoError(t, repo.UpdateRecurringInvoice(context.Background(), recurring.ID, stored))
+
+	stored, err = repo.GetRecurringInvoiceByID(context.Background(), recurring.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Maintenance and hosting", stored.Subject)
+	assert.Len(t, stored.Items, 2)
+	assert.Equal(t, 1, stored.Occurrences, "updates leave the schedule alone")
+
+	stored.Status = domain.RecurringStatusCompleted
+	assert.NoError(t, repo.UpdateRecurringSchedule(context.Background(), stored, 1))
+	assert.Equal(t, utils.ErrRecurringInvoiceCompleted, repo.UpdateRecurringInvoice(context.Background(), recurring.ID, stored))
+
+	due, err = repo.GetDueRecurringInvoices(context.Background(), start.AddDate(1, 0, 0))
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+
+	assert.NoError(t, repo.DeleteRecurringInvoice(context.Background(), recurring.ID))
+	_, err = repo.GetRecurringInvoiceByID(context.Background(), recurring.ID)
+	assert.Equal(t, utils.ErrRecurringInvoiceNotFound, err)
+	assert.Equal(t, utils.ErrRecurringInvoiceNotFound, repo.DeleteRecurringInvoice(context.Background(), recurring.ID))
+}
+
+func TestCreateInvoice_RejectsRepeatedRecurringRun(t *testing.T) {
+	db := setupTestDB(t)
+	r := repository.NewInvoiceRepository(db, testInvoiceNumbering)
+
+	recurringID := uint(4)
+	newInvoice := func(run int) domain.Invoice {
+		return domain.Invoice{
+			CustomerID:   1,
+			IssueDate:    time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+			DueDate:      time.Date(2025, 12, 3, 0, 0, 0, 0, time.UTC),
+			Status:       domain.InvoiceStatusIssued,
+			RecurringID:  &recurringID,
+			RecurringRun: run,
+		}
+	}
+
+	first := newInvoice(1)
+	assert.NoError(t, r.CreateInvoice(context.Background(), &first))
+
+	second := newInvoice(2)
+	assert.NoError(t, r.CreateInvoice(context.Background(), &second))
+
+	repeated := newInvoice(1)
+	assert.Equal(t, utils.ErrRecurringRunExists, r.CreateInvoice(context.Background(), &repeated))
+
+	// invoices outside any schedule don't collide with each other
+	for i := 0; i < 2; i++ {
+		plain := domain.Invoice{CustomerID: 1, IssueDate: first.IssueDate, DueDate: first.DueDate, Status: domain.InvoiceStatusDraft}
+		assert.NoError(t, r.CreateInvoice(context.Background(), &plain))
+	}
+}
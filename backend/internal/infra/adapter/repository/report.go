@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) repository.ReportRepository {
+	return &reportRepository{db: db}
+}
+
+// GetInvoiceTotalsByCurrencyDay implements repository.ReportRepository.
+func (r *reportRepository) GetInvoiceTotalsByCurrencyDay(ctx context.Context, filter domain.ReportFilter) ([]domain.CurrencyDayTotal, error) {
+	var rows []struct {
+		Currency     string
+		IssueDate    string
+		InvoiceCount int64
+		Subtotal     domain.Money
+		Tax          domain.Money
+		TotalAmount  domain.Money
+	}
+
+	db := r.db.WithContext(ctx).Model(&models.Invoice{}).
+		Select("currency, DATE(issue_date) AS issue_date, COUNT(*) AS invoice_count, " +
+			"SUM(subtotal) AS subtotal, SUM(tax) AS tax, SUM(total_amount) AS total_amount")
+	db = applyReportFilter(db, filter)
+
+	err := db.Group("currency, DATE(issue_date)").
+		Order("issue_date ASC, currency ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate invoice totals: %w", err)
+	}
+
+	result := make([]domain.CurrencyDayTotal, 0, len(rows))
+	for _, row := range rows {
+		issueDate, err := parseSQLDate(row.IssueDate)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, domain.CurrencyDayTotal{
+			Currency:     row.Currency,
+			IssueDate:    issueDate,
+			InvoiceCount: row.InvoiceCount,
+			Subtotal:     row.Subtotal,
+			Tax:          row.Tax,
+			TotalAmount:  row.TotalAmount,
+		})
+	}
+
+	return result, nil
+}
+
+// GetAgingRows implements repository.ReportRepository. The balance of each
+// invoice is rebuilt as of the end of the as-of day from the payments and
+// issued credit notes dated up to then, so past days report what was owed
+// at the time rather than today's balance.
+func (r *reportRepository) GetAgingRows(ctx context.Context, filter domain.AgingFilter) ([]domain.AgingRow, error) {
+	end := truncateToNextDay(filter.AsOf)
+
+	paid := r.db.WithContext(ctx).Model(&models.Payment{}).
+		Select("COALESCE(SUM(payments.amount), 0)").
+		Where("payments.invoice_id = invoices.id AND payments.payment_date < ?", end)
+	credited := r.db.WithContext(ctx).Model(&models.CreditNote{}).
+		Select("COALESCE(SUM(credit_notes.total_amount), 0)").
+		Where("credit_notes.invoice_id = invoices.id AND credit_notes.status = ? AND credit_notes.issue_date < ?", domain.CreditNoteStatusIssued, end)
+
+	// paid invoices are included, they may have been owed on the as-of day
+	balances := r.db.WithContext(ctx).Model(&models.Invoice{}).
+		Select("invoices.customer_id, invoices.currency, invoices.due_date, "+
+			"invoices.total_amount - (?) - (?) AS balance", paid, credited).
+		Where("invoices.status IN ?", append(domain.OutstandingInvoiceStatuses(), domain.InvoiceStatusPaid)).
+		Where("invoices.issue_date < ?", end)
+	if filter.CustomerID != nil {
+		balances = balances.Where("invoices.customer_id = ?", *filter.CustomerID)
+	}
+
+	var rows []struct {
+		CustomerID   uint         `gorm:"column:customer_id"`
+		CustomerName string       `gorm:"column:customer_name"`
+		Currency     string       `gorm:"column:currency"`
+		Current      domain.Money `gorm:"column:not_due"`
+		Days1To30    domain.Money `gorm:"column:days_1_30"`
+		Days31To60   domain.Money `gorm:"column:days_31_60"`
+		Days61To90   domain.Money `gorm:"column:days_61_90"`
+		DaysOver90   domain.Money `gorm:"column:days_over_90"`
+	}
+
+	starts := filter.AgingBucketStarts()
+	err := r.db.WithContext(ctx).Table("(?) AS balances", balances).
+		Select("balances.customer_id, customers.name AS customer_name, balances.currency, "+
+			"SUM(CASE WHEN balances.due_date >= ? THEN balances.balance ELSE 0 END) AS not_due, "+
+			"SUM(CASE WHEN balances.due_date < ? AND balances.due_date >= ? THEN balances.balance ELSE 0 END) AS days_1_30, "+
+			"SUM(CASE WHEN balances.due_date < ? AND balances.due_date >= ? THEN balances.balance ELSE 0 END) AS days_31_60, "+
+			"SUM(CASE WHEN balances.due_date < ? AND balances.due_date >= ? THEN balances.balance ELSE 0 END) AS days_61_90, "+
+			"SUM(CASE WHEN balances.due_date < ? THEN balances.balance ELSE 0 END) AS days_over_90",
+			starts[0], starts[0], starts[1], starts[1], starts[2], starts[2], starts[3], starts[3]).
+		Joins("JOIN customers ON customers.id = balances.customer_id").
+		Where("balances.balance > 0").
+		Group("balances.customer_id, customers.name, balances.currency").
+		Order("customers.name ASC, balances.currency ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate aging balances: %w", err)
+	}
+
+	result := make([]domain.AgingRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, domain.AgingRow{
+			CustomerID:   row.CustomerID,
+			CustomerName: row.CustomerName,
+			Currency:     row.Currency,
+			Current:      row.Current,
+			Days1To30:    row.Days1To30,
+			Days31To60:   row.Days31To60,
+			Days61To90:   row.Days61To90,
+			DaysOver90:   row.DaysOver90,
+		})
+	}
+
+	return result, nil
+}
+
+// GetRevenueByPeriod implements repository.ReportRepository. The database
+// sums the invoices per currency and day, which both MySQL and SQLite can
+// group by, and the days are then rolled up into the requested periods.
+func (r *reportRepository) GetRevenueByPeriod(ctx context.Context, filter domain.ReportFilter, period string) ([]domain.RevenueTotal, error) {
+	var rows []struct {
+		Currency     string
+		IssueDate    string
+		InvoiceCount int64
+		Billed       domain.Money
+		Paid         domain.Money
+		Credited     domain.Money
+	}
+
+	db := r.db.WithContext(ctx).Model(&models.Invoice{}).
+		Select("invoices.currency, DATE(invoices.issue_date) AS issue_date, COUNT(*) AS invoice_count, " +
+			"SUM(invoices.total_amount) AS billed, SUM(invoices.amount_paid) AS paid, SUM(invoices.amount_credited) AS credited")
+	db = applyReportFilter(db, filter)
+
+	err := db.Group("invoices.currency, DATE(invoices.issue_date)").
+		Order("issue_date ASC, invoices.currency ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate revenue: %w", err)
+	}
+
+	type key struct {
+		start    time.Time
+		currency string
+	}
+	result := []domain.RevenueTotal{}
+	index := make(map[key]int)
+
+	for _, row := range rows {
+		issueDate, err := parseSQLDate(row.IssueDate)
+		if err != nil {
+			return nil, err
+		}
+
+		k := key{domain.ReportPeriodStart(period, issueDate), row.Currency}
+		idx, ok := index[k]
+		if !ok {
+			idx = len(result)
+			index[k] = idx
+			result = append(result, domain.RevenueTotal{PeriodStart: k.start, Currency: k.currency})
+		}
+
+		total := &result[idx]
+		total.InvoiceCount += row.InvoiceCount
+		total.Billed = total.Billed.Add(row.Billed)
+		total.Paid = total.Paid.Add(row.Paid)
+		total.Credited = total.Credited.Add(row.Credited)
+	}
+
+	return result, nil
+}
+
+// GetTaxByPeriod implements repository.ReportRepository. Like
+// GetRevenueByPeriod it groups by day in the database and rolls the days up
+// into periods.
+func (r *reportRepository) GetTaxByPeriod(ctx context.Context, filter domain.ReportFilter, period string) ([]domain.TaxTotal, error) {
+	var rows []struct {
+		Currency  string
+		IssueDate string
+		TaxCode   string
+		TaxRate   float64
+		Taxable   domain.Money
+		Tax       domain.Money
+	}
+
+	db := r.db.WithContext(ctx).Model(&models.InvoiceItem{}).
+		Select("invoices.currency, DATE(invoices.issue_date) AS issue_date, invoice_items.tax_code, invoice_items.tax_rate, " +
+			"SUM(invoice_items.total_price) AS taxable, SUM(invoice_items.tax_amount) AS tax").
+		Joins("JOIN invoices ON invoices.id = invoice_items.invoice_id AND invoices.deleted_at IS NULL")
+	db = applyReportFilter(db, filter)
+
+	err := db.Group("invoices.currency, DATE(invoices.issue_date), invoice_items.tax_code, invoice_items.tax_rate").
+		Order("issue_date ASC, invoices.currency ASC, invoice_items.tax_code ASC, invoice_items.tax_rate ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate tax: %w", err)
+	}
+
+	type key struct {
+		start    time.Time
+		currency string
+		code     string
+		rate     float64
+	}
+	result := []domain.TaxTotal{}
+	index := make(map[key]int)
+
+	for _, row := range rows {
+		issueDate, err := parseSQLDate(row.IssueDate)
+		if err != nil {
+			return nil, err
+		}
+
+		k := key{domain.ReportPeriodStart(period, issueDate), row.Currency, row.TaxCode, row.TaxRate}
+		idx, ok := index[k]
+		if !ok {
+			idx = len(result)
+			index[k] = idx
+			result = append(result, domain.TaxTotal{PeriodStart: k.start, Currency: k.currency, TaxCode: k.code, TaxRate: k.rate})
+		}
+
+		total := &result[idx]
+		total.Taxable = total.Taxable.Add(row.Taxable)
+		total.Tax = total.Tax.Add(row.Tax)
+	}
+
+	return result, nil
+}
+
+// GetTopCustomers implements repository.ReportRepository.
+func (r *reportRepository) GetTopCustomers(ctx context.Context, filter domain.ReportFilter, limit int) ([]domain.CustomerSales, error) {
+	var rows []domain.CustomerSales
+
+	db := r.db.WithContext(ctx).Model(&models.Invoice{}).
+		Select("invoices.customer_id, customers.name AS customer_name, invoices.currency, " +
+			"COUNT(*) AS invoice_count, SUM(invoices.total_amount) AS billed").
+		Joins("JOIN customers ON customers.id = invoices.customer_id")
+	db = applyReportFilter(db, filter)
+
+	err := db.Group("invoices.customer_id, customers.name, invoices.currency").
+		Order("billed DESC, invoices.customer_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank customers: %w", err)
+	}
+
+	return rows, nil
+}
+
+// GetTopItems implements repository.ReportRepository.
+func (r *reportRepository) GetTopItems(ctx context.Context, filter domain.ReportFilter, orderBy string, limit int) ([]domain.ItemSales, error) {
+	order, ok := map[string]string{
+		domain.ItemSalesByRevenue:  "revenue DESC, quantity DESC",
+		domain.ItemSalesByQuantity: "quantity DESC, revenue DESC",
+	}[orderBy]
+	if !ok {
+		return nil, utils.ErrInvalidSortField
+	}
+
+	var rows []domain.ItemSales
+
+	db := r.db.WithContext(ctx).Model(&models.InvoiceItem{}).
+		Select("invoice_items.item_id, items.name AS item_name, invoices.currency, " +
+			"SUM(invoice_items.quantity) AS quantity, SUM(invoice_items.total_price) AS revenue").
+		Joins("JOIN invoices ON invoices.id = invoice_items.invoice_id AND invoices.deleted_at IS NULL").
+		Joins("JOIN items ON items.id = invoice_items.item_id")
+	db = applyReportFilter(db, filter)
+
+	err := db.Group("invoice_items.item_id, items.name, invoices.currency").
+		Order(order + ", invoice_items.item_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank items: %w", err)
+	}
+
+	return rows, nil
+}
+
+// truncateToNextDay returns the start of the day after t.
+func truncateToNextDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+}
+
+// applyReportFilter limits a report to the billed invoices, leaving out
+// drafts and voided or cancelled ones, within the filter's period and
+// currency.
+func applyReportFilter(db *gorm.DB, filter domain.ReportFilter) *gorm.DB {
+	db = db.Where("invoices.status IN ?", domain.BilledInvoiceStatuses())
+
+	if filter.From != nil {
+		db = db.Where("DATE(invoices.issue_date) >= ?", filter.From.Format("2006-01-02"))
+	}
+
+	if filter.To != nil {
+		db = db.Where("DATE(invoices.issue_date) <= ?", filter.To.Format("2006-01-02"))
+	}
+
+	if filter.Currency != "" {
+		db = db.Where("invoices.currency = ?", filter.Currency)
+	}
+
+	return db
+}
+
+// parseSQLDate reads the result of DATE(...), which MySQL returns as a date
+// value and SQLite as plain "YYYY-MM-DD" text.
+func parseSQLDate(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", time.RFC3339Nano, "2006-01-02 15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unexpected date value %q", value)
+}
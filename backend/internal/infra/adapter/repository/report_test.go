@@ -0,0 +1,213 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportRepository_GetAgingRows(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.Payment{}, &models.CreditNote{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewReportRepository(db)
+
+	alice := models.Customer{Name: "Alice", Email: "alice@example.com"}
+	bob := models.Customer{Name: "Bob", Email: "bob@example.com"}
+	carol := models.Customer{Name: "Carol", Email: "carol@example.com"}
+	db.Create(&alice)
+	db.Create(&bob)
+	db.Create(&carol)
+
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.Local) }
+	asOf := day(6, 30)
+
+	newInvoice := func(number string, customerID uint, currency, status string, issued, due time.Time, total int64) uint {
+		inv := TestInvoice{
+			InvoiceNumber: number,
+			CustomerID:    customerID,
+			Currency:      currency,
+			Status:        status,
+			IssueDate:     issued,
+			DueDate:       due,
+			TotalAmount:   domain.NewMoney(total),
+		}
+		db.Create(&inv)
+		return inv.ID
+	}
+	pay := func(invoiceID uint, date time.Time, amount int64) {
+		db.Create(&models.Payment{InvoiceID: invoiceID, Amount: domain.NewMoney(amount), PaymentDate: date, Method: "bank_transfer"})
+	}
+
+	newInvoice("INV-A1", alice.ID, "IDR", domain.InvoiceStatusIssued, day(6, 10), day(7, 10), 100)
+	a2 := newInvoice("INV-A2", alice.ID, "IDR", domain.InvoiceStatusPartiallyPaid, day(5, 15), day(6, 15), 200)
+	pay(a2, day(6, 1), 50)
+	// paid after the as-of day, so still owed then
+	a3 := newInvoice("INV-A3", alice.ID, "IDR", domain.InvoiceStatusPaid, day(4, 1), day(5, 1), 300)
+	pay(a3, day(7, 5), 300)
+	a4 := newInvoice("INV-A4", alice.ID, "IDR", domain.InvoiceStatusOverdue, day(2, 1), day(3, 1), 400)
+	db.Create(&models.CreditNote{CreditNoteNumber: "CN-1", InvoiceID: a4, CustomerID: alice.ID, IssueDate: day(6, 10), TotalAmount: domain.NewMoney(100), Status: domain.CreditNoteStatusIssued})
+	db.Create(&models.CreditNote{CreditNoteNumber: "CN-2", InvoiceID: a4, CustomerID: alice.ID, IssueDate: day(6, 11), TotalAmount: domain.NewMoney(50), Status: domain.CreditNoteStatusDraft})
+	newInvoice("INV-A5", alice.ID, "IDR", domain.InvoiceStatusDraft, day(1, 1), day(1, 1), 999)
+	newInvoice("INV-A6", alice.ID, "IDR", domain.InvoiceStatusIssued, day(7, 2), day(6, 1), 999)
+	newInvoice("INV-B1", bob.ID, "USD", domain.InvoiceStatusIssued, day(3, 15), day(4, 15), 80)
+	c1 := newInvoice("INV-C1", carol.ID, "IDR", domain.InvoiceStatusPaid, day(5, 1), day(5, 31), 70)
+	pay(c1, day(6, 20), 70)
+
+	rows, err := repo.GetAgingRows(context.Background(), domain.AgingFilter{AsOf: asOf.Add(10 * time.Hour)})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AgingRow{
+		{
+			CustomerID:   alice.ID,
+			CustomerName: "Alice",
+			Currency:     "IDR",
+			Current:      domain.NewMoney(100),
+			Days1To30:    domain.NewMoney(150),
+			Days31To60:   domain.NewMoney(300),
+			DaysOver90:   domain.NewMoney(300),
+		},
+		{CustomerID: bob.ID, CustomerName: "Bob", Currency: "USD", Days61To90: domain.NewMoney(80)},
+	}, rows)
+
+	// a week later the late payment has come in and the invoice issued in
+	// July is owed
+	rows, err = repo.GetAgingRows(context.Background(), domain.AgingFilter{AsOf: day(7, 6)})
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, domain.NewMoney(999), rows[0].Days31To60)
+	}
+
+	rows, err = repo.GetAgingRows(context.Background(), domain.AgingFilter{AsOf: asOf, CustomerID: &bob.ID})
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, "Bob", rows[0].CustomerName)
+	}
+}
+
+func TestReportRepository_SalesAggregates(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewReportRepository(db)
+
+	alice := models.Customer{Name: "Alice", Email: "alice@example.com"}
+	bob := models.Customer{Name: "Bob", Email: "bob@example.com"}
+	db.Create(&alice)
+	db.Create(&bob)
+	consulting := models.Item{Name: "Consulting"}
+	hosting := models.Item{Name: "Hosting"}
+	db.Create(&consulting)
+	db.Create(&hosting)
+
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.Local) }
+	type line struct {
+		itemID     uint
+		qty        int
+		total, tax int64
+		taxCode    string
+		taxRate    float64
+	}
+	newInvoice := func(number string, customerID uint, currency, status string, issued time.Time, total, paid, credited int64, lines ...line) {
+		inv := TestInvoice{
+			InvoiceNumber:  number,
+			CustomerID:     customerID,
+			Currency:       currency,
+			Status:         status,
+			IssueDate:      issued,
+			DueDate:        issued.AddDate(0, 0, 30),
+			TotalAmount:    domain.NewMoney(total),
+			AmountPaid:     domain.NewMoney(paid),
+			AmountCredited: domain.NewMoney(credited),
+		}
+		db.Create(&inv)
+		for _, l := range lines {
+			db.Create(&models.InvoiceItem{
+				InvoiceID:  inv.ID,
+				ItemID:     l.itemID,
+				Quantity:   l.qty,
+				TotalPrice: domain.NewMoney(l.total),
+				TaxCode:    l.taxCode,
+				TaxRate:    l.taxRate,
+				TaxAmount:  domain.NewMoney(l.tax),
+			})
+		}
+	}
+
+	newInvoice("INV-1", alice.ID, "IDR", domain.InvoiceStatusIssued, day(6, 2), 1100, 0, 0, line{consulting.ID, 2, 1000, 100, "VAT", 10})
+	newInvoice("INV-2", alice.ID, "IDR", domain.InvoiceStatusPaid, day(6, 8), 550, 550, 0, line{hosting.ID, 5, 500, 50, "VAT", 10})
+	newInvoice("INV-3", bob.ID, "IDR", domain.InvoiceStatusPartiallyPaid, day(6, 9), 2200, 1000, 200, line{consulting.ID, 4, 2000, 200, "VAT", 10})
+	newInvoice("INV-4", bob.ID, "USD", domain.InvoiceStatusIssued, day(6, 10), 10, 0, 0, line{hosting.ID, 1, 10, 0, "", 0})
+	newInvoice("INV-5", alice.ID, "IDR", domain.InvoiceStatusDraft, day(6, 3), 9999, 0, 0, line{consulting.ID, 50, 9999, 0, "", 0})
+	newInvoice("INV-6", alice.ID, "IDR", domain.InvoiceStatusVoid, day(7, 1), 500, 0, 0, line{hosting.ID, 9, 500, 0, "", 0})
+	newInvoice("INV-7", alice.ID, "IDR", domain.InvoiceStatusIssued, day(7, 15), 300, 0, 0, line{hosting.ID, 3, 300, 0, "", 0})
+
+	t.Run("revenue by week", func(t *testing.T) {
+		revenue, err := repo.GetRevenueByPeriod(context.Background(), domain.ReportFilter{Currency: "IDR"}, domain.ReportPeriodWeek)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.RevenueTotal{
+			{PeriodStart: day(6, 2), Currency: "IDR", InvoiceCount: 2, Billed: domain.NewMoney(1650), Paid: domain.NewMoney(550)},
+			{PeriodStart: day(6, 9), Currency: "IDR", InvoiceCount: 1, Billed: domain.NewMoney(2200), Paid: domain.NewMoney(1000), Credited: domain.NewMoney(200)},
+			{PeriodStart: day(7, 14), Currency: "IDR", InvoiceCount: 1, Billed: domain.NewMoney(300)},
+		}, revenue)
+	})
+
+	t.Run("revenue by month", func(t *testing.T) {
+		revenue, err := repo.GetRevenueByPeriod(context.Background(), domain.ReportFilter{}, domain.ReportPeriodMonth)
+		assert.NoError(t, err)
+		if assert.Len(t, revenue, 3) {
+			assert.Equal(t, domain.NewMoney(3850), revenue[0].Billed)
+			assert.Equal(t, "USD", revenue[1].Currency)
+			assert.Equal(t, day(7, 1), revenue[2].PeriodStart)
+		}
+	})
+
+	t.Run("tax by month", func(t *testing.T) {
+		taxes, err := repo.GetTaxByPeriod(context.Background(), domain.ReportFilter{}, domain.ReportPeriodMonth)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.TaxTotal{
+			{PeriodStart: day(6, 1), Currency: "IDR", TaxCode: "VAT", TaxRate: 10, Taxable: domain.NewMoney(3500), Tax: domain.NewMoney(350)},
+			{PeriodStart: day(6, 1), Currency: "USD", Taxable: domain.NewMoney(10)},
+			{PeriodStart: day(7, 1), Currency: "IDR", Taxable: domain.NewMoney(300)},
+		}, taxes)
+	})
+
+	t.Run("top customers", func(t *testing.T) {
+		customers, err := repo.GetTopCustomers(context.Background(), domain.ReportFilter{Currency: "IDR"}, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.CustomerSales{
+			{CustomerID: bob.ID, CustomerName: "Bob", Currency: "IDR", InvoiceCount: 1, Billed: domain.NewMoney(2200)},
+			{CustomerID: alice.ID, CustomerName: "Alice", Currency: "IDR", InvoiceCount: 3, Billed: domain.NewMoney(1950)},
+		}, customers)
+
+		from := day(7, 1)
+		customers, err = repo.GetTopCustomers(context.Background(), domain.ReportFilter{From: &from, Currency: "IDR"}, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, customers, 1) {
+			assert.Equal(t, domain.NewMoney(300), customers[0].Billed)
+		}
+	})
+
+	t.Run("top items", func(t *testing.T) {
+		items, err := repo.GetTopItems(context.Background(), domain.ReportFilter{Currency: "IDR"}, domain.ItemSalesByRevenue, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.ItemSales{
+			{ItemID: consulting.ID, ItemName: "Consulting", Currency: "IDR", Quantity: 6, Revenue: domain.NewMoney(3000)},
+			{ItemID: hosting.ID, ItemName: "Hosting", Currency: "IDR", Quantity: 8, Revenue: domain.NewMoney(800)},
+		}, items)
+
+		items, err = repo.GetTopItems(context.Background(), domain.ReportFilter{Currency: "IDR"}, domain.ItemSalesByQuantity, 1)
+		assert.NoError(t, err)
+		if assert.Len(t, items, 1) {
+			assert.Equal(t, "Hosting", items[0].ItemName)
+		}
+
+		_, err = repo.GetTopItems(context.Background(), domain.ReportFilter{}, "margin", 10)
+		assert.Equal(t, utils.ErrInvalidSortField, err)
+	})
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type statementRepository struct {
+	db *gorm.DB
+}
+
+func NewStatementRepository(db *gorm.DB) repository.StatementRepository {
+	return &statementRepository{db: db}
+}
+
+// GetStatement implements repository.StatementRepository.
+func (s *statementRepository) GetStatement(ctx context.Context, filter domain.StatementFilter) (domain.Statement, error) {
+	from, end := filter.From, truncateToNextDay(filter.To)
+
+	statement := domain.Statement{
+		Currency: filter.Currency,
+		From:     filter.From,
+		To:       filter.To,
+		Entries:  []domain.StatementEntry{},
+	}
+
+	opening, err := s.balanceBefore(ctx, filter, from)
+	if err != nil {
+		return domain.Statement{}, err
+	}
+	statement.OpeningBalance = opening
+
+	var invoices []models.Invoice
+	err = billedInvoicesOf(s.db.WithContext(ctx).Model(&models.Invoice{}), filter).
+		Where("invoices.issue_date >= ? AND invoices.issue_date < ?", from, end).
+		Find(&invoices).Error
+	if err != nil {
+		return domain.Statement{}, fmt.Errorf("failed to load statement invoices: %w", err)
+	}
+	for _, inv := range invoices {
+		statement.Entries = append(statement.Entries, domain.StatementEntry{
+			Type:          domain.StatementEntryInvoice,
+			ID:            inv.ID,
+			Date:          inv.IssueDate,
+			Reference:     inv.InvoiceNumber,
+			Description:   inv.Subject,
+			InvoiceID:     inv.ID,
+			InvoiceNumber: inv.InvoiceNumber,
+			Debit:         inv.TotalAmount,
+		})
+	}
+
+	var payments []struct {
+		models.Payment
+		InvoiceNumber string
+	}
+	err = billedInvoicesOf(s.db.WithContext(ctx).Model(&models.Payment{}), filter).
+		Select("payments.*, invoices.invoice_number").
+		Joins("JOIN invoices ON invoices.id = payments.invoice_id AND invoices.deleted_at IS NULL").
+		Where("payments.payment_date >= ? AND payments.payment_date < ?", from, end).
+		Scan(&payments).Error
+	if err != nil {
+		return domain.Statement{}, fmt.Errorf("failed to load statement payments: %w", err)
+	}
+	for _, p := range payments {
+		statement.Entries = append(statement.Entries, domain.StatementEntry{
+			Type:          domain.StatementEntryPayment,
+			ID:            p.ID,
+			Date:          p.PaymentDate,
+			Reference:     p.Reference,
+			Description:   "Payment by " + p.Method,
+			InvoiceID:     p.InvoiceID,
+			InvoiceNumber: p.InvoiceNumber,
+			Credit:        p.Amount,
+		})
+	}
+
+	var creditNotes []struct {
+		models.CreditNote
+		InvoiceNumber string
+	}
+	err = billedInvoicesOf(s.db.WithContext(ctx).Model(&models.CreditNote{}), filter).
+		Select("credit_notes.*, invoices.invoice_number").
+		Joins("JOIN invoices ON invoices.id = credit_notes.invoice_id AND invoices.deleted_at IS NULL").
+		Where("credit_notes.status = ?", domain.CreditNoteStatusIssued).
+		Where("credit_notes.issue_date >= ? AND credit_notes.issue_date < ?", from, end).
+		Scan(&creditNotes).Error
+	if err != nil {
+		return domain.Statement{}, fmt.Errorf("failed to load statement credit notes: %w", err)
+	}
+	for _, cn := range creditNotes {
+		description := cn.Reason
+		if description == "" {
+			description = "Credit note"
+		}
+
+		statement.Entries = append(statement.Entries, domain.StatementEntry{
+			Type:          domain.StatementEntryCreditNote,
+			ID:            cn.ID,
+			Date:          cn.IssueDate,
+			Reference:     cn.CreditNoteNumber,
+			Description:   description,
+			InvoiceID:     cn.InvoiceID,
+			InvoiceNumber: cn.InvoiceNumber,
+			Credit:        cn.TotalAmount,
+		})
+	}
+
+	statement.Settle()
+
+	return statement, nil
+}
+
+// billedInvoicesOf narrows db, which selects from or joins invoices, to the
+// billed invoices of the customer in the statement's currency.
+func billedInvoicesOf(db *gorm.DB, filter domain.StatementFilter) *gorm.DB {
+	return db.Where("invoices.customer_id = ? AND invoices.currency = ?", filter.CustomerID, filter.Currency).
+		Where("invoices.status IN ?", domain.BilledInvoiceStatuses())
+}
+
+// balanceBefore is what the customer owed right before the time before.
+func (s *statementRepository) balanceBefore(ctx context.Context, filter domain.StatementFilter, before time.Time) (domain.Money, error) {
+	var invoiced, paid, credited domain.Money
+
+	err := billedInvoicesOf(s.db.WithContext(ctx).Model(&models.Invoice{}), filter).
+		Select("COALESCE(SUM(invoices.total_amount), 0)").
+		Where("invoices.issue_date < ?", before).
+		Row().Scan(&invoiced)
+	if err != nil {
+		return domain.Money{}, fmt.Errorf("failed to sum statement invoices: %w", err)
+	}
+
+	err = billedInvoicesOf(s.db.WithContext(ctx).Model(&models.Payment{}), filter).
+		Select("COALESCE(SUM(payments.amount), 0)").
+		Joins("JOIN invoices ON invoices.id = payments.invoice_id AND invoices.deleted_at IS NULL").
+		Where("payments.payment_date < ?", before).
+		Row().Scan(&paid)
+	if err != nil {
+		return domain.Money{}, fmt.Errorf("failed to sum statement payments: %w", err)
+	}
+
+	err = billedInvoicesOf(s.db.WithContext(ctx).Model(&models.CreditNote{}), filter).
+		Select("COALESCE(SUM(credit_notes.total_amount), 0)").
+		Joins("JOIN invoices ON invoices.id = credit_notes.invoice_id AND invoices.deleted_at IS NULL").
+		Where("credit_notes.status = ? AND credit_notes.issue_date < ?", domain.CreditNoteStatusIssued, before).
+		Row().Scan(&credited)
+	if err != nil {
+		return domain.Money{}, fmt.Errorf("failed to sum statement credit notes: %w", err)
+	}
+
+	return invoiced.Sub(paid).Sub(credited), nil
+}
@@ -0,0 +1,99 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatementRepository_GetStatement(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.Payment{}, &models.CreditNote{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewStatementRepository(db)
+
+	alice := models.Customer{Name: "Alice", Email: "alice@example.com", Currency: "IDR"}
+	bob := models.Customer{Name: "Bob", Email: "bob@example.com", Currency: "IDR"}
+	db.Create(&alice)
+	db.Create(&bob)
+
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.Local) }
+	newInvoice := func(number string, customerID uint, currency, status string, issued time.Time, total int64) uint {
+		inv := TestInvoice{
+			InvoiceNumber: number,
+			CustomerID:    customerID,
+			Currency:      currency,
+			Status:        status,
+			Subject:       "Subject of " + number,
+			IssueDate:     issued,
+			DueDate:       issued.AddDate(0, 0, 30),
+			TotalAmount:   domain.NewMoney(total),
+		}
+		db.Create(&inv)
+		return inv.ID
+	}
+	pay := func(invoiceID uint, date time.Time, amount int64) {
+		db.Create(&models.Payment{InvoiceID: invoiceID, Amount: domain.NewMoney(amount), PaymentDate: date, Method: "bank_transfer", Reference: "TRX"})
+	}
+	credit := func(number string, invoiceID uint, status string, date time.Time, amount int64) {
+		db.Create(&models.CreditNote{CreditNoteNumber: number, InvoiceID: invoiceID, CustomerID: alice.ID, Currency: "IDR", IssueDate: date, TotalAmount: domain.NewMoney(amount), Status: status})
+	}
+
+	// before the statement: 100 invoiced, 40 paid and 10 credited
+	i1 := newInvoice("INV-1", alice.ID, "IDR", domain.InvoiceStatusPartiallyPaid, day(4, 20), 100)
+	pay(i1, day(4, 25), 40)
+	credit("CN-1", i1, domain.CreditNoteStatusIssued, day(4, 28), 10)
+
+	i2 := newInvoice("INV-2", alice.ID, "IDR", domain.InvoiceStatusPartiallyPaid, day(5, 3), 300)
+	pay(i2, day(5, 10).Add(14*time.Hour), 100)
+	pay(i2, day(6, 2), 50)
+	pay(i1, day(5, 5), 50)
+	credit("CN-2", i2, domain.CreditNoteStatusDraft, day(5, 6), 25)
+
+	// none of these are on the statement
+	void := newInvoice("INV-3", alice.ID, "IDR", domain.InvoiceStatusVoid, day(5, 4), 999)
+	pay(void, day(5, 4), 999)
+	newInvoice("INV-4", alice.ID, "USD", domain.InvoiceStatusIssued, day(5, 5), 20)
+	newInvoice("INV-5", bob.ID, "IDR", domain.InvoiceStatusIssued, day(5, 5), 70)
+	newInvoice("INV-6", alice.ID, "IDR", domain.InvoiceStatusDraft, day(5, 7), 80)
+
+	statement, err := repo.GetStatement(context.Background(), domain.StatementFilter{CustomerID: alice.ID, Currency: "IDR", From: day(5, 1), To: day(5, 31)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, domain.NewMoney(50), statement.OpeningBalance)
+	if assert.Len(t, statement.Entries, 3) {
+		invoice, early, late := statement.Entries[0], statement.Entries[1], statement.Entries[2]
+
+		assert.Equal(t, domain.StatementEntryInvoice, invoice.Type)
+		assert.Equal(t, "INV-2", invoice.Reference)
+		assert.Equal(t, "Subject of INV-2", invoice.Description)
+		assert.Equal(t, domain.NewMoney(350), invoice.Balance)
+
+		assert.Equal(t, domain.StatementEntryPayment, early.Type)
+		assert.Equal(t, "INV-1", early.InvoiceNumber)
+		assert.Equal(t, domain.NewMoney(300), early.Balance)
+
+		assert.Equal(t, "INV-2", late.InvoiceNumber)
+		assert.Equal(t, domain.NewMoney(100), late.Credit)
+		assert.Equal(t, domain.NewMoney(200), late.Balance)
+	}
+	assert.Equal(t, domain.NewMoney(200), statement.ClosingBalance())
+
+	// in April the same activity makes up the entries instead
+	statement, err = repo.GetStatement(context.Background(), domain.StatementFilter{CustomerID: alice.ID, Currency: "IDR", From: day(4, 1), To: day(4, 30)})
+	assert.NoError(t, err)
+	assert.True(t, statement.OpeningBalance.IsZero())
+	if assert.Len(t, statement.Entries, 3) {
+		assert.Equal(t, domain.StatementEntryCreditNote, statement.Entries[2].Type)
+		assert.Equal(t, "CN-1", statement.Entries[2].Reference)
+		assert.Equal(t, "INV-1", statement.Entries[2].InvoiceNumber)
+	}
+	assert.Equal(t, domain.NewMoney(50), statement.ClosingBalance())
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+type taxRateRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxRateRepository(db *gorm.DB) repository.TaxRateRepository {
+	return &taxRateRepository{db: db}
+}
+
+// GetAllTaxRates implements repository.TaxRateRepository.
+func (t *taxRateRepository) GetAllTaxRates(ctx context.Context) ([]domain.TaxRate, error) {
+	var rates []models.TaxRate
+
+	if err := t.db.WithContext(ctx).Order("code ASC").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.TaxRate, 0, len(rates))
+	for _, r := range rates {
+		result = append(result, mapper.ToDomainTaxRate(r))
+	}
+
+	return result, nil
+}
+
+// GetTaxRateByID implements repository.TaxRateRepository.
+func (t *taxRateRepository) GetTaxRateByID(ctx context.Context, id uint) (domain.TaxRate, error) {
+	var rate models.TaxRate
+
+	if err := t.db.WithContext(ctx).First(&rate, id).Error; err != nil {
+		if utils.IsNotFound(err) {
+			return domain.TaxRate{}, utils.ErrTaxRateNotFound
+		}
+
+		return domain.TaxRate{}, fmt.Errorf("failed to get tax rate by ID: %w", err)
+	}
+
+	return mapper.ToDomainTaxRate(rate), nil
+}
+
+// GetDefaultTaxRate implements repository.TaxRateRepository.
+func (t *taxRateRepository) GetDefaultTaxRate(ctx context.Context) (domain.TaxRate, error) {
+	var rate models.TaxRate
+
+	err := t.db.WithContext(ctx).Where("is_default = ? AND is_active = ?", true, true).First(&rate).Error
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return domain.TaxRate{}, utils.ErrDefaultTaxRateMissing
+		}
+
+		return domain.TaxRate{}, fmt.Errorf("failed to get default tax rate: %w", err)
+	}
+
+	return mapper.ToDomainTaxRate(rate), nil
+}
+
+// CreateTaxRate implements repository.TaxRateRepository.
+func (t *taxRateRepository) CreateTaxRate(ctx context.Context, rate *domain.TaxRate) error {
+	m := mapper.ToModelTaxRate(*rate)
+
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if m.IsDefault {
+			if err := clearDefaultTaxRate(tx, 0); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(&m).Error
+	})
+	if err != nil {
+		if utils.IsDuplicateKeyError(err) {
+			return utils.ErrTaxRateAlreadyExists
+		}
+
+		return err
+	}
+
+	rate.ID = m.ID
+
+	return nil
+}
+
+// UpdateTaxRate implements repository.TaxRateRepository.
+func (t *taxRateRepository) UpdateTaxRate(ctx context.Context, id uint, rate domain.TaxRate) error {
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.TaxRate
+		if err := tx.First(&existing, id).Error; err != nil {
+			if utils.IsNotFound(err) {
+				return utils.ErrTaxRateNotFound
+			}
+
+			return err
+		}
+
+		if rate.IsDefault {
+			if err := clearDefaultTaxRate(tx, id); err != nil {
+				return err
+			}
+		}
+
+		// map keeps zero values (rate 0, inactive) which a struct update would skip
+		return tx.Model(&existing).Updates(map[string]interface{}{
+			"code":       rate.Code,
+			"name":       rate.Name,
+			"type":       rate.Type,
+			"rate":       rate.Rate,
+			"is_default": rate.IsDefault,
+			"is_active":  rate.IsActive,
+		}).Error
+	})
+	if err != nil && utils.IsDuplicateKeyError(err) {
+		return utils.ErrTaxRateAlreadyExists
+	}
+
+	return err
+}
+
+// GetCustomerTaxRate implements repository.TaxRateRepository.
+func (t *taxRateRepository) GetCustomerTaxRate(ctx context.Context, customerID uint) (*domain.TaxRate, error) {
+	var rates []models.TaxRate
+
+	err := t.db.WithContext(ctx).Model(&models.TaxRate{}).
+		Joins("JOIN customers ON customers.tax_rate_id = tax_rates.id").
+		Where("customers.id = ? AND customers.deleted_at IS NULL AND tax_rates.is_active = ?", customerID, true).
+		Limit(1).
+		Find(&rates).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get customer tax rate: %w", err)
+	}
+
+	if len(rates) == 0 {
+		return nil, nil
+	}
+
+	rate := mapper.ToDomainTaxRate(rates[0])
+
+	return &rate, nil
+}
+
+// GetItemTaxRates implements repository.TaxRateRepository.
+func (t *taxRateRepository) GetItemTaxRates(ctx context.Context, itemIDs []uint) (map[uint]domain.TaxRate, error) {
+	result := make(map[uint]domain.TaxRate)
+	if len(itemIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		ItemID uint
+		models.TaxRate
+	}
+
+	err := t.db.WithContext(ctx).Model(&models.TaxRate{}).
+		Select("items.id AS item_id, tax_rates.*").
+		Joins("JOIN items ON items.tax_rate_id = tax_rates.id").
+		Where("items.id IN ? AND items.deleted_at IS NULL AND tax_rates.is_active = ?", itemIDs, true).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item tax rates: %w", err)
+	}
+
+	for _, row := range rows {
+		result[row.ItemID] = mapper.ToDomainTaxRate(row.TaxRate)
+	}
+
+	return result, nil
+}
+
+// clearDefaultTaxRate unsets the default flag on every rate except keepID, so
+// that at most one default rate exists at a time.
+func clearDefaultTaxRate(tx *gorm.DB, keepID uint) error {
+	return tx.Model(&models.TaxRate{}).
+		Where("is_default = ? AND id <> ?", true, keepID).
+		Update("is_default", false).Error
+}
@@ -0,0 +1,96 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	infradb "invoice-system/internal/infra/db"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTaxTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+		DisableAutomaticPing:                     true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	if err := db.Use(infradb.Tenancy{}); err != nil {
+		t.Fatalf("failed to register tenancy: %v", err)
+	}
+
+	if err := db.AutoMigrate(&models.TaxRate{}, &models.Customer{}, &models.Item{}, &models.AuditLog{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+
+	return db
+}
+
+func TestTaxRateRepository_DefaultRate(t *testing.T) {
+	db := setupTaxTestDB(t)
+	repo := repository.NewTaxRateRepository(db)
+
+	_, err := repo.GetDefaultTaxRate(context.Background())
+	assert.Equal(t, utils.ErrDefaultTaxRateMissing, err)
+
+	first := &domain.TaxRate{Code: "VAT10", Name: "PPN 10%", Type: domain.TaxTypeStandard, Rate: 10, IsDefault: true, IsActive: true}
+	assert.NoError(t, repo.CreateTaxRate(context.Background(), first))
+
+	second := &domain.TaxRate{Code: "VAT11", Name: "PPN 11%", Type: domain.TaxTypeStandard, Rate: 11, IsDefault: true, IsActive: true}
+	assert.NoError(t, repo.CreateTaxRate(context.Background(), second))
+
+	// only the most recent default survives
+	def, err := repo.GetDefaultTaxRate(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "VAT11", def.Code)
+
+	var defaults int64
+	db.Model(&models.TaxRate{}).Where("is_default = ?", true).Count(&defaults)
+	assert.Equal(t, int64(1), defaults)
+
+	duplicate := &domain.TaxRate{Code: "VAT10", Name: "Again", Type: domain.TaxTypeStandard, Rate: 10, IsActive: true}
+	assert.Equal(t, utils.ErrTaxRateAlreadyExists, repo.CreateTaxRate(context.Background(), duplicate))
+}
+
+func TestTaxRateRepository_Overrides(t *testing.T) {
+	db := setupTaxTestDB(t)
+	repo := repository.NewTaxRateRepository(db)
+
+	reduced := models.TaxRate{Code: "VAT5", Name: "Reduced", Type: domain.TaxTypeReduced, Rate: 5, IsActive: true}
+	exempt := models.TaxRate{Code: "EXEMPT", Name: "Exempt", Type: domain.TaxTypeExempt, IsActive: true}
+	db.Create(&reduced)
+	db.Create(&exempt)
+
+	customer := models.Customer{Name: "Exempt Foundation", Email: "foundation@example.com", TaxRateID: &exempt.ID}
+	plain := models.Customer{Name: "Regular Co", Email: "regular@example.com"}
+	db.Create(&customer)
+	db.Create(&plain)
+
+	food := models.Item{Name: "Catering", Type: "Goods", TaxRateID: &reduced.ID}
+	service := models.Item{Name: "Consulting", Type: "Service"}
+	db.Create(&food)
+	db.Create(&service)
+
+	rate, err := repo.GetCustomerTaxRate(context.Background(), customer.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, rate) {
+		assert.Equal(t, "EXEMPT", rate.Code)
+	}
+
+	rate, err = repo.GetCustomerTaxRate(context.Background(), plain.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, rate)
+
+	itemRates, err := repo.GetItemTaxRates(context.Background(), []uint{food.ID, service.ID})
+	assert.NoError(t, err)
+	assert.Len(t, itemRates, 1)
+	assert.Equal(t, "VAT5", itemRates[food.ID].Code)
+}
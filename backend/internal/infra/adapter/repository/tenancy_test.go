@@ -0,0 +1,190 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	tenantA = domain.ContextWithTenant(context.Background(), 1)
+	tenantB = domain.ContextWithTenant(context.Background(), 2)
+)
+
+func createTenantCustomer(t *testing.T, ctx context.Context, db *gorm.DB, email string) domain.Customer {
+	t.Helper()
+
+	customer := domain.Customer{Name: "Customer " + email, Email: email, Currency: "IDR"}
+	if err := repository.NewCustomerRepository(db).CreateCustomer(ctx, &customer); err != nil {
+		t.Fatalf("failed to create customer: %v", err)
+	}
+
+	var m models.Customer
+	if err := db.Where("email = ?", email).Order("id DESC").First(&m).Error; err != nil {
+		t.Fatalf("failed to load customer: %v", err)
+	}
+	customer.ID = m.ID
+	return customer
+}
+
+func TestTenancy_Customers(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewCustomerRepository(db)
+
+	// emails are only unique within an organization
+	a := createTenantCustomer(t, tenantA, db, "billing@example.com")
+	b := createTenantCustomer(t, tenantB, db, "billing@example.com")
+	assert.Equal(t, utils.ErrCustomerAlreadyExists, repo.CreateCustomer(tenantA, &domain.Customer{Name: "Again", Email: "billing@example.com"}))
+
+	var stored models.Customer
+	db.First(&stored, b.ID)
+	assert.Equal(t, uint(2), stored.TenantID)
+
+	customers, page, err := repo.FindCustomers(tenantA, domain.CustomerFilter{Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, customers, 1) {
+		assert.Equal(t, a.ID, customers[0].ID)
+	}
+	assert.Equal(t, int64(1), page.TotalItems)
+
+	_, err = repo.GetCustomerByID(tenantA, b.ID)
+	assert.Equal(t, utils.ErrCustomerNotFound, err)
+
+	b.Name = "Taken over"
+	assert.Equal(t, utils.ErrCustomerNotFound, repo.UpdateCustomer(tenantA, b))
+	assert.Equal(t, utils.ErrCustomerNotFound, repo.DeleteCustomer(tenantA, b.ID))
+
+	found, err := repo.GetCustomerByID(tenantB, b.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Customer billing@example.com", found.Name)
+}
+
+func TestTenancy_Invoices(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewInvoiceRepository(db, testInvoiceNumbering)
+	issueDate := time.Date(2025, 10, 1, 0, 0, 0, 0, time.Local)
+
+	create := func(ctx context.Context, customerID uint) domain.Invoice {
+		t.Helper()
+
+		invoice := domain.Invoice{
+			IssueDate:  issueDate,
+			DueDate:    issueDate.AddDate(0, 0, 14),
+			CustomerID: customerID,
+			Currency:   "IDR",
+			Status:     domain.InvoiceStatusIssued,
+		}
+		if err := repo.CreateInvoice(ctx, &invoice); err != nil {
+			t.Fatalf("failed to create invoice: %v", err)
+		}
+		return invoice
+	}
+
+	a := create(tenantA, createTenantCustomer(t, tenantA, db, "a@example.com").ID)
+	b := create(tenantB, createTenantCustomer(t, tenantB, db, "b@example.com").ID)
+
+	// every organization numbers its invoices on its own
+	assert.Equal(t, "INV/2025/10/00001", a.InvoiceNumber)
+	assert.Equal(t, "INV/2025/10/00001", b.InvoiceNumber)
+
+	invoices, _, err := repo.GetAllInvoices(tenantA, domain.InvoiceFilter{Limit: 10, Page: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, invoices, 1) {
+		assert.Equal(t, a.ID, invoices[0].ID)
+	}
+
+	_, err = repo.GetInvoiceByID(tenantA, b.ID)
+	assert.Equal(t, utils.ErrInvoiceNotFound, err)
+	assert.Equal(t, utils.ErrInvalidStatusTransition, repo.UpdateInvoiceStatus(tenantA, b.ID, domain.InvoiceStatusIssued, domain.InvoiceStatusVoid))
+	assert.Equal(t, utils.ErrInvoiceNotFound, repo.DeleteInvoice(tenantA, b.ID))
+
+	found, err := repo.GetInvoiceByID(tenantB, b.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.InvoiceStatusIssued, found.Status)
+
+	// the overdue job runs without an organization and reaches them all
+	flagged, err := repo.MarkOverdueInvoices(context.Background(), issueDate.AddDate(0, 1, 0), time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), flagged)
+
+	// and files each change with the organization of its invoice
+	audit := repository.NewAuditRepository(db)
+	for ctx, invoice := range map[context.Context]domain.Invoice{tenantA: a, tenantB: b} {
+		entries, _, err := audit.GetAuditEntries(ctx, domain.AuditFilter{EntityType: domain.AuditEntityInvoice, Limit: 10, Page: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 2) {
+			assert.Equal(t, invoice.ID, entries[0].EntityID)
+			assert.Equal(t, domain.InvoiceStatusOverdue, entries[0].Changes["status"].After)
+		}
+	}
+}
+
+func TestTenancy_Items(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewItemRepository(db)
+
+	for _, ctx := range []context.Context{tenantA, tenantB} {
+		if err := repo.AddItem(ctx, domain.Item{Name: "Consulting", SKU: "SKU-1"}); err != nil {
+			t.Fatalf("failed to add item: %v", err)
+		}
+	}
+
+	var b models.Item
+	db.Where("tenant_id = ?", 2).First(&b)
+
+	items, err := repo.GetAllItems(tenantA, "", 10)
+	assert.NoError(t, err)
+	if assert.Len(t, items, 1) {
+		assert.NotEqual(t, b.ID, items[0].ID)
+	}
+
+	_, err = repo.GetItemByID(tenantA, b.ID)
+	assert.Equal(t, utils.ErrItemNotFound, err)
+
+	byID, err := repo.GetItemsByIDs(tenantA, []uint{b.ID})
+	assert.NoError(t, err)
+	assert.Empty(t, byID)
+}
+
+func TestTenancy_Users(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.User{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewUserRepository(db)
+
+	for _, ctx := range []context.Context{tenantA, tenantB} {
+		user := &domain.User{Name: "Admin", Email: "admin@example.com", PasswordHash: "hash", Role: domain.RoleAdmin, IsActive: true}
+		if err := repo.CreateUser(ctx, user); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	a, err := repo.GetUserByEmail(tenantA, "admin@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), a.TenantID)
+	b, err := repo.GetUserByEmail(tenantB, "admin@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), b.TenantID)
+
+	users, err := repo.GetAllUsers(tenantA)
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	admins, err := repo.CountActiveAdmins(tenantA)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), admins)
+
+	// tokens are resolved to their user before the organization is known
+	found, err := repo.GetUserByID(context.Background(), b.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), found.TenantID)
+}
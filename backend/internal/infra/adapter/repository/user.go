@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"invoice-system/internal/applications/ports/repository"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/mapper"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type userRepository struct {
+	db *gorm.DB
+}
+
+func NewUserRepository(db *gorm.DB) repository.UserRepository {
+	return &userRepository{db: db}
+}
+
+// CreateUser implements repository.UserRepository.
+func (u *userRepository) CreateUser(ctx context.Context, user *domain.User) error {
+	m := mapper.ToModelUser(*user)
+
+	if err := u.db.WithContext(ctx).Create(&m).Error; err != nil {
+		if utils.IsDuplicateKeyError(err) {
+			return utils.ErrUserAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	user.ID = m.ID
+	user.CreatedAt = m.CreatedAt
+	user.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+// GetUserByID implements repository.UserRepository.
+func (u *userRepository) GetUserByID(ctx context.Context, id uint) (domain.User, error) {
+	return u.findUser(ctx, u.db.WithContext(ctx).Where("id = ?", id))
+}
+
+// GetUserByEmail implements repository.UserRepository. Emails are stored
+// normalized, so the lookup ignores case.
+func (u *userRepository) GetUserByEmail(ctx context.Context, email string) (domain.User, error) {
+	return u.findUser(ctx, u.db.WithContext(ctx).Where("email = ?", domain.NormalizeEmail(email)))
+}
+
+// CountUsers implements repository.UserRepository.
+func (u *userRepository) CountUsers(ctx context.Context) (int64, error) {
+	var count int64
+	if err := u.db.WithContext(ctx).Model(&models.User{}).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetAllUsers implements repository.UserRepository.
+func (u *userRepository) GetAllUsers(ctx context.Context) ([]domain.User, error) {
+	var ms []models.User
+	if err := u.db.WithContext(ctx).Order("name ASC, id ASC").Find(&ms).Error; err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	users := make([]domain.User, len(ms))
+	for i, m := range ms {
+		users[i] = mapper.ToDomainUser(m)
+	}
+	return users, nil
+}
+
+// UpdateUser implements repository.UserRepository. The fields are written
+// with a map so deactivating a user isn't skipped as a zero value.
+func (u *userRepository) UpdateUser(ctx context.Context, user domain.User) error {
+	result := u.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ?", user.ID).
+		Updates(map[string]interface{}{
+			"name":          user.Name,
+			"role":          user.Role,
+			"is_active":     user.IsActive,
+			"password_hash": user.PasswordHash,
+			"updated_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update user: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return utils.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// CountActiveAdmins implements repository.UserRepository.
+func (u *userRepository) CountActiveAdmins(ctx context.Context) (int64, error) {
+	var count int64
+	err := u.db.WithContext(ctx).Model(&models.User{}).
+		Where("role = ? AND is_active = ?", domain.RoleAdmin, true).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count admins: %w", err)
+	}
+
+	return count, nil
+}
+
+func (u *userRepository) findUser(ctx context.Context, db *gorm.DB) (domain.User, error) {
+	var m models.User
+	if err := db.First(&m).Error; err != nil {
+		if utils.IsNotFound(err) {
+			return domain.User{}, utils.ErrUserNotFound
+		}
+
+		return domain.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return mapper.ToDomainUser(m), nil
+}
@@ -0,0 +1,92 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"invoice-system/internal/domain"
+	repository "invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/db/models"
+	"invoice-system/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRepository(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.User{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewUserRepository(db)
+
+	count, err := repo.CountUsers(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+
+	user := &domain.User{Name: "Admin", Email: "admin@example.com", PasswordHash: "hash", IsActive: true}
+	if err := repo.CreateUser(context.Background(), user); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.NotZero(t, user.ID)
+
+	err = repo.CreateUser(context.Background(), &domain.User{Name: "Other", Email: "admin@example.com", PasswordHash: "hash"})
+	assert.Equal(t, utils.ErrUserAlreadyExists, err)
+
+	found, err := repo.GetUserByEmail(context.Background(), " Admin@Example.com ")
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, found.ID)
+	assert.Equal(t, "hash", found.PasswordHash)
+	assert.True(t, found.IsActive)
+
+	found, err = repo.GetUserByID(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin@example.com", found.Email)
+
+	_, err = repo.GetUserByEmail(context.Background(), "nobody@example.com")
+	assert.Equal(t, utils.ErrUserNotFound, err)
+	_, err = repo.GetUserByID(context.Background(), 999)
+	assert.Equal(t, utils.ErrUserNotFound, err)
+
+	count, err = repo.CountUsers(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestUserRepository_UpdateUser(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.User{}); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	repo := repository.NewUserRepository(db)
+
+	admin := &domain.User{Name: "Admin", Email: "admin@example.com", PasswordHash: "hash", Role: domain.RoleAdmin, IsActive: true}
+	clerk := &domain.User{Name: "Clerk", Email: "clerk@example.com", PasswordHash: "hash", Role: domain.RoleClerk, IsActive: true}
+	for _, u := range []*domain.User{admin, clerk} {
+		if err := repo.CreateUser(context.Background(), u); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	admins, err := repo.CountActiveAdmins(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), admins)
+
+	clerk.Role = domain.RoleAdmin
+	assert.NoError(t, repo.UpdateUser(context.Background(), *clerk))
+	admin.IsActive = false
+	assert.NoError(t, repo.UpdateUser(context.Background(), *admin))
+
+	stored, err := repo.GetUserByID(context.Background(), admin.ID)
+	assert.NoError(t, err)
+	assert.False(t, stored.IsActive, "deactivation is not skipped as a zero value")
+
+	admins, err = repo.CountActiveAdmins(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), admins)
+
+	users, err := repo.GetAllUsers(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	assert.Equal(t, utils.ErrUserNotFound, repo.UpdateUser(context.Background(), domain.User{ID: 999, Role: domain.RoleClerk}))
+}
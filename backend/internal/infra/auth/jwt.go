@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"fmt"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// issuer is put in and required of every token.
+const issuer = "invoice-system"
+
+// claims are the registered claims plus the kind of token, so a refresh
+// token can't be used to call the API and an access token can't be
+// refreshed.
+type claims struct {
+	jwt.RegisteredClaims
+	Type  string `json:"typ"`
+	Email string `json:"email,omitempty"`
+}
+
+type jwtManager struct {
+	secret []byte
+	ttl    map[string]time.Duration
+	now    func() time.Time
+}
+
+// NewJWTManager returns a token manager signing HS256 tokens with secret.
+// Access and refresh tokens expire after their own lifetime.
+func NewJWTManager(secret string, accessTTL, refreshTTL time.Duration) services.TokenManager {
+	return &jwtManager{
+		secret: []byte(secret),
+		ttl: map[string]time.Duration{
+			domain.TokenTypeAccess:  accessTTL,
+			domain.TokenTypeRefresh: refreshTTL,
+		},
+		now: time.Now,
+	}
+}
+
+// IssueToken implements services.TokenManager.
+func (m *jwtManager) IssueToken(user domain.User, tokenType string) (string, time.Time, error) {
+	ttl, ok := m.ttl[tokenType]
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("unknown token type %q", tokenType)
+	}
+
+	now := m.now()
+	expiresAt := now.Add(ttl)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   strconv.FormatUint(uint64(user.ID), 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Type:  tokenType,
+		Email: user.Email,
+	})
+
+	signed, err := token.SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signed, expiresAt, nil
+}
+
+// ParseToken implements services.TokenManager. Only HS256 is accepted, so a
+// token can't pick a weaker algorithm or "none" for itself.
+func (m *jwtManager) ParseToken(token, tokenType string) (uint, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(*jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(m.now),
+	)
+	if err != nil || c.Type != tokenType {
+		return 0, utils.ErrInvalidToken
+	}
+
+	id, err := strconv.ParseUint(c.Subject, 10, 64)
+	if err != nil || id == 0 {
+		return 0, utils.ErrInvalidToken
+	}
+
+	return uint(id), nil
+}
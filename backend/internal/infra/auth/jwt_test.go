@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+	"invoice-system/internal/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWTManager_RoundTrip(t *testing.T) {
+	m := NewJWTManager("secret", 15*time.Minute, 24*time.Hour)
+	user := domain.User{ID: 42, Email: "admin@example.com"}
+
+	access, expiresAt, err := m.IssueToken(user, domain.TokenTypeAccess)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), expiresAt, time.Minute)
+
+	id, err := m.ParseToken(access, domain.TokenTypeAccess)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(42), id)
+
+	// tokens only work for what they were issued for
+	_, err = m.ParseToken(access, domain.TokenTypeRefresh)
+	assert.Equal(t, utils.ErrInvalidToken, err)
+
+	refresh, _, err := m.IssueToken(user, domain.TokenTypeRefresh)
+	assert.NoError(t, err)
+	_, err = m.ParseToken(refresh, domain.TokenTypeAccess)
+	assert.Equal(t, utils.ErrInvalidToken, err)
+}
+
+func TestJWTManager_RejectsBadTokens(t *testing.T) {
+	m := NewJWTManager("secret", 15*time.Minute, 24*time.Hour)
+	user := domain.User{ID: 42}
+
+	token, _, err := m.IssueToken(user, domain.TokenTypeAccess)
+	assert.NoError(t, err)
+
+	other := NewJWTManager("another secret", 15*time.Minute, 24*time.Hour)
+	_, err = other.ParseToken(token, domain.TokenTypeAccess)
+	assert.Equal(t, utils.ErrInvalidToken, err, "signed with another secret")
+
+	_, err = m.ParseToken(token+"x", domain.TokenTypeAccess)
+	assert.Equal(t, utils.ErrInvalidToken, err, "tampered signature")
+
+	_, err = m.ParseToken("", domain.TokenTypeAccess)
+	assert.Equal(t, utils.ErrInvalidToken, err, "empty token")
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"iss": issuer, "sub": "42", "typ": domain.TokenTypeAccess, "exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
+	_, err = m.ParseToken(unsigned, domain.TokenTypeAccess)
+	assert.Equal(t, utils.ErrInvalidToken, err, "alg none")
+
+	expired := m.(*jwtManager)
+	expired.now = func() time.Time { return time.Now().Add(16 * time.Minute) }
+	_, err = expired.ParseToken(token, domain.TokenTypeAccess)
+	assert.Equal(t, utils.ErrInvalidToken, err, "expired")
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+
+	"gorm.io/gorm"
+)
+
+// backfillInvoiceItemTaxes stamps lines created before per-line taxes existed
+// with the default tax rate, which is the rate those invoices were taxed at.
+func backfillInvoiceItemTaxes(db *gorm.DB) error {
+	var rate models.TaxRate
+	if err := db.Where("is_default = ?", true).Limit(1).Find(&rate).Error; err != nil {
+		return err
+	}
+
+	if rate.ID == 0 {
+		return nil
+	}
+
+	return db.Model(&models.InvoiceItem{}).
+		Where("tax_rate_id IS NULL").
+		Updates(map[string]interface{}{
+			"tax_rate_id": rate.ID,
+			"tax_code":    rate.Code,
+			"tax_rate":    rate.Rate,
+			"tax_amount":  gorm.Expr("ROUND(total_price * ? / 100, 2)", rate.Rate),
+		}).Error
+}
+
+// backfillInvoiceStatuses renames the legacy "unpaid" status, which predates
+// the invoice lifecycle, to issued.
+func backfillInvoiceStatuses(db *gorm.DB) error {
+	return db.Model(&models.Invoice{}).
+		Where("status = ?", "unpaid").
+		Update("status", domain.InvoiceStatusIssued).Error
+}
+
+// backfillPaidInvoiceAmounts settles invoices that were marked paid by hand
+// before payments were recorded, so their balance due comes out as zero.
+// Invoices settled by credit notes have nothing paid and are left alone.
+func backfillPaidInvoiceAmounts(db *gorm.DB) error {
+	return db.Model(&models.Invoice{}).
+		Where("status = ? AND amount_paid = 0 AND amount_credited = 0", domain.InvoiceStatusPaid).
+		Update("amount_paid", gorm.Expr("total_amount")).Error
+}
+
+// backfillFirstAdmin makes the oldest user an admin when there is none.
+// Users created before roles existed got the default, read-only role, which
+// would leave nobody able to manage users.
+func backfillFirstAdmin(db *gorm.DB) error {
+	var admins int64
+	if err := db.Model(&models.User{}).Where("role = ?", domain.RoleAdmin).Count(&admins).Error; err != nil {
+		return err
+	}
+
+	if admins > 0 {
+		return nil
+	}
+
+	var first models.User
+	if err := db.Order("id ASC").Limit(1).Find(&first).Error; err != nil {
+		return err
+	}
+
+	if first.ID == 0 {
+		return nil
+	}
+
+	return db.Model(&first).Update("role", domain.RoleAdmin).Error
+}
+
+// dropGlobalUniqueIndexes drops the unique indexes that predate
+// organizations. Numbers, codes and emails are now unique per organization,
+// which the indexes replacing them enforce.
+func dropGlobalUniqueIndexes(db *gorm.DB) error {
+	indexes := []struct {
+		model interface{}
+		name  string
+	}{
+		{&models.Customer{}, "idx_customers_email"},
+		{&models.Invoice{}, "idx_invoices_invoice_number"},
+		{&models.CreditNote{}, "idx_credit_notes_credit_note_number"},
+		{&models.Quote{}, "idx_quotes_quote_number"},
+		{&models.Item{}, "idx_items_sku"},
+		{&models.TaxRate{}, "idx_tax_rates_code"},
+		{&models.PriceList{}, "idx_price_lists_name"},
+		{&models.ExchangeRate{}, "idx_exchange_rates_pair_date"},
+		{&models.DocumentSequence{}, "idx_document_sequences_series_period"},
+		{&models.User{}, "idx_users_email"},
+	}
+
+	migrator := db.Migrator()
+	for _, index := range indexes {
+		if !migrator.HasIndex(index.model, index.name) {
+			continue
+		}
+		if err := migrator.DropIndex(index.model, index.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
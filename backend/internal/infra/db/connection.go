@@ -1,9 +1,11 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"invoice-system/internal/config"
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/db/models"
 	"invoice-system/internal/infra/db/seeders"
 	"invoice-system/internal/infra/logger"
@@ -36,6 +38,10 @@ func NewDatabase(cfg config.DatabaseConfig) (*Database, error) {
 		return nil, fmt.Errorf("failed to connect DB: %w", err)
 	}
 
+	if err := db.Use(Tenancy{}); err != nil {
+		return nil, fmt.Errorf("failed to register tenancy: %w", err)
+	}
+
 	sqlDB, err := db.DB()
 
 	log.Printf("DSN = %s", dsn)
@@ -48,17 +54,57 @@ func NewDatabase(cfg config.DatabaseConfig) (*Database, error) {
 
 	// jalankan migrasi otomatis
 	if err := db.AutoMigrate(
+		&models.Organization{},
+		&models.TaxRate{},
 		&models.Customer{},
 		&models.Invoice{},
 		&models.InvoiceItem{},
 		&models.Item{},
+		&models.ExchangeRate{},
+		&models.Payment{},
+		&models.PriceList{},
+		&models.PriceListItem{},
+		&models.DocumentSequence{},
+		&models.CreditNote{},
+		&models.CreditNoteItem{},
+		&models.Quote{},
+		&models.QuoteItem{},
+		&models.RecurringInvoice{},
+		&models.RecurringInvoiceItem{},
+		&models.User{},
+		&models.AuditLog{},
 	); err != nil {
 		logger.Error("Failed to run auto migration", zap.Error(err))
 		return nil, fmt.Errorf("failed to run auto migration: %w", err)
 	}
 
+	if err := dropGlobalUniqueIndexes(db); err != nil {
+		logger.Error("Failed to drop global unique indexes", zap.Error(err))
+		return nil, fmt.Errorf("failed to drop global unique indexes: %w", err)
+	}
+
 	// database seed all
-	seeders.SeedAll(db)
+	seeders.SeedAll(db.WithContext(domain.ContextWithTenant(context.Background(), domain.DefaultOrganizationID)))
+
+	if err := backfillInvoiceItemTaxes(db); err != nil {
+		logger.Error("Failed to backfill invoice item taxes", zap.Error(err))
+		return nil, fmt.Errorf("failed to backfill invoice item taxes: %w", err)
+	}
+
+	if err := backfillInvoiceStatuses(db); err != nil {
+		logger.Error("Failed to backfill invoice statuses", zap.Error(err))
+		return nil, fmt.Errorf("failed to backfill invoice statuses: %w", err)
+	}
+
+	if err := backfillPaidInvoiceAmounts(db); err != nil {
+		logger.Error("Failed to backfill paid invoice amounts", zap.Error(err))
+		return nil, fmt.Errorf("failed to backfill paid invoice amounts: %w", err)
+	}
+
+	if err := backfillFirstAdmin(db); err != nil {
+		logger.Error("Failed to backfill the first admin", zap.Error(err))
+		return nil, fmt.Errorf("failed to backfill the first admin: %w", err)
+	}
 
 	sqlDB.SetMaxOpenConns(cfg.MaxOpenCons)
 	sqlDB.SetMaxIdleConns(cfg.MaxIdleCons)
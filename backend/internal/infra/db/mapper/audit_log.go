@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainAuditEntry(m models.AuditLog) domain.AuditEntry {
+	return domain.AuditEntry{
+		ID:         m.ID,
+		EntityType: m.EntityType,
+		EntityID:   m.EntityID,
+		Action:     m.Action,
+		ActorID:    m.ActorID,
+		ActorEmail: m.ActorEmail,
+		Changes:    m.Changes,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func ToModelAuditLog(d domain.AuditEntry) models.AuditLog {
+	return models.AuditLog{
+		ID:         d.ID,
+		EntityType: d.EntityType,
+		EntityID:   d.EntityID,
+		Action:     d.Action,
+		ActorID:    d.ActorID,
+		ActorEmail: d.ActorEmail,
+		Changes:    d.Changes,
+		CreatedAt:  d.CreatedAt,
+	}
+}
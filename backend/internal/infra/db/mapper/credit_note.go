@@ -0,0 +1,111 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainCreditNoteItem(m models.CreditNoteItem) domain.CreditNoteItem {
+	d := domain.CreditNoteItem{
+		ID:            m.ID,
+		CreditNoteID:  m.CreditNoteID,
+		InvoiceItemID: m.InvoiceItemID,
+		ItemID:        m.ItemID,
+		Quantity:      m.Quantity,
+		Unit:          m.Unit,
+		Price:         m.Price,
+		TotalPrice:    m.TotalPrice,
+		TaxRateID:     m.TaxRateID,
+		TaxCode:       m.TaxCode,
+		TaxRate:       m.TaxRate,
+		TaxAmount:     m.TaxAmount,
+		CreatedAt:     m.CreatedAt,
+	}
+
+	if m.Item != nil {
+		d.ItemName = m.Item.Name
+		d.Type = m.Item.Type
+	}
+
+	return d
+}
+
+func ToModelCreditNoteItem(d domain.CreditNoteItem) models.CreditNoteItem {
+	return models.CreditNoteItem{
+		ID:            d.ID,
+		CreditNoteID:  d.CreditNoteID,
+		InvoiceItemID: d.InvoiceItemID,
+		ItemID:        d.ItemID,
+		Quantity:      d.Quantity,
+		Unit:          d.Unit,
+		Price:         d.Price,
+		TotalPrice:    d.TotalPrice,
+		TaxRateID:     d.TaxRateID,
+		TaxCode:       d.TaxCode,
+		TaxRate:       d.TaxRate,
+		TaxAmount:     d.TaxAmount,
+		CreatedAt:     d.CreatedAt,
+	}
+}
+
+func ToDomainCreditNote(m models.CreditNote) domain.CreditNote {
+	var items []domain.CreditNoteItem
+	for _, it := range m.Items {
+		items = append(items, ToDomainCreditNoteItem(it))
+	}
+
+	d := domain.CreditNote{
+		ID:               m.ID,
+		CreditNoteNumber: m.CreditNoteNumber,
+		InvoiceID:        m.InvoiceID,
+		CustomerID:       m.CustomerID,
+		IssueDate:        m.IssueDate,
+		Reason:           m.Reason,
+		TotalItems:       m.TotalItems,
+		Subtotal:         m.Subtotal,
+		Tax:              m.Tax,
+		TotalAmount:      m.TotalAmount,
+		Currency:         m.Currency,
+		Status:           m.Status,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+		Items:            items,
+	}
+
+	if m.Invoice != nil {
+		invoice := ToDomainInvoice(*m.Invoice)
+		d.Invoice = &invoice
+	}
+
+	if m.Customer != nil {
+		customer := ToDomainCustomer(*m.Customer)
+		d.Customer = &customer
+	}
+
+	return d
+}
+
+func ToModelCreditNote(d domain.CreditNote) models.CreditNote {
+	var items []models.CreditNoteItem
+	for _, it := range d.Items {
+		items = append(items, ToModelCreditNoteItem(it))
+	}
+
+	return models.CreditNote{
+		ID:               d.ID,
+		CreditNoteNumber: d.CreditNoteNumber,
+		InvoiceID:        d.InvoiceID,
+		CustomerID:       d.CustomerID,
+		IssueDate:        d.IssueDate,
+		Reason:           d.Reason,
+		TotalItems:       d.TotalItems,
+		Subtotal:         d.Subtotal,
+		Tax:              d.Tax,
+		TotalAmount:      d.TotalAmount,
+		Currency:         d.Currency,
+		Status:           d.Status,
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+		Items:            items,
+	}
+}
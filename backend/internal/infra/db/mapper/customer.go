@@ -7,24 +7,30 @@ import (
 
 func ToDomainCustomer(m models.Customer) domain.Customer {
 	return domain.Customer{
-		ID:        m.ID,
-		Name:      m.Name,
-		Email:     m.Email,
-		Phone:     m.Phone,
-		Address:   m.Address,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:          m.ID,
+		Name:        m.Name,
+		Email:       m.Email,
+		Phone:       m.Phone,
+		Address:     m.Address,
+		TaxRateID:   m.TaxRateID,
+		PriceListID: m.PriceListID,
+		Currency:    m.Currency,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
 	}
 }
 
 func ToModelCustomer(d domain.Customer) models.Customer {
 	return models.Customer{
-		ID:        d.ID,
-		Name:      d.Name,
-		Email:     d.Email,
-		Phone:     d.Phone,
-		Address:   d.Address,
-		CreatedAt: d.CreatedAt,
-		UpdatedAt: d.UpdatedAt,
+		ID:          d.ID,
+		Name:        d.Name,
+		Email:       d.Email,
+		Phone:       d.Phone,
+		Address:     d.Address,
+		TaxRateID:   d.TaxRateID,
+		PriceListID: d.PriceListID,
+		Currency:    d.Currency,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
 	}
 }
@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainExchangeRate(m models.ExchangeRate) domain.ExchangeRate {
+	return domain.ExchangeRate{
+		ID:           m.ID,
+		BaseCurrency: m.BaseCurrency,
+		Currency:     m.Currency,
+		Rate:         m.Rate,
+		RateDate:     m.RateDate,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func ToModelExchangeRate(d domain.ExchangeRate) models.ExchangeRate {
+	return models.ExchangeRate{
+		ID:           d.ID,
+		BaseCurrency: d.BaseCurrency,
+		Currency:     d.Currency,
+		Rate:         d.Rate,
+		RateDate:     d.RateDate,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
@@ -13,8 +13,13 @@ func ToDomainInvoiceItem(m models.InvoiceItem) domain.InvoiceItem {
 		ItemName:   m.Item.Name,
 		Type:       m.Item.Type,
 		Quantity:   m.Quantity,
+		Unit:       m.Unit,
 		Price:      m.Price,
 		TotalPrice: m.TotalPrice,
+		TaxRateID:  m.TaxRateID,
+		TaxCode:    m.TaxCode,
+		TaxRate:    m.TaxRate,
+		TaxAmount:  m.TaxAmount,
 		CreatedAt:  m.CreatedAt,
 	}
 }
@@ -25,8 +30,13 @@ func ToModelInvoiceItem(d domain.InvoiceItem) models.InvoiceItem {
 		InvoiceID:  d.InvoiceID,
 		ItemID:     d.ItemID,
 		Quantity:   d.Quantity,
+		Unit:       d.Unit,
 		Price:      d.Price,
 		TotalPrice: d.TotalPrice,
+		TaxRateID:  d.TaxRateID,
+		TaxCode:    d.TaxCode,
+		TaxRate:    d.TaxRate,
+		TaxAmount:  d.TaxAmount,
 		CreatedAt:  d.CreatedAt,
 	}
 }
@@ -37,27 +47,42 @@ func ToDomainInvoice(m models.Invoice) domain.Invoice {
 		items = append(items, ToDomainInvoiceItem(it))
 	}
 
+	var payments []domain.Payment
+	for _, p := range m.Payments {
+		payments = append(payments, ToDomainPayment(p))
+	}
+
 	var customer domain.Customer
 	if m.Customer != nil {
 		customer = ToDomainCustomer(*m.Customer)
 	}
 
 	return domain.Invoice{
-		ID:            m.ID,
-		InvoiceNumber: m.InvoiceNumber,
-		IssueDate:     m.IssueDate,
-		DueDate:       m.DueDate,
-		Subject:       m.Subject,
-		CustomerID:    m.CustomerID,
-		TotalItems:    m.TotalItems,
-		Subtotal:      m.Subtotal,
-		Tax:           m.Tax,
-		TotalAmount:   m.TotalAmount,
-		Status:        m.Status,
-		CreatedAt:     m.CreatedAt,
-		UpdatedAt:     m.UpdatedAt,
-		Customer:      &customer,
-		Items:         items,
+		ID:             m.ID,
+		InvoiceNumber:  m.InvoiceNumber,
+		IssueDate:      m.IssueDate,
+		DueDate:        m.DueDate,
+		Subject:        m.Subject,
+		CustomerID:     m.CustomerID,
+		TotalItems:     m.TotalItems,
+		Subtotal:       m.Subtotal,
+		Tax:            m.Tax,
+		TotalAmount:    m.TotalAmount,
+		AmountPaid:     m.AmountPaid,
+		AmountCredited: m.AmountCredited,
+		Currency:       m.Currency,
+		Status:         m.Status,
+		QuoteID:        m.QuoteID,
+		RecurringID:    m.RecurringID,
+		RecurringRun:   m.RecurringRun,
+		OverdueAt:      m.OverdueAt,
+		VoidedAt:       m.VoidedAt,
+		Version:        m.Version,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+		Customer:       &customer,
+		Items:          items,
+		Payments:       payments,
 	}
 }
 
@@ -68,19 +93,28 @@ func ToModelInvoice(d domain.Invoice) models.Invoice {
 	}
 
 	return models.Invoice{
-		ID:            d.ID,
-		InvoiceNumber: d.InvoiceNumber,
-		IssueDate:     d.IssueDate,
-		DueDate:       d.DueDate,
-		Subject:       d.Subject,
-		CustomerID:    d.CustomerID,
-		TotalItems:    d.TotalItems,
-		Subtotal:      d.Subtotal,
-		Tax:           d.Tax,
-		TotalAmount:   d.TotalAmount,
-		Status:        d.Status,
-		CreatedAt:     d.CreatedAt,
-		UpdatedAt:     d.UpdatedAt,
-		Items:         items,
+		ID:             d.ID,
+		InvoiceNumber:  d.InvoiceNumber,
+		IssueDate:      d.IssueDate,
+		DueDate:        d.DueDate,
+		Subject:        d.Subject,
+		CustomerID:     d.CustomerID,
+		TotalItems:     d.TotalItems,
+		Subtotal:       d.Subtotal,
+		Tax:            d.Tax,
+		TotalAmount:    d.TotalAmount,
+		AmountPaid:     d.AmountPaid,
+		AmountCredited: d.AmountCredited,
+		Currency:       d.Currency,
+		Status:         d.Status,
+		QuoteID:        d.QuoteID,
+		RecurringID:    d.RecurringID,
+		RecurringRun:   d.RecurringRun,
+		OverdueAt:      d.OverdueAt,
+		VoidedAt:       d.VoidedAt,
+		Version:        d.Version,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+		Items:          items,
 	}
 }
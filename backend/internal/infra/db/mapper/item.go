@@ -6,23 +6,45 @@ import (
 )
 
 func ToDomainItem(m models.Item) domain.Item {
+	var sku string
+	if m.SKU != nil {
+		sku = *m.SKU
+	}
+
 	return domain.Item{
-		ID:        m.ID,
-		Name:      m.Name,
-		Type:      m.Type,
-		IsActive:  m.IsActive,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:          m.ID,
+		SKU:         sku,
+		Name:        m.Name,
+		Type:        m.Type,
+		Description: m.Description,
+		UnitPrice:   m.UnitPrice,
+		Unit:        m.Unit,
+		IsActive:    m.IsActive,
+		TaxRateID:   m.TaxRateID,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
 	}
 }
 
 func ToModelItem(d domain.Item) models.Item {
+	// items without a SKU are stored as NULL so they don't collide on the
+	// unique index
+	var sku *string
+	if d.SKU != "" {
+		sku = &d.SKU
+	}
+
 	return models.Item{
-		ID:        d.ID,
-		Name:      d.Name,
-		Type:      d.Type,
-		IsActive:  d.IsActive,
-		CreatedAt: d.CreatedAt,
-		UpdatedAt: d.UpdatedAt,
+		ID:          d.ID,
+		SKU:         sku,
+		Name:        d.Name,
+		Type:        d.Type,
+		Description: d.Description,
+		UnitPrice:   d.UnitPrice,
+		Unit:        d.Unit,
+		IsActive:    d.IsActive,
+		TaxRateID:   d.TaxRateID,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
 	}
 }
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainPayment(m models.Payment) domain.Payment {
+	return domain.Payment{
+		ID:          m.ID,
+		InvoiceID:   m.InvoiceID,
+		Amount:      m.Amount,
+		PaymentDate: m.PaymentDate,
+		Method:      m.Method,
+		Reference:   m.Reference,
+		Notes:       m.Notes,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func ToModelPayment(d domain.Payment) models.Payment {
+	return models.Payment{
+		ID:          d.ID,
+		InvoiceID:   d.InvoiceID,
+		Amount:      d.Amount,
+		PaymentDate: d.PaymentDate,
+		Method:      d.Method,
+		Reference:   d.Reference,
+		Notes:       d.Notes,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainPriceList(m models.PriceList) domain.PriceList {
+	var items []domain.PriceListItem
+	for _, it := range m.Items {
+		items = append(items, ToDomainPriceListItem(it))
+	}
+
+	return domain.PriceList{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		IsDefault:   m.IsDefault,
+		ValidFrom:   m.ValidFrom,
+		ValidTo:     m.ValidTo,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		Items:       items,
+	}
+}
+
+func ToModelPriceList(d domain.PriceList) models.PriceList {
+	return models.PriceList{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		IsDefault:   d.IsDefault,
+		ValidFrom:   d.ValidFrom,
+		ValidTo:     d.ValidTo,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func ToDomainPriceListItem(m models.PriceListItem) domain.PriceListItem {
+	return domain.PriceListItem{
+		ID:          m.ID,
+		PriceListID: m.PriceListID,
+		ItemID:      m.ItemID,
+		UnitPrice:   m.UnitPrice,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func ToModelPriceListItem(d domain.PriceListItem) models.PriceListItem {
+	return models.PriceListItem{
+		ID:          d.ID,
+		PriceListID: d.PriceListID,
+		ItemID:      d.ItemID,
+		UnitPrice:   d.UnitPrice,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
@@ -0,0 +1,106 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainQuoteItem(m models.QuoteItem) domain.QuoteItem {
+	d := domain.QuoteItem{
+		ID:         m.ID,
+		QuoteID:    m.QuoteID,
+		ItemID:     m.ItemID,
+		Quantity:   m.Quantity,
+		Unit:       m.Unit,
+		Price:      m.Price,
+		TotalPrice: m.TotalPrice,
+		TaxRateID:  m.TaxRateID,
+		TaxCode:    m.TaxCode,
+		TaxRate:    m.TaxRate,
+		TaxAmount:  m.TaxAmount,
+		CreatedAt:  m.CreatedAt,
+	}
+
+	if m.Item != nil {
+		d.ItemName = m.Item.Name
+		d.Type = m.Item.Type
+	}
+
+	return d
+}
+
+func ToModelQuoteItem(d domain.QuoteItem) models.QuoteItem {
+	return models.QuoteItem{
+		ID:         d.ID,
+		QuoteID:    d.QuoteID,
+		ItemID:     d.ItemID,
+		Quantity:   d.Quantity,
+		Unit:       d.Unit,
+		Price:      d.Price,
+		TotalPrice: d.TotalPrice,
+		TaxRateID:  d.TaxRateID,
+		TaxCode:    d.TaxCode,
+		TaxRate:    d.TaxRate,
+		TaxAmount:  d.TaxAmount,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func ToDomainQuote(m models.Quote) domain.Quote {
+	var items []domain.QuoteItem
+	for _, it := range m.Items {
+		items = append(items, ToDomainQuoteItem(it))
+	}
+
+	d := domain.Quote{
+		ID:          m.ID,
+		QuoteNumber: m.QuoteNumber,
+		CustomerID:  m.CustomerID,
+		IssueDate:   m.IssueDate,
+		ExpiryDate:  m.ExpiryDate,
+		Subject:     m.Subject,
+		TotalItems:  m.TotalItems,
+		Subtotal:    m.Subtotal,
+		Tax:         m.Tax,
+		TotalAmount: m.TotalAmount,
+		Currency:    m.Currency,
+		Status:      m.Status,
+		InvoiceID:   m.InvoiceID,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		Items:       items,
+	}
+
+	if m.Customer != nil {
+		customer := ToDomainCustomer(*m.Customer)
+		d.Customer = &customer
+	}
+
+	return d
+}
+
+func ToModelQuote(d domain.Quote) models.Quote {
+	var items []models.QuoteItem
+	for _, it := range d.Items {
+		items = append(items, ToModelQuoteItem(it))
+	}
+
+	return models.Quote{
+		ID:          d.ID,
+		QuoteNumber: d.QuoteNumber,
+		CustomerID:  d.CustomerID,
+		IssueDate:   d.IssueDate,
+		ExpiryDate:  d.ExpiryDate,
+		Subject:     d.Subject,
+		TotalItems:  d.TotalItems,
+		Subtotal:    d.Subtotal,
+		Tax:         d.Tax,
+		TotalAmount: d.TotalAmount,
+		Currency:    d.Currency,
+		Status:      d.Status,
+		InvoiceID:   d.InvoiceID,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+		Items:       items,
+	}
+}
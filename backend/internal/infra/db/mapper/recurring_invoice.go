@@ -0,0 +1,99 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainRecurringInvoiceItem(m models.RecurringInvoiceItem) domain.RecurringInvoiceItem {
+	d := domain.RecurringInvoiceItem{
+		ID:                 m.ID,
+		RecurringInvoiceID: m.RecurringInvoiceID,
+		ItemID:             m.ItemID,
+		Quantity:           m.Quantity,
+		Price:              m.Price,
+		TaxRateID:          m.TaxRateID,
+		CreatedAt:          m.CreatedAt,
+	}
+
+	if m.Item != nil {
+		d.ItemName = m.Item.Name
+	}
+
+	return d
+}
+
+func ToModelRecurringInvoiceItem(d domain.RecurringInvoiceItem) models.RecurringInvoiceItem {
+	return models.RecurringInvoiceItem{
+		ID:                 d.ID,
+		RecurringInvoiceID: d.RecurringInvoiceID,
+		ItemID:             d.ItemID,
+		Quantity:           d.Quantity,
+		Price:              d.Price,
+		TaxRateID:          d.TaxRateID,
+		CreatedAt:          d.CreatedAt,
+	}
+}
+
+func ToDomainRecurringInvoice(m models.RecurringInvoice) domain.RecurringInvoice {
+	var items []domain.RecurringInvoiceItem
+	for _, it := range m.Items {
+		items = append(items, ToDomainRecurringInvoiceItem(it))
+	}
+
+	d := domain.RecurringInvoice{
+		ID:              m.ID,
+		TenantID:        m.TenantID,
+		CustomerID:      m.CustomerID,
+		Subject:         m.Subject,
+		Currency:        m.Currency,
+		Interval:        m.Interval,
+		StartDate:       m.StartDate,
+		NextRunDate:     m.NextRunDate,
+		LastRunDate:     m.LastRunDate,
+		EndDate:         m.EndDate,
+		MaxOccurrences:  m.MaxOccurrences,
+		Occurrences:     m.Occurrences,
+		PaymentTermDays: m.PaymentTermDays,
+		InvoiceStatus:   m.InvoiceStatus,
+		Status:          m.Status,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+		Items:           items,
+	}
+
+	if m.Customer != nil {
+		customer := ToDomainCustomer(*m.Customer)
+		d.Customer = &customer
+	}
+
+	return d
+}
+
+func ToModelRecurringInvoice(d domain.RecurringInvoice) models.RecurringInvoice {
+	var items []models.RecurringInvoiceItem
+	for _, it := range d.Items {
+		items = append(items, ToModelRecurringInvoiceItem(it))
+	}
+
+	return models.RecurringInvoice{
+		ID:              d.ID,
+		TenantID:        d.TenantID,
+		CustomerID:      d.CustomerID,
+		Subject:         d.Subject,
+		Currency:        d.Currency,
+		Interval:        d.Interval,
+		StartDate:       d.StartDate,
+		NextRunDate:     d.NextRunDate,
+		LastRunDate:     d.LastRunDate,
+		EndDate:         d.EndDate,
+		MaxOccurrences:  d.MaxOccurrences,
+		Occurrences:     d.Occurrences,
+		PaymentTermDays: d.PaymentTermDays,
+		InvoiceStatus:   d.InvoiceStatus,
+		Status:          d.Status,
+		CreatedAt:       d.CreatedAt,
+		UpdatedAt:       d.UpdatedAt,
+		Items:           items,
+	}
+}
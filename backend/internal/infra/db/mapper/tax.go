@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainTaxRate(m models.TaxRate) domain.TaxRate {
+	return domain.TaxRate{
+		ID:        m.ID,
+		Code:      m.Code,
+		Name:      m.Name,
+		Type:      m.Type,
+		Rate:      m.Rate,
+		IsDefault: m.IsDefault,
+		IsActive:  m.IsActive,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func ToModelTaxRate(d domain.TaxRate) models.TaxRate {
+	return models.TaxRate{
+		ID:        d.ID,
+		Code:      d.Code,
+		Name:      d.Name,
+		Type:      d.Type,
+		Rate:      d.Rate,
+		IsDefault: d.IsDefault,
+		IsActive:  d.IsActive,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+)
+
+func ToDomainUser(m models.User) domain.User {
+	return domain.User{
+		ID:           m.ID,
+		TenantID:     m.TenantID,
+		Name:         m.Name,
+		Email:        m.Email,
+		PasswordHash: m.PasswordHash,
+		Role:         m.Role,
+		IsActive:     m.IsActive,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func ToModelUser(d domain.User) models.User {
+	return models.User{
+		ID:           d.ID,
+		TenantID:     d.TenantID,
+		Name:         d.Name,
+		Email:        d.Email,
+		PasswordHash: d.PasswordHash,
+		Role:         d.Role,
+		IsActive:     d.IsActive,
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
@@ -1,38 +1,56 @@
 package models
 
 import (
+	"invoice-system/internal/domain"
 	"time"
 
 	"gorm.io/gorm"
 )
 
 type Invoice struct {
-	ID            uint           `gorm:"primaryKey" json:"id"`
-	InvoiceNumber string         `gorm:"type:varchar(20);uniqueIndex;not null" json:"invoice_number"`
-	IssueDate     time.Time      `json:"issue_date"`
-	DueDate       time.Time      `json:"due_date"`
-	Subject       string         `gorm:"type:varchar(255)" json:"subject"`
-	CustomerID    uint           `json:"customer_id"`
-	TotalItems    int            `json:"total_items"`
-	Subtotal      float64        `gorm:"type:decimal(12,2)" json:"subtotal"`
-	Tax           float64        `gorm:"type:decimal(12,2)" json:"tax"`
-	TotalAmount   float64        `gorm:"type:decimal(12,2)" json:"total_amount"`
-	Status        string         `gorm:"type:enum('paid','unpaid');default:'unpaid'" json:"status"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+	ID             uint           `gorm:"primaryKey" json:"id"`
+	TenantID       uint           `gorm:"not null;default:1;uniqueIndex:idx_invoices_tenant_number" json:"tenant_id"`
+	InvoiceNumber  string         `gorm:"type:varchar(50);uniqueIndex:idx_invoices_tenant_number;not null" json:"invoice_number"`
+	IssueDate      time.Time      `json:"issue_date"`
+	DueDate        time.Time      `json:"due_date"`
+	Subject        string         `gorm:"type:varchar(255)" json:"subject"`
+	CustomerID     uint           `json:"customer_id"`
+	TotalItems     int            `json:"total_items"`
+	Subtotal       domain.Money   `gorm:"type:decimal(12,2)" json:"subtotal"`
+	Tax            domain.Money   `gorm:"type:decimal(12,2)" json:"tax"`
+	TotalAmount    domain.Money   `gorm:"type:decimal(12,2)" json:"total_amount"`
+	AmountPaid     domain.Money   `gorm:"type:decimal(12,2);not null;default:0" json:"amount_paid"`
+	AmountCredited domain.Money   `gorm:"type:decimal(12,2);not null;default:0" json:"amount_credited"`
+	Currency       string         `gorm:"type:char(3);not null;default:'IDR'" json:"currency"`
+	Status         string         `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	QuoteID        *uint          `gorm:"uniqueIndex" json:"quote_id"`
+	RecurringID    *uint          `gorm:"uniqueIndex:idx_invoices_recurring_run" json:"recurring_invoice_id"`
+	RecurringRun   int            `gorm:"uniqueIndex:idx_invoices_recurring_run;not null;default:0" json:"recurring_run"`
+	OverdueAt      *time.Time     `json:"overdue_at"`
+	VoidedAt       *time.Time     `json:"voided_at"`
+	Version        uint           `gorm:"not null;default:1" json:"version"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
 
 	Customer *Customer     `gorm:"foreignKey:CustomerID" json:"customer,omitempty"`
 	Items    []InvoiceItem `gorm:"foreignKey:InvoiceID;constraint:OnDelete:CASCADE;" json:"items,omitempty"`
+	Payments []Payment     `gorm:"foreignKey:InvoiceID" json:"payments,omitempty"`
 }
 
 type InvoiceItem struct {
 	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	TenantID   uint           `gorm:"not null;default:1;index" json:"tenant_id"`
 	InvoiceID  uint           `json:"invoice_id"`
 	ItemID     uint           `json:"item_id"`
 	Quantity   int            `json:"quantity"`
-	Price      float64        `gorm:"type:decimal(12,2)" json:"price"`
-	TotalPrice float64        `gorm:"type:decimal(12,2)" json:"total_price"`
+	Unit       string         `gorm:"type:varchar(20)" json:"unit"`
+	Price      domain.Money   `gorm:"type:decimal(12,2)" json:"price"`
+	TotalPrice domain.Money   `gorm:"type:decimal(12,2)" json:"total_price"`
+	TaxRateID  *uint          `json:"tax_rate_id"`
+	TaxCode    string         `gorm:"type:varchar(20)" json:"tax_code"`
+	TaxRate    float64        `gorm:"type:decimal(5,2)" json:"tax_rate"`
+	TaxAmount  domain.Money   `gorm:"type:decimal(12,2)" json:"tax_amount"`
 	CreatedAt  time.Time      `json:"created_at"`
 	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
 
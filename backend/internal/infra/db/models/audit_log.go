@@ -0,0 +1,18 @@
+package models
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+)
+
+type AuditLog struct {
+	ID         uint                          `gorm:"primaryKey" json:"id"`
+	TenantID   uint                          `gorm:"not null;default:1;index:idx_audit_logs_entity,priority:1" json:"tenant_id"`
+	EntityType string                        `gorm:"type:varchar(20);not null;index:idx_audit_logs_entity,priority:2" json:"entity_type"`
+	EntityID   uint                          `gorm:"not null;index:idx_audit_logs_entity,priority:3" json:"entity_id"`
+	Action     string                        `gorm:"type:varchar(20);not null" json:"action"`
+	ActorID    *uint                         `gorm:"index" json:"actor_id"`
+	ActorEmail string                        `gorm:"type:varchar(255)" json:"actor_email"`
+	Changes    map[string]domain.AuditChange `gorm:"type:text;serializer:json" json:"changes"`
+	CreatedAt  time.Time                     `gorm:"index" json:"created_at"`
+}
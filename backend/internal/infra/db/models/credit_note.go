@@ -0,0 +1,51 @@
+package models
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CreditNote struct {
+	ID               uint           `gorm:"primaryKey" json:"id"`
+	TenantID         uint           `gorm:"not null;default:1;uniqueIndex:idx_credit_notes_tenant_number" json:"tenant_id"`
+	CreditNoteNumber string         `gorm:"type:varchar(50);uniqueIndex:idx_credit_notes_tenant_number;not null" json:"credit_note_number"`
+	InvoiceID        uint           `gorm:"index;not null" json:"invoice_id"`
+	CustomerID       uint           `gorm:"index;not null" json:"customer_id"`
+	IssueDate        time.Time      `json:"issue_date"`
+	Reason           string         `gorm:"type:varchar(255)" json:"reason"`
+	TotalItems       int            `json:"total_items"`
+	Subtotal         domain.Money   `gorm:"type:decimal(12,2)" json:"subtotal"`
+	Tax              domain.Money   `gorm:"type:decimal(12,2)" json:"tax"`
+	TotalAmount      domain.Money   `gorm:"type:decimal(12,2)" json:"total_amount"`
+	Currency         string         `gorm:"type:char(3);not null;default:'IDR'" json:"currency"`
+	Status           string         `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Invoice  *Invoice         `gorm:"foreignKey:InvoiceID" json:"invoice,omitempty"`
+	Customer *Customer        `gorm:"foreignKey:CustomerID" json:"customer,omitempty"`
+	Items    []CreditNoteItem `gorm:"foreignKey:CreditNoteID;constraint:OnDelete:CASCADE;" json:"items,omitempty"`
+}
+
+type CreditNoteItem struct {
+	ID            uint         `gorm:"primaryKey;autoIncrement"`
+	TenantID      uint         `gorm:"not null;default:1;index" json:"tenant_id"`
+	CreditNoteID  uint         `gorm:"index;not null" json:"credit_note_id"`
+	InvoiceItemID *uint        `gorm:"index" json:"invoice_item_id"`
+	ItemID        uint         `json:"item_id"`
+	Quantity      int          `json:"quantity"`
+	Unit          string       `gorm:"type:varchar(20)" json:"unit"`
+	Price         domain.Money `gorm:"type:decimal(12,2)" json:"price"`
+	TotalPrice    domain.Money `gorm:"type:decimal(12,2)" json:"total_price"`
+	TaxRateID     *uint        `json:"tax_rate_id"`
+	TaxCode       string       `gorm:"type:varchar(20)" json:"tax_code"`
+	TaxRate       float64      `gorm:"type:decimal(5,2)" json:"tax_rate"`
+	TaxAmount     domain.Money `gorm:"type:decimal(12,2)" json:"tax_amount"`
+	CreatedAt     time.Time    `json:"created_at"`
+
+	Item        *Item        `gorm:"foreignKey:ItemID" json:"item,omitempty"`
+	InvoiceItem *InvoiceItem `gorm:"foreignKey:InvoiceItemID" json:"invoice_item,omitempty"`
+}
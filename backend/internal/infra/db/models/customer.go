@@ -7,14 +7,20 @@ import (
 )
 
 type Customer struct {
-	ID        uint           `gorm:"primaryKey" json:"id"`
-	Name      string         `gorm:"type:varchar(255);not null" json:"name"`
-	Email     string         `gorm:"type:varchar(255);uniqueIndex" json:"email"`
-	Phone     string         `gorm:"type:varchar(50)" json:"phone"`
-	Address   string         `gorm:"type:text" json:"address"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	TenantID    uint           `gorm:"not null;default:1;uniqueIndex:idx_customers_tenant_email" json:"tenant_id"`
+	Name        string         `gorm:"type:varchar(255);not null" json:"name"`
+	Email       string         `gorm:"type:varchar(255);uniqueIndex:idx_customers_tenant_email" json:"email"`
+	Phone       string         `gorm:"type:varchar(50)" json:"phone"`
+	Address     string         `gorm:"type:text" json:"address"`
+	TaxRateID   *uint          `json:"tax_rate_id"`
+	Currency    string         `gorm:"type:char(3);not null;default:'IDR'" json:"currency"`
+	PriceListID *uint          `gorm:"index" json:"price_list_id"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 
-	Invoices []Invoice `gorm:"foreignKey:CustomerID" json:"invoices,omitempty"`
+	TaxRate   *TaxRate   `gorm:"foreignKey:TaxRateID" json:"tax_rate,omitempty"`
+	PriceList *PriceList `gorm:"foreignKey:PriceListID" json:"price_list,omitempty"`
+	Invoices  []Invoice  `gorm:"foreignKey:CustomerID" json:"invoices,omitempty"`
 }
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// DocumentSequence holds the last number handed out for a document series
+// in a period (see domain.NumberingScheme.Period).
+type DocumentSequence struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	TenantID  uint      `gorm:"not null;default:1;uniqueIndex:idx_document_sequences_tenant_series_period" json:"tenant_id"`
+	Series    string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_document_sequences_tenant_series_period" json:"series"`
+	Period    string    `gorm:"type:varchar(7);not null;default:'';uniqueIndex:idx_document_sequences_tenant_series_period" json:"period"`
+	LastValue int64     `gorm:"not null;default:0" json:"last_value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type ExchangeRate struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	TenantID     uint      `gorm:"not null;default:1;uniqueIndex:idx_exchange_rates_tenant_pair_date" json:"tenant_id"`
+	BaseCurrency string    `gorm:"type:char(3);not null;uniqueIndex:idx_exchange_rates_tenant_pair_date" json:"base_currency"`
+	Currency     string    `gorm:"type:char(3);not null;uniqueIndex:idx_exchange_rates_tenant_pair_date" json:"currency"`
+	RateDate     time.Time `gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_tenant_pair_date" json:"rate_date"`
+	Rate         float64   `gorm:"type:decimal(18,8);not null" json:"rate"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
@@ -1,19 +1,27 @@
 package models
 
 import (
+	"invoice-system/internal/domain"
 	"time"
 
 	"gorm.io/gorm"
 )
 
 type Item struct {
-	ID        uint           `gorm:"primaryKey" json:"id"`
-	Name      string         `gorm:"type:varchar(255);not null" json:"name"`
-	Type      string         `gorm:"type:varchar(255)" json:"type"`
-	IsActive  bool           `gorm:"default:true" json:"is_active"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	TenantID    uint           `gorm:"not null;default:1;uniqueIndex:idx_items_tenant_sku" json:"tenant_id"`
+	SKU         *string        `gorm:"column:sku;type:varchar(64);uniqueIndex:idx_items_tenant_sku" json:"sku"`
+	Name        string         `gorm:"type:varchar(255);not null" json:"name"`
+	Type        string         `gorm:"type:varchar(255)" json:"type"`
+	Description string         `gorm:"type:text" json:"description"`
+	UnitPrice   domain.Money   `gorm:"type:decimal(12,2);not null;default:0" json:"unit_price"`
+	Unit        string         `gorm:"type:varchar(20);not null;default:'pcs'" json:"unit"`
+	IsActive    bool           `gorm:"default:true" json:"is_active"`
+	TaxRateID   *uint          `json:"tax_rate_id"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 
+	TaxRate      *TaxRate      `gorm:"foreignKey:TaxRateID" json:"tax_rate,omitempty"`
 	InvoiceItems []InvoiceItem `gorm:"foreignKey:ItemID" json:"invoice_items,omitempty"`
 }
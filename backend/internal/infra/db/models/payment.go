@@ -0,0 +1,24 @@
+package models
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Payment struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	TenantID    uint           `gorm:"not null;default:1;index" json:"tenant_id"`
+	InvoiceID   uint           `gorm:"index;not null" json:"invoice_id"`
+	Amount      domain.Money   `gorm:"type:decimal(12,2);not null" json:"amount"`
+	PaymentDate time.Time      `gorm:"not null" json:"payment_date"`
+	Method      string         `gorm:"type:varchar(20);not null" json:"method"`
+	Reference   string         `gorm:"type:varchar(100)" json:"reference"`
+	Notes       string         `gorm:"type:varchar(255)" json:"notes"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Invoice *Invoice `gorm:"foreignKey:InvoiceID" json:"invoice,omitempty"`
+}
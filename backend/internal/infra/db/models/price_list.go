@@ -0,0 +1,35 @@
+package models
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PriceList struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	TenantID    uint           `gorm:"not null;default:1;uniqueIndex:idx_price_lists_tenant_name" json:"tenant_id"`
+	Name        string         `gorm:"type:varchar(100);not null;uniqueIndex:idx_price_lists_tenant_name" json:"name"`
+	Description string         `gorm:"type:text" json:"description"`
+	IsDefault   bool           `gorm:"not null;default:false;index" json:"is_default"`
+	ValidFrom   time.Time      `gorm:"type:date;not null;index" json:"valid_from"`
+	ValidTo     *time.Time     `gorm:"type:date" json:"valid_to"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Items []PriceListItem `gorm:"foreignKey:PriceListID" json:"items,omitempty"`
+}
+
+type PriceListItem struct {
+	ID          uint         `gorm:"primaryKey" json:"id"`
+	TenantID    uint         `gorm:"not null;default:1;index" json:"tenant_id"`
+	PriceListID uint         `gorm:"not null;uniqueIndex:idx_price_list_items_list_item" json:"price_list_id"`
+	ItemID      uint         `gorm:"not null;uniqueIndex:idx_price_list_items_list_item;index" json:"item_id"`
+	UnitPrice   domain.Money `gorm:"type:decimal(12,2);not null" json:"unit_price"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+
+	Item *Item `gorm:"foreignKey:ItemID" json:"item,omitempty"`
+}
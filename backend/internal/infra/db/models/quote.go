@@ -0,0 +1,49 @@
+package models
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Quote struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	TenantID    uint           `gorm:"not null;default:1;uniqueIndex:idx_quotes_tenant_number" json:"tenant_id"`
+	QuoteNumber string         `gorm:"type:varchar(50);uniqueIndex:idx_quotes_tenant_number;not null" json:"quote_number"`
+	CustomerID  uint           `gorm:"index;not null" json:"customer_id"`
+	IssueDate   time.Time      `json:"issue_date"`
+	ExpiryDate  time.Time      `json:"expiry_date"`
+	Subject     string         `gorm:"type:varchar(255)" json:"subject"`
+	TotalItems  int            `json:"total_items"`
+	Subtotal    domain.Money   `gorm:"type:decimal(12,2)" json:"subtotal"`
+	Tax         domain.Money   `gorm:"type:decimal(12,2)" json:"tax"`
+	TotalAmount domain.Money   `gorm:"type:decimal(12,2)" json:"total_amount"`
+	Currency    string         `gorm:"type:char(3);not null;default:'IDR'" json:"currency"`
+	Status      string         `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	InvoiceID   *uint          `gorm:"index" json:"invoice_id"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Customer *Customer   `gorm:"foreignKey:CustomerID" json:"customer,omitempty"`
+	Items    []QuoteItem `gorm:"foreignKey:QuoteID;constraint:OnDelete:CASCADE;" json:"items,omitempty"`
+}
+
+type QuoteItem struct {
+	ID         uint         `gorm:"primaryKey;autoIncrement"`
+	TenantID   uint         `gorm:"not null;default:1;index" json:"tenant_id"`
+	QuoteID    uint         `gorm:"index;not null" json:"quote_id"`
+	ItemID     uint         `json:"item_id"`
+	Quantity   int          `json:"quantity"`
+	Unit       string       `gorm:"type:varchar(20)" json:"unit"`
+	Price      domain.Money `gorm:"type:decimal(12,2)" json:"price"`
+	TotalPrice domain.Money `gorm:"type:decimal(12,2)" json:"total_price"`
+	TaxRateID  *uint        `json:"tax_rate_id"`
+	TaxCode    string       `gorm:"type:varchar(20)" json:"tax_code"`
+	TaxRate    float64      `gorm:"type:decimal(5,2)" json:"tax_rate"`
+	TaxAmount  domain.Money `gorm:"type:decimal(12,2)" json:"tax_amount"`
+	CreatedAt  time.Time    `json:"created_at"`
+
+	Item *Item `gorm:"foreignKey:ItemID" json:"item,omitempty"`
+}
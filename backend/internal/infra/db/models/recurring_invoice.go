@@ -0,0 +1,45 @@
+package models
+
+import (
+	"invoice-system/internal/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RecurringInvoice struct {
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	TenantID        uint           `gorm:"not null;default:1;index" json:"tenant_id"`
+	CustomerID      uint           `gorm:"index;not null" json:"customer_id"`
+	Subject         string         `gorm:"type:varchar(255)" json:"subject"`
+	Currency        string         `gorm:"type:char(3)" json:"currency"`
+	Interval        string         `gorm:"column:billing_interval;type:varchar(20);not null" json:"interval"`
+	StartDate       time.Time      `json:"start_date"`
+	NextRunDate     time.Time      `gorm:"index" json:"next_run_date"`
+	LastRunDate     *time.Time     `json:"last_run_date"`
+	EndDate         *time.Time     `json:"end_date"`
+	MaxOccurrences  *int           `json:"max_occurrences"`
+	Occurrences     int            `gorm:"not null;default:0" json:"occurrences"`
+	PaymentTermDays int            `gorm:"not null;default:30" json:"payment_term_days"`
+	InvoiceStatus   string         `gorm:"type:varchar(20);not null;default:'draft'" json:"invoice_status"`
+	Status          string         `gorm:"type:varchar(20);not null;default:'active';index" json:"status"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Customer *Customer              `gorm:"foreignKey:CustomerID" json:"customer,omitempty"`
+	Items    []RecurringInvoiceItem `gorm:"foreignKey:RecurringInvoiceID;constraint:OnDelete:CASCADE;" json:"items,omitempty"`
+}
+
+type RecurringInvoiceItem struct {
+	ID                 uint          `gorm:"primaryKey;autoIncrement"`
+	TenantID           uint          `gorm:"not null;default:1;index" json:"tenant_id"`
+	RecurringInvoiceID uint          `gorm:"index;not null" json:"recurring_invoice_id"`
+	ItemID             uint          `json:"item_id"`
+	Quantity           int           `json:"quantity"`
+	Price              *domain.Money `gorm:"type:decimal(12,2)" json:"price"`
+	TaxRateID          *uint         `json:"tax_rate_id"`
+	CreatedAt          time.Time     `json:"created_at"`
+
+	Item *Item `gorm:"foreignKey:ItemID" json:"item,omitempty"`
+}
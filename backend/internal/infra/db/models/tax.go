@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TaxRate struct {
+	ID        uint           `gorm:"primaryKey" json:"id"`
+	TenantID  uint           `gorm:"not null;default:1;uniqueIndex:idx_tax_rates_tenant_code" json:"tenant_id"`
+	Code      string         `gorm:"type:varchar(20);uniqueIndex:idx_tax_rates_tenant_code;not null" json:"code"`
+	Name      string         `gorm:"type:varchar(255);not null" json:"name"`
+	Type      string         `gorm:"type:varchar(20);not null;default:'standard'" json:"type"`
+	Rate      float64        `gorm:"type:decimal(5,2);not null;default:0" json:"rate"`
+	IsDefault bool           `gorm:"default:false" json:"is_default"`
+	IsActive  bool           `gorm:"default:true" json:"is_active"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type User struct {
+	ID           uint           `gorm:"primaryKey" json:"id"`
+	TenantID     uint           `gorm:"not null;default:1;uniqueIndex:idx_users_tenant_email" json:"tenant_id"`
+	Name         string         `gorm:"type:varchar(255);not null" json:"name"`
+	Email        string         `gorm:"type:varchar(255);uniqueIndex:idx_users_tenant_email;not null" json:"email"`
+	PasswordHash string         `gorm:"type:varchar(255);not null" json:"-"`
+	Role         string         `gorm:"type:varchar(20);not null;default:'auditor'" json:"role"`
+	IsActive     bool           `gorm:"not null;default:true" json:"is_active"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+}
@@ -1,6 +1,7 @@
 package seeders
 
 import (
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/db/models"
 	"time"
 
@@ -22,6 +23,12 @@ func SeedInvoiceItems(db *gorm.DB) error {
 		return nil // Skip if no invoices or items exist
 	}
 
+	// seeded invoices are taxed at the default rate
+	var taxRate models.TaxRate
+	if err := db.Where("is_default = ?", true).First(&taxRate).Error; err != nil {
+		return err
+	}
+
 	// Create invoice items for each invoice
 	var invoiceItems []models.InvoiceItem
 
@@ -32,32 +39,27 @@ func SeedInvoiceItems(db *gorm.DB) error {
 			numItems = len(items)
 		}
 
-		// Calculate price per item based on subtotal
-		pricePerItem := invoice.Subtotal / float64(numItems)
+		// Split the subtotal over the items so that the lines add up exactly
+		prices := invoice.Subtotal.Allocate(numItems)
 
 		// Create items for this invoice
 		for j := 0; j < numItems; j++ {
 			itemIndex := (i + j) % len(items) // Rotate through available items
 
 			quantity := 1
-			if j == 0 && numItems == 1 {
-				// If only one item, use full subtotal
-				pricePerItem = invoice.Subtotal
-			} else if j == numItems-1 {
-				// For last item, adjust price to match exact subtotal
-				remainingPrice := invoice.Subtotal
-				for k := 0; k < j; k++ {
-					remainingPrice -= pricePerItem
-				}
-				pricePerItem = remainingPrice
-			}
+			totalPrice := prices[j].Mul(quantity)
 
 			invoiceItem := models.InvoiceItem{
 				InvoiceID:  invoice.ID,
 				ItemID:     items[itemIndex].ID,
 				Quantity:   quantity,
-				Price:      pricePerItem,
-				TotalPrice: pricePerItem * float64(quantity),
+				Unit:       items[itemIndex].Unit,
+				Price:      prices[j],
+				TotalPrice: totalPrice,
+				TaxRateID:  &taxRate.ID,
+				TaxCode:    taxRate.Code,
+				TaxRate:    taxRate.Rate,
+				TaxAmount:  totalPrice.Percent(taxRate.Rate, domain.RoundHalfUp),
 				CreatedAt:  time.Now(),
 			}
 
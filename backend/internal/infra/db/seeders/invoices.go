@@ -1,6 +1,7 @@
 package seeders
 
 import (
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/db/models"
 	"time"
 
@@ -27,9 +28,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Website Development Project",
 			CustomerID:    customers[0].ID,
 			TotalItems:    2,
-			Subtotal:      4500000,
-			Tax:           450000,
-			TotalAmount:   4950000,
+			Subtotal:      domain.NewMoney(4500000),
+			Tax:           domain.NewMoney(450000),
+			TotalAmount:   domain.NewMoney(4950000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -30),
 			UpdatedAt:     now.AddDate(0, 0, -15),
@@ -41,9 +42,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Mobile App Development",
 			CustomerID:    customers[0].ID,
 			TotalItems:    3,
-			Subtotal:      7500000,
-			Tax:           750000,
-			TotalAmount:   8250000,
+			Subtotal:      domain.NewMoney(7500000),
+			Tax:           domain.NewMoney(750000),
+			TotalAmount:   domain.NewMoney(8250000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -28),
 			UpdatedAt:     now.AddDate(0, 0, -13),
@@ -55,10 +56,10 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "E-commerce Platform Setup",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    4,
-			Subtotal:      12000000,
-			Tax:           1200000,
-			TotalAmount:   13200000,
-			Status:        "unpaid",
+			Subtotal:      domain.NewMoney(12000000),
+			Tax:           domain.NewMoney(1200000),
+			TotalAmount:   domain.NewMoney(13200000),
+			Status:        "issued",
 			CreatedAt:     now.AddDate(0, 0, -25),
 			UpdatedAt:     now.AddDate(0, 0, -25),
 		},
@@ -69,9 +70,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Database Migration Services",
 			CustomerID:    customers[0].ID,
 			TotalItems:    1,
-			Subtotal:      3000000,
-			Tax:           300000,
-			TotalAmount:   3300000,
+			Subtotal:      domain.NewMoney(3000000),
+			Tax:           domain.NewMoney(300000),
+			TotalAmount:   domain.NewMoney(3300000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -22),
 			UpdatedAt:     now.AddDate(0, 0, -7),
@@ -83,10 +84,10 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "API Development & Integration",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    2,
-			Subtotal:      5500000,
-			Tax:           550000,
-			TotalAmount:   6050000,
-			Status:        "unpaid",
+			Subtotal:      domain.NewMoney(5500000),
+			Tax:           domain.NewMoney(550000),
+			TotalAmount:   domain.NewMoney(6050000),
+			Status:        "issued",
 			CreatedAt:     now.AddDate(0, 0, -20),
 			UpdatedAt:     now.AddDate(0, 0, -20),
 		},
@@ -97,9 +98,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "UI/UX Design Services",
 			CustomerID:    customers[0].ID,
 			TotalItems:    3,
-			Subtotal:      4200000,
-			Tax:           420000,
-			TotalAmount:   4620000,
+			Subtotal:      domain.NewMoney(4200000),
+			Tax:           domain.NewMoney(420000),
+			TotalAmount:   domain.NewMoney(4620000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -18),
 			UpdatedAt:     now.AddDate(0, 0, -3),
@@ -111,10 +112,10 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Cloud Infrastructure Setup",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    2,
-			Subtotal:      6800000,
-			Tax:           680000,
-			TotalAmount:   7480000,
-			Status:        "unpaid",
+			Subtotal:      domain.NewMoney(6800000),
+			Tax:           domain.NewMoney(680000),
+			TotalAmount:   domain.NewMoney(7480000),
+			Status:        "issued",
 			CreatedAt:     now.AddDate(0, 0, -15),
 			UpdatedAt:     now.AddDate(0, 0, -15),
 		},
@@ -125,9 +126,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "SEO Optimization Package",
 			CustomerID:    customers[0].ID,
 			TotalItems:    1,
-			Subtotal:      2500000,
-			Tax:           250000,
-			TotalAmount:   2750000,
+			Subtotal:      domain.NewMoney(2500000),
+			Tax:           domain.NewMoney(250000),
+			TotalAmount:   domain.NewMoney(2750000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -12),
 			UpdatedAt:     now.AddDate(0, 0, -12),
@@ -139,9 +140,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Content Management System",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    3,
-			Subtotal:      8200000,
-			Tax:           820000,
-			TotalAmount:   9020000,
+			Subtotal:      domain.NewMoney(8200000),
+			Tax:           domain.NewMoney(820000),
+			TotalAmount:   domain.NewMoney(9020000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -10),
 			UpdatedAt:     now.AddDate(0, 0, -10),
@@ -153,9 +154,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Security Audit & Penetration Testing",
 			CustomerID:    customers[0].ID,
 			TotalItems:    2,
-			Subtotal:      9500000,
-			Tax:           950000,
-			TotalAmount:   10450000,
+			Subtotal:      domain.NewMoney(9500000),
+			Tax:           domain.NewMoney(950000),
+			TotalAmount:   domain.NewMoney(10450000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -8),
 			UpdatedAt:     now.AddDate(0, 0, -8),
@@ -167,9 +168,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Data Analytics Dashboard",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    4,
-			Subtotal:      11200000,
-			Tax:           1120000,
-			TotalAmount:   12320000,
+			Subtotal:      domain.NewMoney(11200000),
+			Tax:           domain.NewMoney(1120000),
+			TotalAmount:   domain.NewMoney(12320000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -6),
 			UpdatedAt:     now.AddDate(0, 0, -6),
@@ -181,9 +182,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "DevOps Implementation",
 			CustomerID:    customers[0].ID,
 			TotalItems:    2,
-			Subtotal:      6700000,
-			Tax:           670000,
-			TotalAmount:   7370000,
+			Subtotal:      domain.NewMoney(6700000),
+			Tax:           domain.NewMoney(670000),
+			TotalAmount:   domain.NewMoney(7370000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -4),
 			UpdatedAt:     now.AddDate(0, 0, -4),
@@ -195,9 +196,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Progressive Web App Development",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    3,
-			Subtotal:      8900000,
-			Tax:           890000,
-			TotalAmount:   9790000,
+			Subtotal:      domain.NewMoney(8900000),
+			Tax:           domain.NewMoney(890000),
+			TotalAmount:   domain.NewMoney(9790000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -2),
 			UpdatedAt:     now.AddDate(0, 0, -2),
@@ -209,9 +210,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Machine Learning Model Development",
 			CustomerID:    customers[0].ID,
 			TotalItems:    2,
-			Subtotal:      15000000,
-			Tax:           1500000,
-			TotalAmount:   16500000,
+			Subtotal:      domain.NewMoney(15000000),
+			Tax:           domain.NewMoney(1500000),
+			TotalAmount:   domain.NewMoney(16500000),
 			Status:        "paid",
 			CreatedAt:     now,
 			UpdatedAt:     now,
@@ -223,9 +224,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Legacy System Modernization",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    5,
-			Subtotal:      22000000,
-			Tax:           2200000,
-			TotalAmount:   24200000,
+			Subtotal:      domain.NewMoney(22000000),
+			Tax:           domain.NewMoney(2200000),
+			TotalAmount:   domain.NewMoney(24200000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -35),
 			UpdatedAt:     now.AddDate(0, 0, -20),
@@ -237,9 +238,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Blockchain Integration Services",
 			CustomerID:    customers[0].ID,
 			TotalItems:    3,
-			Subtotal:      18500000,
-			Tax:           1850000,
-			TotalAmount:   20350000,
+			Subtotal:      domain.NewMoney(18500000),
+			Tax:           domain.NewMoney(1850000),
+			TotalAmount:   domain.NewMoney(20350000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -32),
 			UpdatedAt:     now.AddDate(0, 0, -17),
@@ -251,10 +252,10 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "IoT Platform Development",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    4,
-			Subtotal:      13800000,
-			Tax:           1380000,
-			TotalAmount:   15180000,
-			Status:        "unpaid",
+			Subtotal:      domain.NewMoney(13800000),
+			Tax:           domain.NewMoney(1380000),
+			TotalAmount:   domain.NewMoney(15180000),
+			Status:        "issued",
 			CreatedAt:     now.AddDate(0, 0, -29),
 			UpdatedAt:     now.AddDate(0, 0, -29),
 		},
@@ -265,9 +266,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Microservices Architecture",
 			CustomerID:    customers[0].ID,
 			TotalItems:    3,
-			Subtotal:      10500000,
-			Tax:           1050000,
-			TotalAmount:   11550000,
+			Subtotal:      domain.NewMoney(10500000),
+			Tax:           domain.NewMoney(1050000),
+			TotalAmount:   domain.NewMoney(11550000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -26),
 			UpdatedAt:     now.AddDate(0, 0, -11),
@@ -279,10 +280,10 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Performance Optimization",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    2,
-			Subtotal:      4800000,
-			Tax:           480000,
-			TotalAmount:   5280000,
-			Status:        "unpaid",
+			Subtotal:      domain.NewMoney(4800000),
+			Tax:           domain.NewMoney(480000),
+			TotalAmount:   domain.NewMoney(5280000),
+			Status:        "issued",
 			CreatedAt:     now.AddDate(0, 0, -23),
 			UpdatedAt:     now.AddDate(0, 0, -23),
 		},
@@ -293,9 +294,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Quality Assurance Testing",
 			CustomerID:    customers[0].ID,
 			TotalItems:    2,
-			Subtotal:      3600000,
-			Tax:           360000,
-			TotalAmount:   3960000,
+			Subtotal:      domain.NewMoney(3600000),
+			Tax:           domain.NewMoney(360000),
+			TotalAmount:   domain.NewMoney(3960000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -19),
 			UpdatedAt:     now.AddDate(0, 0, -4),
@@ -307,10 +308,10 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Digital Marketing Automation",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    3,
-			Subtotal:      7200000,
-			Tax:           720000,
-			TotalAmount:   7920000,
-			Status:        "unpaid",
+			Subtotal:      domain.NewMoney(7200000),
+			Tax:           domain.NewMoney(720000),
+			TotalAmount:   domain.NewMoney(7920000),
+			Status:        "issued",
 			CreatedAt:     now.AddDate(0, 0, -16),
 			UpdatedAt:     now.AddDate(0, 0, -16),
 		},
@@ -321,9 +322,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Real-time Chat System",
 			CustomerID:    customers[0].ID,
 			TotalItems:    2,
-			Subtotal:      5800000,
-			Tax:           580000,
-			TotalAmount:   6380000,
+			Subtotal:      domain.NewMoney(5800000),
+			Tax:           domain.NewMoney(580000),
+			TotalAmount:   domain.NewMoney(6380000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -13),
 			UpdatedAt:     now.AddDate(0, 0, -13),
@@ -335,9 +336,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Video Streaming Platform",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    4,
-			Subtotal:      16800000,
-			Tax:           1680000,
-			TotalAmount:   18480000,
+			Subtotal:      domain.NewMoney(16800000),
+			Tax:           domain.NewMoney(1680000),
+			TotalAmount:   domain.NewMoney(18480000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -11),
 			UpdatedAt:     now.AddDate(0, 0, -11),
@@ -349,9 +350,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Document Management System",
 			CustomerID:    customers[0].ID,
 			TotalItems:    3,
-			Subtotal:      9200000,
-			Tax:           920000,
-			TotalAmount:   10120000,
+			Subtotal:      domain.NewMoney(9200000),
+			Tax:           domain.NewMoney(920000),
+			TotalAmount:   domain.NewMoney(10120000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -9),
 			UpdatedAt:     now.AddDate(0, 0, -9),
@@ -363,9 +364,9 @@ func SeedInvoices(db *gorm.DB) error {
 			Subject:       "Payment Gateway Integration",
 			CustomerID:    customers[len(customers)-1].ID,
 			TotalItems:    2,
-			Subtotal:      4400000,
-			Tax:           440000,
-			TotalAmount:   4840000,
+			Subtotal:      domain.NewMoney(4400000),
+			Tax:           domain.NewMoney(440000),
+			TotalAmount:   domain.NewMoney(4840000),
 			Status:        "paid",
 			CreatedAt:     now.AddDate(0, 0, -7),
 			UpdatedAt:     now.AddDate(0, 0, -7),
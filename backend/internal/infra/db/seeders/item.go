@@ -1,6 +1,7 @@
 package seeders
 
 import (
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/db/models"
 	"time"
 
@@ -10,140 +11,200 @@ import (
 func SeedItems(db *gorm.DB) error {
 	items := []models.Item{
 		{
+			SKU:       sku("SVC-WEB-001"),
 			Name:      "Website Development",
+			UnitPrice: domain.MustParseMoney("15000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-MOB-001"),
 			Name:      "Mobile App Development",
+			UnitPrice: domain.MustParseMoney("25000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-DSN-001"),
 			Name:      "Logo Design",
+			UnitPrice: domain.MustParseMoney("2500000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-DSN-002"),
 			Name:      "UI/UX Design",
+			UnitPrice: domain.MustParseMoney("7500000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-DBA-001"),
 			Name:      "Database Design",
+			UnitPrice: domain.MustParseMoney("5000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-API-001"),
 			Name:      "API Development",
+			UnitPrice: domain.MustParseMoney("10000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-CON-001"),
 			Name:      "Consultation Hours",
+			UnitPrice: domain.MustParseMoney("500000"),
+			Unit:      "hour",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-PMO-001"),
 			Name:      "Project Management",
+			UnitPrice: domain.MustParseMoney("750000"),
+			Unit:      "day",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-QA-001"),
 			Name:      "Quality Assurance Testing",
+			UnitPrice: domain.MustParseMoney("600000"),
+			Unit:      "day",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-OPS-001"),
 			Name:      "DevOps Setup",
+			UnitPrice: domain.MustParseMoney("8000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-OPS-002"),
 			Name:      "Cloud Migration",
+			UnitPrice: domain.MustParseMoney("12000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-SEC-001"),
 			Name:      "Security Audit",
+			UnitPrice: domain.MustParseMoney("9000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-PRF-001"),
 			Name:      "Performance Optimization",
+			UnitPrice: domain.MustParseMoney("4000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-REV-001"),
 			Name:      "Code Review",
+			UnitPrice: domain.MustParseMoney("400000"),
+			Unit:      "hour",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("SVC-DOC-001"),
 			Name:      "Technical Documentation",
+			UnitPrice: domain.MustParseMoney("3000000"),
+			Unit:      "project",
 			Type:      "Service",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("PRD-LIC-001"),
 			Name:      "Software License",
+			UnitPrice: domain.MustParseMoney("1200000"),
+			Unit:      "license",
 			Type:      "Product",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("PRD-HST-001"),
 			Name:      "Server Hosting",
+			UnitPrice: domain.MustParseMoney("350000"),
+			Unit:      "month",
 			Type:      "Product",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("PRD-SSL-001"),
 			Name:      "SSL Certificate",
+			UnitPrice: domain.MustParseMoney("250000"),
+			Unit:      "year",
 			Type:      "Product",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("PRD-DOM-001"),
 			Name:      "Domain Registration",
+			UnitPrice: domain.MustParseMoney("200000"),
+			Unit:      "year",
 			Type:      "Product",
 			IsActive:  true,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		},
 		{
+			SKU:       sku("PRD-BAK-001"),
 			Name:      "Backup Storage",
+			UnitPrice: domain.MustParseMoney("150000"),
+			Unit:      "month",
 			Type:      "Product",
 			IsActive:  true,
 			CreatedAt: time.Now(),
@@ -167,3 +228,7 @@ func SeedItems(db *gorm.DB) error {
 
 	return nil
 }
+
+func sku(code string) *string {
+	return &code
+}
@@ -0,0 +1,31 @@
+package seeders
+
+import (
+	"invoice-system/internal/domain"
+	"invoice-system/internal/infra/db/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SeedOrganizations creates the default organization, which owns everything
+// that was stored before there were organizations.
+func SeedOrganizations(db *gorm.DB) error {
+	org := models.Organization{
+		ID:        domain.DefaultOrganizationID,
+		Name:      "Default",
+		Slug:      "default",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	var existing models.Organization
+	if err := db.Where("id = ?", org.ID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return db.Create(&org).Error
+		}
+		return err
+	}
+
+	return nil
+}
@@ -9,6 +9,18 @@ import (
 func SeedAll(db *gorm.DB) {
 	log.Println("🌱 Starting database seeding...")
 
+	log.Println("🏢 Seeding organizations...")
+	if err := SeedOrganizations(db); err != nil {
+		log.Fatalf("Failed seeding organizations: %v", err)
+	}
+	log.Println("✅ Organizations seeded successfully")
+
+	log.Println("💰 Seeding tax rates...")
+	if err := SeedTaxRates(db); err != nil {
+		log.Fatalf("Failed seeding tax rates: %v", err)
+	}
+	log.Println("✅ Tax rates seeded successfully")
+
 	log.Println("📝 Seeding customers...")
 	if err := SeedCustomers(db); err != nil {
 		log.Fatalf("Failed seeding customers: %v", err)
@@ -0,0 +1,65 @@
+package seeders
+
+import (
+	"invoice-system/internal/infra/db/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func SeedTaxRates(db *gorm.DB) error {
+	rates := []models.TaxRate{
+		{
+			Code:      "VAT10",
+			Name:      "PPN 10%",
+			Type:      "standard",
+			Rate:      10,
+			IsDefault: true,
+			IsActive:  true,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			Code:      "VAT5",
+			Name:      "PPN Reduced 5%",
+			Type:      "reduced",
+			Rate:      5,
+			IsActive:  true,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			Code:      "VAT0",
+			Name:      "Zero Rated",
+			Type:      "zero",
+			Rate:      0,
+			IsActive:  true,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			Code:      "EXEMPT",
+			Name:      "Exempt",
+			Type:      "exempt",
+			Rate:      0,
+			IsActive:  true,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	for _, r := range rates {
+		var existing models.TaxRate
+		if err := db.Where("code = ?", r.Code).First(&existing).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				if err := db.Create(&r).Error; err != nil {
+					return err
+				}
+			} else {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"invoice-system/internal/domain"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// Tenancy is a GORM plugin keeping organizations apart. Every statement on a
+// model with a TenantID runs in the scope of the tenant of its context (see
+// domain.ContextWithTenant): queries, counts, updates and deletes only reach
+// that tenant's rows, and records created in it are stamped with it. A
+// context without a tenant, such as a background job's, is not scoped.
+type Tenancy struct{}
+
+// Name implements gorm.Plugin.
+func (Tenancy) Name() string {
+	return "tenancy"
+}
+
+// Initialize implements gorm.Plugin.
+func (Tenancy) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+
+	if err := callbacks.Create().Before("gorm:create").Register("tenancy:create", stampTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Query().Before("gorm:query").Register("tenancy:query", scopeToTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register("tenancy:row", scopeToTenant); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("tenancy:update", scopeWriteToTenant); err != nil {
+		return err
+	}
+	return callbacks.Delete().Before("gorm:delete").Register("tenancy:delete", scopeWriteToTenant)
+}
+
+// tenantField is the tenant column of the statement's model and the tenant
+// of its context, if the statement is to be scoped at all.
+func tenantField(db *gorm.DB) (*schema.Field, uint, bool) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return nil, 0, false
+	}
+
+	field := db.Statement.Schema.LookUpField("TenantID")
+	if field == nil {
+		return nil, 0, false
+	}
+
+	tenantID, ok := domain.TenantFromContext(db.Statement.Context)
+	return field, tenantID, ok
+}
+
+func stampTenant(db *gorm.DB) {
+	field, tenantID, ok := tenantField(db)
+	if !ok {
+		return
+	}
+
+	rv := db.Statement.ReflectValue
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			db.AddError(field.Set(db.Statement.Context, reflect.Indirect(rv.Index(i)), tenantID))
+		}
+	case reflect.Struct:
+		db.AddError(field.Set(db.Statement.Context, rv, tenantID))
+	}
+}
+
+func scopeToTenant(db *gorm.DB) {
+	if field, tenantID, ok := tenantField(db); ok {
+		whereTenant(db, field, tenantID)
+	}
+}
+
+// scopeWriteToTenant scopes an update or delete that names the rows it
+// touches. One that does not is left for GORM to refuse, rather than have
+// the tenant condition turn it into a write over the whole tenant.
+func scopeWriteToTenant(db *gorm.DB) {
+	field, tenantID, ok := tenantField(db)
+	if !ok {
+		return
+	}
+
+	if _, ok := db.Statement.Clauses["WHERE"]; ok || db.AllowGlobalUpdate || hasPrimaryKey(db) {
+		whereTenant(db, field, tenantID)
+	}
+}
+
+func whereTenant(db *gorm.DB, field *schema.Field, tenantID uint) {
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: tenantID},
+	}})
+}
+
+func hasPrimaryKey(db *gorm.DB) bool {
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	rv := db.Statement.ReflectValue
+	if field == nil || rv.Kind() != reflect.Struct {
+		return rv.Kind() == reflect.Slice && rv.Len() > 0
+	}
+
+	_, zero := field.ValueOf(db.Statement.Context, rv)
+	return !zero
+}
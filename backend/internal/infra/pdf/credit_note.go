@@ -0,0 +1,36 @@
+package pdf
+
+import (
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+)
+
+func NewCreditNoteRenderer(company Company) services.CreditNoteRenderer {
+	return &documentRenderer{company: company}
+}
+
+// RenderCreditNote implements services.CreditNoteRenderer.
+func (r *documentRenderer) RenderCreditNote(creditNote domain.CreditNote) ([]byte, error) {
+	doc, tr := r.newDocument("Credit Note " + creditNote.CreditNoteNumber)
+
+	meta := [][2]string{
+		{"Credit Note No", creditNote.CreditNoteNumber},
+		{"Issue Date", creditNote.IssueDate.Format(dateLayout)},
+	}
+	if creditNote.Invoice != nil {
+		meta = append(meta, [2]string{"Invoice No", creditNote.Invoice.InvoiceNumber})
+	}
+	meta = append(meta, [2]string{"Status", formatStatus(creditNote.Status)})
+
+	r.writeHeader(doc, tr, "CREDIT NOTE", meta)
+	writeCustomer(doc, tr, "CREDIT TO", creditNote.Customer, "Reason", creditNote.Reason)
+
+	items := make([]domain.InvoiceItem, len(creditNote.Items))
+	for i, it := range creditNote.Items {
+		items[i] = it.InvoiceItem()
+	}
+	writeItems(doc, tr, items)
+	writeTotals(doc, creditNote.Subtotal, creditNote.TaxBreakdown(), creditNote.Currency, creditNote.TotalAmount)
+
+	return output(doc, "credit note")
+}
@@ -0,0 +1,303 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Company is the issuer printed in the document header.
+type Company struct {
+	Name    string
+	Address string
+	Email   string
+	Phone   string
+	TaxID   string
+}
+
+// documentRenderer lays invoices and credit notes out on A4 using the PDF
+// core fonts, so no font files or external binaries are needed.
+type documentRenderer struct {
+	company Company
+}
+
+func NewInvoiceRenderer(company Company) services.InvoiceRenderer {
+	return &documentRenderer{company: company}
+}
+
+const (
+	pageMargin = 15.0
+	lineHeight = 5.0
+	dateLayout = "02 Jan 2006"
+)
+
+// column widths of the line item table, 180mm in total
+var itemColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"No", 10, "C"},
+	{"Description", 65, "L"},
+	{"Qty", 20, "R"},
+	{"Unit Price", 30, "R"},
+	{"Tax", 20, "R"},
+	{"Amount", 35, "R"},
+}
+
+// RenderInvoice implements services.InvoiceRenderer.
+func (r *documentRenderer) RenderInvoice(invoice domain.Invoice) ([]byte, error) {
+	doc, tr := r.newDocument("Invoice " + invoice.InvoiceNumber)
+
+	r.writeHeader(doc, tr, "INVOICE", [][2]string{
+		{"Invoice No", invoice.InvoiceNumber},
+		{"Issue Date", invoice.IssueDate.Format(dateLayout)},
+		{"Due Date", invoice.DueDate.Format(dateLayout)},
+		{"Status", formatStatus(invoice.Status)},
+	})
+	writeCustomer(doc, tr, "BILL TO", invoice.Customer, "Subject", invoice.Subject)
+	writeItems(doc, tr, invoice.Items)
+	writeInvoiceTotals(doc, invoice)
+
+	return output(doc, "invoice")
+}
+
+// newDocument starts an A4 document with its first page added. The returned
+// function translates UTF-8 text for the core fonts, which are cp1252.
+func (r *documentRenderer) newDocument(title string) (*gofpdf.Fpdf, func(string) string) {
+	doc := gofpdf.New("P", "mm", "A4", "")
+	doc.SetMargins(pageMargin, pageMargin, pageMargin)
+	doc.SetAutoPageBreak(true, pageMargin)
+	doc.SetTitle(title, true)
+	doc.SetCreator(r.company.Name, true)
+
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	doc.AddPage()
+
+	return doc, tr
+}
+
+func output(doc *gofpdf.Fpdf, kind string) ([]byte, error) {
+	if err := doc.Error(); err != nil {
+		return nil, fmt.Errorf("failed to render %s pdf: %w", kind, err)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write %s pdf: %w", kind, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeHeader prints the company on the left and the document title with its
+// meta rows on the right.
+func (r *documentRenderer) writeHeader(doc *gofpdf.Fpdf, tr func(string) string, title string, meta [][2]string) {
+	top := doc.GetY()
+
+	doc.SetFont("Helvetica", "B", 14)
+	doc.CellFormat(100, 7, tr(r.company.Name), "", 2, "L", false, 0, "")
+
+	doc.SetFont("Helvetica", "", 9)
+	for _, line := range nonEmpty(r.company.Address, r.company.Email, r.company.Phone) {
+		doc.CellFormat(100, lineHeight, tr(line), "", 2, "L", false, 0, "")
+	}
+	if r.company.TaxID != "" {
+		doc.CellFormat(100, lineHeight, tr("Tax ID: "+r.company.TaxID), "", 2, "L", false, 0, "")
+	}
+	companyBottom := doc.GetY()
+
+	doc.SetXY(pageMargin+100, top)
+	doc.SetFont("Helvetica", "B", 20)
+	doc.CellFormat(80, 9, title, "", 2, "R", false, 0, "")
+
+	doc.SetFont("Helvetica", "", 9)
+	for _, m := range meta {
+		doc.SetX(pageMargin + 100)
+		doc.CellFormat(35, lineHeight, m[0], "", 0, "R", false, 0, "")
+		doc.CellFormat(45, lineHeight, tr(m[1]), "", 1, "R", false, 0, "")
+	}
+
+	if doc.GetY() < companyBottom {
+		doc.SetY(companyBottom)
+	}
+	doc.Ln(4)
+	doc.Line(pageMargin, doc.GetY(), 210-pageMargin, doc.GetY())
+	doc.Ln(4)
+}
+
+// writeCustomer prints the customer block under heading, followed by an
+// optional labelled note such as the invoice subject.
+func writeCustomer(doc *gofpdf.Fpdf, tr func(string) string, heading string, c *domain.Customer, noteLabel, note string) {
+	doc.SetFont("Helvetica", "B", 9)
+	doc.CellFormat(0, lineHeight, heading, "", 1, "L", false, 0, "")
+
+	if c != nil {
+		doc.SetFont("Helvetica", "B", 10)
+		doc.CellFormat(0, 6, tr(c.Name), "", 1, "L", false, 0, "")
+
+		doc.SetFont("Helvetica", "", 9)
+		if c.Address != "" {
+			doc.MultiCell(100, lineHeight, tr(c.Address), "", "L", false)
+		}
+		for _, line := range nonEmpty(c.Email, c.Phone) {
+			doc.CellFormat(0, lineHeight, tr(line), "", 1, "L", false, 0, "")
+		}
+	}
+
+	if note != "" {
+		doc.Ln(3)
+		doc.SetFont("Helvetica", "B", 9)
+		doc.CellFormat(20, lineHeight, noteLabel, "", 0, "L", false, 0, "")
+		doc.SetFont("Helvetica", "", 9)
+		doc.MultiCell(0, lineHeight, tr(note), "", "L", false)
+	}
+
+	doc.Ln(5)
+}
+
+func writeItems(doc *gofpdf.Fpdf, tr func(string) string, items []domain.InvoiceItem) {
+	header := func() {
+		doc.SetFont("Helvetica", "B", 9)
+		doc.SetFillColor(235, 235, 235)
+		for _, col := range itemColumns {
+			doc.CellFormat(col.width, 7, col.title, "TB", 0, col.align, true, 0, "")
+		}
+		doc.Ln(-1)
+		doc.SetFont("Helvetica", "", 9)
+	}
+
+	header()
+
+	_, pageHeight := doc.GetPageSize()
+	for i, item := range items {
+		if doc.GetY()+6 > pageHeight-pageMargin {
+			doc.AddPage()
+			header()
+		}
+
+		tax := item.TaxCode
+		if tax == "" {
+			tax = "-"
+		}
+
+		qty := fmt.Sprintf("%d", item.Quantity)
+		if item.Unit != "" {
+			qty += " " + item.Unit
+		}
+
+		values := []string{
+			fmt.Sprintf("%d", i+1),
+			tr(item.ItemName),
+			tr(qty),
+			formatMoney(item.Price),
+			tr(tax),
+			formatMoney(item.TotalPrice),
+		}
+		for c, col := range itemColumns {
+			doc.CellFormat(col.width, 6, fitText(doc, values[c], col.width-2), "B", 0, col.align, false, 0, "")
+		}
+		doc.Ln(-1)
+	}
+
+	doc.Ln(4)
+}
+
+func writeInvoiceTotals(doc *gofpdf.Fpdf, invoice domain.Invoice) {
+	writeTotals(doc, invoice.Subtotal, invoice.TaxBreakdown(), invoice.Currency, invoice.TotalAmount)
+
+	if !invoice.AmountPaid.IsZero() || !invoice.AmountCredited.IsZero() {
+		doc.SetFont("Helvetica", "", 9)
+		if !invoice.AmountPaid.IsZero() {
+			totalsRow(doc, "Amount Paid", formatMoney(invoice.AmountPaid))
+		}
+		if !invoice.AmountCredited.IsZero() {
+			totalsRow(doc, "Amount Credited", formatMoney(invoice.AmountCredited))
+		}
+		doc.SetFont("Helvetica", "B", 10)
+		totalsRow(doc, "Balance Due", formatMoney(invoice.BalanceDue()))
+	}
+
+	doc.Ln(6)
+	doc.SetFont("Helvetica", "", 9)
+	doc.CellFormat(0, lineHeight, "Please pay by "+invoice.DueDate.Format(dateLayout)+".", "", 1, "L", false, 0, "")
+}
+
+// writeTotals prints the subtotal, one row per tax rate and the total.
+func writeTotals(doc *gofpdf.Fpdf, subtotal domain.Money, breakdown []domain.TaxBreakdown, currency string, total domain.Money) {
+	rows := [][2]string{{"Subtotal", formatMoney(subtotal)}}
+	for _, b := range breakdown {
+		rows = append(rows, [2]string{fmt.Sprintf("Tax %s (%s%%)", b.Code, trimRate(b.Rate)), formatMoney(b.TaxAmount)})
+	}
+
+	doc.SetFont("Helvetica", "", 9)
+	for _, row := range rows {
+		totalsRow(doc, row[0], row[1])
+	}
+
+	doc.SetFont("Helvetica", "B", 10)
+	totalsRow(doc, "Total ("+currency+")", formatMoney(total))
+}
+
+func totalsRow(doc *gofpdf.Fpdf, label, value string) {
+	doc.SetX(pageMargin + 100)
+	doc.CellFormat(45, 6, label, "", 0, "R", false, 0, "")
+	doc.CellFormat(35, 6, value, "", 1, "R", false, 0, "")
+}
+
+// formatMoney writes an amount with thousands separators, e.g. 1,250,000.00.
+func formatMoney(m domain.Money) string {
+	s := m.String()
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	for i, d := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+
+	return sign + b.String() + "." + frac
+}
+
+func formatStatus(status string) string {
+	return strings.ToUpper(strings.ReplaceAll(status, "_", " "))
+}
+
+func trimRate(rate float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", rate), "0"), ".")
+}
+
+// fitText shortens text that would overflow a table cell.
+func fitText(doc *gofpdf.Fpdf, text string, width float64) string {
+	if doc.GetStringWidth(text) <= width {
+		return text
+	}
+
+	for len(text) > 0 && doc.GetStringWidth(text+"...") > width {
+		text = text[:len(text)-1]
+	}
+
+	return text + "..."
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,115 @@
+package pdf
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"invoice-system/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoiceRenderer_RenderInvoice(t *testing.T) {
+	renderer := NewInvoiceRenderer(Company{Name: "PT Contoh", Address: "Jakarta", TaxID: "01.234"})
+
+	items := make([]domain.InvoiceItem, 60)
+	for i := range items {
+		items[i] = domain.InvoiceItem{
+			ItemName:   "Konsultasi — a fairly long description that will not fit in the column",
+			Quantity:   2,
+			Price:      domain.NewMoney(1500000),
+			TotalPrice: domain.NewMoney(3000000),
+			TaxCode:    "VAT10",
+			TaxRate:    10,
+			TaxAmount:  domain.NewMoney(300000),
+		}
+	}
+
+	invoice := domain.Invoice{
+		InvoiceNumber: "INV/2025/0001",
+		IssueDate:     time.Date(2025, 10, 30, 0, 0, 0, 0, time.UTC),
+		DueDate:       time.Date(2025, 11, 15, 0, 0, 0, 0, time.UTC),
+		Subject:       "Website development",
+		Currency:      "IDR",
+		Status:        domain.InvoiceStatusPartiallyPaid,
+		Customer:      &domain.Customer{Name: "Budi Santoso", Address: "Jl. Merdeka 1\nBandung", Email: "budi@example.com"},
+		Items:         items,
+		AmountPaid:    domain.NewMoney(1000000),
+	}
+	invoice.CalculateTotals()
+
+	content, err := renderer.RenderInvoice(invoice)
+
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+	// 60 lines don't fit on one page
+	assert.GreaterOrEqual(t, bytes.Count(content, []byte("/Type /Page\n")), 2)
+}
+
+func TestFormatMoney(t *testing.T) {
+	tests := map[string]string{
+		"0":          "0.00",
+		"999.5":      "999.50",
+		"1250000":    "1,250,000.00",
+		"-1234567.8": "-1,234,567.80",
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, formatMoney(domain.MustParseMoney(in)), in)
+	}
+}
+
+func TestCreditNoteRenderer_RenderCreditNote(t *testing.T) {
+	renderer := NewCreditNoteRenderer(Company{Name: "PT Contoh"})
+
+	creditNote := domain.CreditNote{
+		CreditNoteNumber: "CN/2025/00001",
+		IssueDate:        time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+		Reason:           "Returned goods",
+		Currency:         "IDR",
+		Status:           domain.CreditNoteStatusIssued,
+		Invoice:          &domain.Invoice{InvoiceNumber: "INV/2025/10/00001"},
+		Customer:         &domain.Customer{Name: "Budi Santoso"},
+		Items: []domain.CreditNoteItem{{
+			ItemName:   "Konsultasi",
+			Quantity:   1,
+			Price:      domain.NewMoney(1500000),
+			TotalPrice: domain.NewMoney(1500000),
+			TaxCode:    "VAT10",
+			TaxRate:    10,
+			TaxAmount:  domain.NewMoney(150000),
+		}},
+	}
+	creditNote.CalculateTotals()
+
+	content, err := renderer.RenderCreditNote(creditNote)
+
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+}
+
+func TestStatementRenderer_RenderStatement(t *testing.T) {
+	renderer := NewStatementRenderer(Company{Name: "PT Contoh"})
+
+	statement := domain.Statement{
+		Customer:       domain.Customer{ID: 3, Name: "Budi Santoso", Email: "budi@example.com"},
+		Currency:       "IDR",
+		From:           time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC),
+		OpeningBalance: domain.NewMoney(500000),
+	}
+	for i := 0; i < 40; i++ {
+		statement.Entries = append(statement.Entries,
+			domain.StatementEntry{Type: domain.StatementEntryInvoice, ID: uint(i + 1), Date: statement.From.AddDate(0, 0, i%30), Reference: "INV/2025/10/00001", Description: "Konsultasi — monthly retainer", Debit: domain.NewMoney(1000000)},
+			domain.StatementEntry{Type: domain.StatementEntryPayment, ID: uint(i + 1), Date: statement.From.AddDate(0, 0, i%30), Description: "Payment by bank_transfer", InvoiceNumber: "INV/2025/10/00001", Credit: domain.NewMoney(750000)},
+		)
+	}
+	statement.Settle()
+
+	content, err := renderer.RenderStatement(statement)
+
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+	assert.GreaterOrEqual(t, bytes.Count(content, []byte("/Type /Page\n")), 2)
+}
@@ -0,0 +1,106 @@
+package pdf
+
+import (
+	"invoice-system/internal/applications/ports/services"
+	"invoice-system/internal/domain"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+func NewStatementRenderer(company Company) services.StatementRenderer {
+	return &documentRenderer{company: company}
+}
+
+// column widths of the statement table, 180mm in total
+var statementColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"Date", 22, "L"},
+	{"Reference", 32, "L"},
+	{"Description", 50, "L"},
+	{"Debit", 25, "R"},
+	{"Credit", 25, "R"},
+	{"Balance", 26, "R"},
+}
+
+// RenderStatement implements services.StatementRenderer.
+func (r *documentRenderer) RenderStatement(statement domain.Statement) ([]byte, error) {
+	doc, tr := r.newDocument("Statement " + statement.Customer.Name)
+
+	r.writeHeader(doc, tr, "STATEMENT", [][2]string{
+		{"From", statement.From.Format(dateLayout)},
+		{"To", statement.To.Format(dateLayout)},
+		{"Currency", statement.Currency},
+	})
+	writeCustomer(doc, tr, "STATEMENT FOR", &statement.Customer, "", "")
+	writeStatementEntries(doc, tr, statement)
+
+	doc.SetFont("Helvetica", "", 9)
+	totalsRow(doc, "Opening Balance", formatMoney(statement.OpeningBalance))
+	totalsRow(doc, "Invoiced", formatMoney(statement.TotalDebits()))
+	totalsRow(doc, "Paid and Credited", formatMoney(statement.TotalCredits()))
+	doc.SetFont("Helvetica", "B", 10)
+	totalsRow(doc, "Balance Due ("+statement.Currency+")", formatMoney(statement.ClosingBalance()))
+
+	return output(doc, "statement")
+}
+
+func writeStatementEntries(doc *gofpdf.Fpdf, tr func(string) string, statement domain.Statement) {
+	header := func() {
+		doc.SetFont("Helvetica", "B", 9)
+		doc.SetFillColor(235, 235, 235)
+		for _, col := range statementColumns {
+			doc.CellFormat(col.width, 7, col.title, "TB", 0, col.align, true, 0, "")
+		}
+		doc.Ln(-1)
+		doc.SetFont("Helvetica", "", 9)
+	}
+
+	row := func(values []string) {
+		for c, col := range statementColumns {
+			doc.CellFormat(col.width, 6, fitText(doc, values[c], col.width-2), "B", 0, col.align, false, 0, "")
+		}
+		doc.Ln(-1)
+	}
+
+	header()
+	row([]string{statement.From.Format(dateLayout), "", "Opening balance", "", "", formatMoney(statement.OpeningBalance)})
+
+	_, pageHeight := doc.GetPageSize()
+	for _, e := range statement.Entries {
+		if doc.GetY()+6 > pageHeight-pageMargin {
+			doc.AddPage()
+			header()
+		}
+
+		row([]string{
+			e.Date.Format(dateLayout),
+			tr(e.Reference),
+			tr(statementDescription(e)),
+			formatOptionalMoney(e.Debit),
+			formatOptionalMoney(e.Credit),
+			formatMoney(e.Balance),
+		})
+	}
+
+	doc.Ln(4)
+}
+
+// statementDescription adds the invoice that payments and credit notes
+// settle to their description.
+func statementDescription(e domain.StatementEntry) string {
+	if e.Type == domain.StatementEntryInvoice || e.InvoiceNumber == "" {
+		return e.Description
+	}
+
+	return e.Description + " (" + e.InvoiceNumber + ")"
+}
+
+func formatOptionalMoney(m domain.Money) string {
+	if m.IsZero() {
+		return ""
+	}
+	return formatMoney(m)
+}
@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"invoice-system/internal/infra/logger"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job is a unit of background work. Run receives the time of the pass and
+// must be safe to repeat: a pass that fails is simply tried again on the
+// next tick.
+type Job struct {
+	Name string
+	Run  func(now time.Time) error
+}
+
+// Scheduler runs its jobs one after another, once when started and then on
+// every tick of the interval, inside the server process.
+type Scheduler struct {
+	interval time.Duration
+	now      func() time.Time
+	jobs     []Job
+
+	mu      sync.Mutex
+	started bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func NewScheduler(interval time.Duration) *Scheduler {
+	return &Scheduler{interval: interval, now: time.Now}
+}
+
+// Register adds a job. Jobs must be registered before Start.
+func (s *Scheduler) Register(name string, run func(now time.Time) error) {
+	s.jobs = append(s.jobs, Job{Name: name, Run: run})
+}
+
+// Start runs the jobs in the background until Stop is called. The first
+// pass runs right away so work that fell due while the server was down is
+// picked up on start up.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	go s.loop()
+
+	logger.Info("Scheduler started", zap.Duration("interval", s.interval), zap.Int("jobs", len(s.jobs)))
+}
+
+// Stop waits for the running pass, if any, to finish and stops the
+// scheduler. It does nothing when the scheduler was never started.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return
+	}
+	s.started = false
+
+	close(s.stop)
+	<-s.done
+
+	logger.Info("Scheduler stopped")
+}
+
+func (s *Scheduler) loop() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.runJobs()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.runJobs()
+		}
+	}
+}
+
+func (s *Scheduler) runJobs() {
+	for _, job := range s.jobs {
+		select {
+		case <-s.stop:
+			return
+		default:
+		}
+
+		s.runJob(job)
+	}
+}
+
+// runJob runs one job, keeping a failing or panicking job from taking the
+// scheduler down with it.
+func (s *Scheduler) runJob(job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Scheduled job panicked", zap.String("job", job.Name), zap.Any("panic", r))
+		}
+	}()
+
+	if err := job.Run(s.now()); err != nil {
+		logger.Error("Scheduled job failed", zap.String("job", job.Name), zap.Error(err))
+	}
+}
@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_RunsOnStartAndOnEveryTick(t *testing.T) {
+	var runs atomic.Int32
+	s := NewScheduler(10 * time.Millisecond)
+	s.Register("count", func(time.Time) error {
+		runs.Add(1)
+		return nil
+	})
+
+	s.Start()
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+	s.Stop()
+
+	stopped := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load(), "no passes after Stop")
+}
+
+func TestScheduler_FailingJobDoesNotStopOthers(t *testing.T) {
+	var runs atomic.Int32
+	s := NewScheduler(time.Hour)
+	s.Register("fails", func(time.Time) error { return errors.New("boom") })
+	s.Register("panics", func(time.Time) error { panic("boom") })
+	s.Register("count", func(time.Time) error {
+		runs.Add(1)
+		return nil
+	})
+
+	s.Start()
+	assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, 5*time.Millisecond)
+	s.Stop()
+}
+
+func TestScheduler_StopWaitsForRunningPass(t *testing.T) {
+	started := make(chan struct{})
+	var finished atomic.Bool
+	s := NewScheduler(time.Hour)
+	s.Register("slow", func(time.Time) error {
+		close(started)
+		time.Sleep(20 * time.Millisecond)
+		finished.Store(true)
+		return nil
+	})
+
+	s.Start()
+	<-started
+	s.Stop()
+
+	assert.True(t, finished.Load())
+}
+
+func TestScheduler_StopWithoutStart(t *testing.T) {
+	s := NewScheduler(time.Hour)
+	assert.NotPanics(t, s.Stop)
+}
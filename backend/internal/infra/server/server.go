@@ -5,10 +5,16 @@ import (
 	"fmt"
 	"invoice-system/internal/applications/service"
 	"invoice-system/internal/config"
+	"invoice-system/internal/domain"
 	"invoice-system/internal/infra/adapter/http/handler"
+	"invoice-system/internal/infra/adapter/http/middleware"
 	"invoice-system/internal/infra/adapter/http/router"
 	"invoice-system/internal/infra/adapter/repository"
+	"invoice-system/internal/infra/auth"
 	"invoice-system/internal/infra/logger"
+	"invoice-system/internal/infra/pdf"
+	"invoice-system/internal/infra/scheduler"
+	"log"
 	"net/http"
 	"os"
 	"os/signal"
@@ -21,9 +27,10 @@ import (
 )
 
 type AppServer struct {
-	DB     *gorm.DB
-	Config *config.AppConfig
-	Gin    *gin.Engine
+	DB        *gorm.DB
+	Config    *config.AppConfig
+	Gin       *gin.Engine
+	Scheduler *scheduler.Scheduler
 }
 
 func InitServer(cf *config.AppConfig, db *gorm.DB) *AppServer {
@@ -33,7 +40,8 @@ func InitServer(cf *config.AppConfig, db *gorm.DB) *AppServer {
 	engine.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization")
+		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, If-Match, X-Tenant-ID")
+		c.Header("Access-Control-Expose-Headers", "ETag")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -43,28 +51,125 @@ func InitServer(cf *config.AppConfig, db *gorm.DB) *AppServer {
 		c.Next()
 	})
 
+	if err := cf.ValidateAuth(); err != nil {
+		log.Fatalf("invalid auth configuration: %v", err)
+	}
+
+	userRepo := repository.NewUserRepository(db)
+	authService := service.NewAuthService(userRepo, auth.NewJWTManager(cf.Secret, cf.Auth.AccessTokenTTL, cf.Auth.RefreshTokenTTL))
+	authHandler := handler.NewAuthHandler(authService)
+	userHandler := handler.NewUserHandler(service.NewUserService(userRepo))
+	auditHandler := handler.NewAuditHandler(service.NewAuditService(repository.NewAuditRepository(db)))
+	if cf.Auth.AdminEmail != "" {
+		if err := authService.BootstrapUser(context.Background(), cf.Auth.AdminName, cf.Auth.AdminEmail, cf.Auth.AdminPassword); err != nil {
+			log.Fatalf("failed to create the first user: %v", err)
+		}
+	}
+
 	customerRepo := repository.NewCustomerRepository(db)
-	customerService := service.NewCustomerService(customerRepo)
+	customerService := service.NewCustomerService(customerRepo, cf.Currency.Base)
 	customerHandler := handler.NewCustomerHandler(customerService)
 
-	invoiceRepo := repository.NewInvoiceRepository(db)
-	invoiceService := service.NewInvoiceService(invoiceRepo)
-	invoiceHandler := handler.NewInvoiceHandler(invoiceService)
+	taxRepo := repository.NewTaxRateRepository(db)
+	taxService := service.NewTaxService(taxRepo)
+	taxHandler := handler.NewTaxHandler(taxService)
 
 	itemRepo := repository.NewItemRepository(db)
-	itemService := service.NewItemService(itemRepo)
+	priceListRepo := repository.NewPriceListRepository(db)
+	itemService := service.NewItemService(itemRepo, priceListRepo, customerRepo)
 	itemHandler := handler.NewItemHandler(itemService)
 
+	priceListService := service.NewPriceListService(priceListRepo, itemRepo)
+	priceListHandler := handler.NewPriceListHandler(priceListService)
+
+	invoiceRepo := repository.NewInvoiceRepository(db, numberingScheme(domain.SeriesInvoice, cf.Numbering.Invoice))
+	invoiceService := service.NewInvoiceService(invoiceRepo, customerRepo, itemService, taxService, cf.Currency.Base)
+	invoiceHandler := handler.NewInvoiceHandler(invoiceService)
+
+	quoteRepo := repository.NewQuoteRepository(db, numberingScheme(domain.SeriesQuote, cf.Numbering.Quote))
+	quoteService := service.NewQuoteService(quoteRepo, customerRepo, itemService, taxService, invoiceService, cf.Currency.Base)
+	quoteHandler := handler.NewQuoteHandler(quoteService)
+
+	recurringInvoiceRepo := repository.NewRecurringInvoiceRepository(db)
+	recurringInvoiceService := service.NewRecurringInvoiceService(recurringInvoiceRepo, customerRepo, itemService, taxService, invoiceService, cf.Currency.Base)
+	recurringInvoiceHandler := handler.NewRecurringInvoiceHandler(recurringInvoiceService)
+
+	exchangeRateRepo := repository.NewExchangeRateRepository(db)
+	exchangeRateService := service.NewExchangeRateService(exchangeRateRepo, cf.Currency.Base)
+	exchangeRateHandler := handler.NewExchangeRateHandler(exchangeRateService)
+
+	reportRepo := repository.NewReportRepository(db)
+	reportService := service.NewReportService(reportRepo, exchangeRateService)
+	reportHandler := handler.NewReportHandler(reportService)
+
+	paymentRepo := repository.NewPaymentRepository(db)
+	paymentService := service.NewPaymentService(paymentRepo, invoiceRepo)
+	paymentHandler := handler.NewPaymentHandler(paymentService)
+
+	company := pdf.Company{
+		Name:    cf.Company.Name,
+		Address: cf.Company.Address,
+		Email:   cf.Company.Email,
+		Phone:   cf.Company.Phone,
+		TaxID:   cf.Company.TaxID,
+	}
+	invoiceRenderer := pdf.NewInvoiceRenderer(company)
+	invoicePDFService := service.NewInvoicePDFService(invoiceRepo, invoiceRenderer)
+	invoicePDFHandler := handler.NewInvoicePDFHandler(invoicePDFService)
+
+	creditNoteRepo := repository.NewCreditNoteRepository(db, numberingScheme(domain.SeriesCreditNote, cf.Numbering.CreditNote))
+	creditNoteService := service.NewCreditNoteService(creditNoteRepo, invoiceRepo, itemService, taxService, pdf.NewCreditNoteRenderer(company))
+	creditNoteHandler := handler.NewCreditNoteHandler(creditNoteService)
+
+	statementRepo := repository.NewStatementRepository(db)
+	statementService := service.NewStatementService(statementRepo, customerRepo, pdf.NewStatementRenderer(company), cf.Currency.Base)
+	statementHandler := handler.NewStatementHandler(statementService)
+
 	// Setup router
-	router.SetupRoutes(engine, customerHandler, invoiceHandler, itemHandler)
+	router.SetupRoutes(engine, customerHandler, invoiceHandler, itemHandler, taxHandler, exchangeRateHandler, reportHandler, paymentHandler, invoicePDFHandler, priceListHandler, creditNoteHandler, quoteHandler, recurringInvoiceHandler, statementHandler, authHandler, userHandler, auditHandler, middleware.Authenticate(authService))
+
+	// Background jobs
+	jobs := scheduler.NewScheduler(cf.Scheduler.Interval)
+	jobs.Register("recurring-invoices", func(now time.Time) error {
+		generated, err := recurringInvoiceService.GenerateDueInvoices(context.Background(), now)
+		if generated > 0 {
+			logger.Info("Generated recurring invoices", zap.Int("count", generated))
+		}
+		return err
+	})
+	jobs.Register("overdue-invoices", func(now time.Time) error {
+		flagged, err := invoiceService.MarkOverdueInvoices(context.Background(), now)
+		if flagged > 0 {
+			logger.Info("Flagged overdue invoices", zap.Int64("count", flagged))
+		}
+		return err
+	})
+	if cf.Scheduler.Enabled {
+		if cf.Scheduler.Interval <= 0 {
+			log.Fatalf("invalid scheduler interval: %v", cf.Scheduler.Interval)
+		}
+		jobs.Start()
+	}
 
 	return &AppServer{
-		DB:     db,
-		Config: cf,
-		Gin:    engine,
+		DB:        db,
+		Config:    cf,
+		Gin:       engine,
+		Scheduler: jobs,
 	}
 }
 
+// numberingScheme turns the configured scheme of a series into its domain
+// form. A scheme that could hand out duplicate numbers stops the start up.
+func numberingScheme(series string, cf config.NumberingSchemeConfig) domain.NumberingScheme {
+	scheme := domain.NumberingScheme{Series: series, Pattern: cf.Pattern, Reset: cf.Reset}
+	if err := scheme.Validate(); err != nil {
+		log.Fatalf("invalid %s numbering: %v", series, err)
+	}
+
+	return scheme
+}
+
 func StartServer(app *AppServer) *http.Server {
 	port := config.Config.Server.Port
 	addr := fmt.Sprintf(":%v", port)
@@ -0,0 +1,10 @@
+package utils
+
+import "time"
+
+// DateOnly returns midnight of t's calendar day in the local time zone, the
+// zone the database connection uses, so DATE columns round-trip unchanged.
+func DateOnly(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
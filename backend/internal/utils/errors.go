@@ -3,7 +3,59 @@ package utils
 import "errors"
 
 var (
-	ErrCustomerAlreadyExists = errors.New("customer already exists")
-	ErrInvoiceNotFound       = errors.New("invoice not found")
-	ErrItemAlreadyExists     = errors.New("item already exists")
+	ErrCustomerAlreadyExists     = errors.New("customer already exists")
+	ErrInvoiceNotFound           = errors.New("invoice not found")
+	ErrItemAlreadyExists         = errors.New("item already exists")
+	ErrPriceListNotFound         = errors.New("price list not found")
+	ErrPriceListAlreadyExists    = errors.New("price list already exists")
+	ErrInvalidPriceListWindow    = errors.New("price list must end on or after the day it starts")
+	ErrItemNotFound              = errors.New("item not found")
+	ErrItemPriceMissing          = errors.New("item has no price, the line must give one")
+	ErrTaxRateNotFound           = errors.New("tax rate not found")
+	ErrTaxRateAlreadyExists      = errors.New("tax rate already exists")
+	ErrDefaultTaxRateMissing     = errors.New("no default tax rate configured")
+	ErrCustomerNotFound          = errors.New("customer not found")
+	ErrCustomerHasUnpaidInvoices = errors.New("customer still has unpaid invoices")
+	ErrInvalidCurrency           = errors.New("invalid currency code")
+	ErrExchangeRateNotFound      = errors.New("exchange rate not found")
+	ErrInvalidRatesImport        = errors.New("invalid exchange rates import")
+	ErrInvalidInvoiceStatus      = errors.New("invalid invoice status")
+	ErrInvalidStatusTransition   = errors.New("invalid invoice status transition")
+	ErrInvalidPaymentMethod      = errors.New("invalid payment method")
+	ErrInvalidSortField          = errors.New("invalid sort field")
+	ErrInvoiceLocked             = errors.New("invoice is no longer a draft and cannot be edited")
+	ErrInvoiceVersionRequired    = errors.New("the version of the invoice being edited is required")
+	ErrInvoiceVersionConflict    = errors.New("invoice was changed since it was read")
+	ErrInvoiceHasSettlements     = errors.New("invoice has payments or issued credit notes, void it instead")
+	ErrCreditNoteNotFound        = errors.New("credit note not found")
+	ErrCreditNoteLocked          = errors.New("credit note is already issued and cannot be changed")
+	ErrInvalidCreditNoteStatus   = errors.New("invalid credit note status")
+	ErrInvalidCreditNoteLine     = errors.New("credit note line does not match the invoice")
+	ErrCreditExceedsInvoice      = errors.New("credited quantity exceeds what is left on the invoice line")
+	ErrQuoteNotFound             = errors.New("quote not found")
+	ErrQuoteLocked               = errors.New("quote is no longer a draft and cannot be edited")
+	ErrInvalidQuoteStatus        = errors.New("invalid quote status")
+	ErrInvalidQuoteTransition    = errors.New("invalid quote status transition")
+	ErrInvalidQuoteExpiry        = errors.New("quote must expire on or after its issue date")
+	ErrQuoteExpired              = errors.New("quote has expired")
+	ErrQuoteNotConvertible       = errors.New("only sent or accepted quotes can be converted")
+	ErrQuoteAlreadyConverted     = errors.New("quote has already been converted into an invoice")
+	ErrRecurringInvoiceNotFound  = errors.New("recurring invoice not found")
+	ErrInvalidRecurringSchedule  = errors.New("invalid recurring invoice schedule")
+	ErrInvalidRecurringStatus    = errors.New("invalid recurring invoice status")
+	ErrRecurringInvoiceCompleted = errors.New("recurring invoice has completed its schedule")
+	ErrRecurringRunExists        = errors.New("invoice for this recurring run already exists")
+	ErrRecurringRunConflict      = errors.New("recurring invoice was advanced concurrently")
+	ErrInvalidReportPeriod       = errors.New("report period must be day, week or month")
+	ErrInvalidStatementPeriod    = errors.New("statement must start on or before its end date")
+	ErrInvalidDate               = errors.New("date must be YYYY-MM-DD or an RFC3339 timestamp")
+	ErrUserNotFound              = errors.New("user not found")
+	ErrUserAlreadyExists         = errors.New("user with this email already exists")
+	ErrInvalidCredentials        = errors.New("invalid email or password")
+	ErrInvalidToken              = errors.New("invalid or expired token")
+	ErrInvalidRole               = errors.New("role must be admin, clerk, accountant or auditor")
+	ErrPasswordTooShort          = errors.New("password must be at least 8 characters")
+	ErrLastAdmin                 = errors.New("the last active admin cannot be demoted or deactivated")
+	ErrInvalidAuditEntity        = errors.New("entity must be invoice, customer or item")
+	ErrAuditEntityRequired       = errors.New("entity is required to filter by id")
 )
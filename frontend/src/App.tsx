@@ -1,11 +1,13 @@
 import { BrowserRouter, Routes, Route } from "react-router-dom";
 import { QueryClient, QueryClientProvider } from "@tanstack/react-query";
 import Layout from "./components/Layout";
+import RequireAuth from "./components/RequireAuth";
 import { Invoices } from "./pages";
 import AddInvoices from "./pages/AddInvoices";
 import EditInvoices from "./pages/EditInvoices";
 import ViewInvoice from "./pages/ViewInvoice";
 import Settings from "./pages/Setting";
+import Login from "./pages/Login";
 
 // Create a client
 const queryClient = new QueryClient({
@@ -21,16 +23,26 @@ function App() {
   return (
     <QueryClientProvider client={queryClient}>
       <BrowserRouter>
-        <Layout>
-          <Routes>
-            <Route path="/" element={<Invoices />} />
-            <Route path="/invoices" element={<Invoices />} />
-            <Route path="/invoices/add" element={<AddInvoices />} />
-            <Route path="/invoices/edit/:id" element={<EditInvoices />} />
-            <Route path="/invoices/view/:id" element={<ViewInvoice />} />
-            <Route path="/settings" element={<Settings />} />
-          </Routes>
-        </Layout>
+        <Routes>
+          <Route path="/login" element={<Login />} />
+          <Route
+            path="*"
+            element={
+              <RequireAuth>
+                <Layout>
+                  <Routes>
+                    <Route path="/" element={<Invoices />} />
+                    <Route path="/invoices" element={<Invoices />} />
+                    <Route path="/invoices/add" element={<AddInvoices />} />
+                    <Route path="/invoices/edit/:id" element={<EditInvoices />} />
+                    <Route path="/invoices/view/:id" element={<ViewInvoice />} />
+                    <Route path="/settings" element={<Settings />} />
+                  </Routes>
+                </Layout>
+              </RequireAuth>
+            }
+          />
+        </Routes>
       </BrowserRouter>
     </QueryClientProvider>
   );
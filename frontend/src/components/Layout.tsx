@@ -1,6 +1,8 @@
-import { Book, House, Settings } from "lucide-react";
+import { Book, House, LogOut, Settings } from "lucide-react";
 import { type ReactNode } from "react";
 import { Link, useLocation } from "react-router-dom";
+import { useQueryClient } from "@tanstack/react-query";
+import { useAuthStore } from "../stores/authStore";
 
 interface LayoutProps {
   children: ReactNode;
@@ -8,6 +10,13 @@ interface LayoutProps {
 
 export default function Layout({ children }: LayoutProps) {
   const location = useLocation();
+  const queryClient = useQueryClient();
+  const { user, logout } = useAuthStore();
+
+  const handleLogout = () => {
+    queryClient.clear();
+    logout();
+  };
 
   const navigation = [
     {
@@ -64,6 +73,14 @@ export default function Layout({ children }: LayoutProps) {
               </li>
             ))}
           </ul>
+
+          <div className="mt-16 px-3 text-white text-xs">
+            <p className="truncate mb-2">{user?.email}</p>
+            <button onClick={handleLogout} className="flex items-center gap-3 py-2 font-medium">
+              <LogOut />
+              Sign out
+            </button>
+          </div>
         </nav>
       </div>
 
@@ -0,0 +1,20 @@
+import { type ReactNode } from "react";
+import { Navigate, useLocation } from "react-router-dom";
+import { useAuthStore } from "../stores/authStore";
+
+interface RequireAuthProps {
+  children: ReactNode;
+}
+
+// Sends visitors who are not signed in to the login page, remembering where
+// they wanted to go
+export default function RequireAuth({ children }: RequireAuthProps) {
+  const isAuthenticated = useAuthStore((state) => state.isAuthenticated);
+  const location = useLocation();
+
+  if (!isAuthenticated) {
+    return <Navigate to="/login" replace state={{ from: location.pathname }} />;
+  }
+
+  return <>{children}</>;
+}
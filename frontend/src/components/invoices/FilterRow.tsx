@@ -1,5 +1,6 @@
 import { ChevronDown } from "lucide-react";
-import { type TRequestInvoice } from "../../types/invoice";
+import { type InvoiceStatus, type TRequestInvoice } from "../../types/invoice";
+import { INVOICE_STATUS_LABELS } from "../../utils/invoiceStatus";
 import DatePicker from "../ui/DatePicker";
 
 interface FilterRowProps {
@@ -81,14 +82,17 @@ export function FilterRow({ filters, onFiltersChange }: FilterRowProps) {
             onChange={(e) =>
               updateFilter(
                 "status",
-                e.target.value ? (e.target.value as "paid" | "unpaid") : null
+                e.target.value ? (e.target.value as InvoiceStatus) : null
               )
             }
-            className="shadow-input h-10 rounded-[10px] bg-white w-full max-w-[89px] px-2 text-sm appearance-none cursor-pointer border border-gray-200 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-transparent"
+            className="shadow-input h-10 rounded-[10px] bg-white w-full max-w-[130px] px-2 text-sm appearance-none cursor-pointer border border-gray-200 focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-transparent"
           >
             <option value=""></option>
-            <option value="paid">Paid</option>
-            <option value="unpaid">Unpaid</option>
+            {Object.entries(INVOICE_STATUS_LABELS).map(([value, label]) => (
+              <option key={value} value={value}>
+                {label}
+              </option>
+            ))}
           </select>
           <div className="absolute inset-y-0 right-0 flex items-center px-2 pointer-events-none">
             <ChevronDown size={24} />
@@ -1,7 +1,16 @@
 import { ArrowDownToLine, Eye, Pencil } from "lucide-react";
 import { type TInvoice } from "../../types/invoice";
+import { formatInvoiceStatus, invoiceStatusTone, type StatusTone } from "../../utils/invoiceStatus";
 import { Link } from "react-router-dom";
 
+const statusColors: Record<StatusTone, string> = {
+  neutral: "text-gray-500",
+  info: "text-blue-600",
+  warning: "text-amber-600",
+  success: "text-green-600",
+  danger: "text-red-500",
+};
+
 type InvoiceRowProps = {
   invoice: TInvoice;
   index: number;
@@ -11,7 +20,7 @@ type InvoiceRowProps = {
 
 export function InvoiceRow({ invoice, index, downloadInvoice, rowNumber }: InvoiceRowProps) {
   const getStatusColor = (status: string) => {
-    return status === "paid" ? "text-green-600" : "text-red-500";
+    return statusColors[invoiceStatusTone(status)];
   };
 
   const formatDate = (dateString: string) => {
@@ -60,7 +69,7 @@ export function InvoiceRow({ invoice, index, downloadInvoice, rowNumber }: Invoi
 
       <td className="px-6 py-4 whitespace-nowrap text-center text-sm font-medium">
         <div className={`text-sm ${getStatusColor(invoice.status)}`}>
-          {invoice.status}
+          {formatInvoiceStatus(invoice.status)}
         </div>
       </td>
 
@@ -1,5 +1,14 @@
 import React from 'react';
 import type { TInvoiceDetail } from '../../types/invoice';
+import { formatInvoiceStatus, invoiceStatusTone, type StatusTone } from '../../utils/invoiceStatus';
+
+const statusColors: Record<StatusTone, string> = {
+  neutral: 'bg-gray-500',
+  info: 'bg-blue-500',
+  warning: 'bg-amber-500',
+  success: 'bg-green-500',
+  danger: 'bg-red-500',
+};
 
 interface PrintableInvoiceProps {
   invoice: TInvoiceDetail;
@@ -15,10 +24,10 @@ const PrintableInvoice = React.forwardRef<HTMLDivElement, PrintableInvoiceProps>
             <h1 className="text-4xl font-bold text-black">INVOICE</h1>
             <span
               className={`px-3 py-1 text-xs font-bold text-white rounded ${
-                invoice.status === 'paid' ? 'bg-green-500' : 'bg-red-500'
+                statusColors[invoiceStatusTone(invoice.status)]
               }`}
             >
-              {invoice.status.toUpperCase()}
+              {formatInvoiceStatus(invoice.status).toUpperCase()}
             </span>
           </div>
           
@@ -152,6 +152,7 @@ export function useEditInvoiceForm(initialData?: TInvoiceDetail) {
         quantity: item.quantity,
         price: item.price,
       })),
+      version: initialData?.version,
     };
   };
 
@@ -0,0 +1,25 @@
+import { useMutation, useQueryClient } from "@tanstack/react-query";
+import { LoginRepository } from "../repository/auth";
+import { useAuthStore } from "../stores/authStore";
+import type { TLogin } from "../types/auth";
+
+export const useLogin = () => {
+  const queryClient = useQueryClient();
+  const login = useAuthStore((state) => state.login);
+
+  return useMutation({
+    mutationFn: (credentials: TLogin) => LoginRepository(credentials),
+
+    onSuccess: (data) => {
+      if (!data) return;
+
+      // nothing cached for a previous user may leak into this session
+      queryClient.clear();
+      login(data.user, data.access_token, data.refresh_token);
+    },
+
+    onError: (error) => {
+      console.error("Error signing in:", error);
+    },
+  });
+};
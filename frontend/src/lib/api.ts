@@ -1,4 +1,6 @@
-import axios from "axios";
+import axios, { type InternalAxiosRequestConfig } from "axios";
+import { useAuthStore } from "../stores/authStore";
+import type { TTokenResponse } from "../types/auth";
 
 // Create axios instance with default config
 export const api = axios.create({
@@ -9,6 +11,68 @@ export const api = axios.create({
   },
 });
 
+// Send the access token with every request
+api.interceptors.request.use((config) => {
+  const { token } = useAuthStore.getState();
+  if (token) {
+    config.headers.Authorization = `Bearer ${token}`;
+  }
+  return config;
+});
+
+// One refresh at a time; requests failing meanwhile wait for it
+let refreshing: Promise<string> | null = null;
+
+const refreshAccessToken = async (refreshToken: string) => {
+  const res = await axios.post<ApiResponse<TTokenResponse>>(
+    `${api.defaults.baseURL}/auth/refresh`,
+    { refresh_token: refreshToken }
+  );
+  const tokens = res.data.data!;
+  useAuthStore.getState().setTokens(tokens.access_token, tokens.refresh_token);
+  return tokens.access_token;
+};
+
+// Renew an expired or missing access token once and retry; when that is not
+// possible the session is over and the user has to sign in again
+api.interceptors.response.use(
+  (response) => response,
+  async (error) => {
+    const request = error.config as
+      | (InternalAxiosRequestConfig & { _retried?: boolean })
+      | undefined;
+    const { refreshToken, clearAuth } = useAuthStore.getState();
+
+    if (
+      error.response?.status !== 401 ||
+      !request ||
+      request._retried ||
+      request.url?.startsWith("/auth/")
+    ) {
+      return Promise.reject(error);
+    }
+
+    if (!refreshToken) {
+      clearAuth();
+      return Promise.reject(error);
+    }
+
+    try {
+      refreshing ??= refreshAccessToken(refreshToken).finally(() => {
+        refreshing = null;
+      });
+      const token = await refreshing;
+
+      request._retried = true;
+      request.headers.Authorization = `Bearer ${token}`;
+      return api(request);
+    } catch {
+      clearAuth();
+      return Promise.reject(error);
+    }
+  }
+);
+
 // API Response types
 export interface ApiResponse<T = any> {
   success: boolean;
@@ -0,0 +1,83 @@
+import { useState, type FormEvent } from "react";
+import { Navigate, useLocation, useNavigate } from "react-router-dom";
+import { useLogin } from "../hooks/useLogin";
+import { useAuthStore } from "../stores/authStore";
+
+export default function Login() {
+  const [email, setEmail] = useState("");
+  const [password, setPassword] = useState("");
+
+  const navigate = useNavigate();
+  const location = useLocation();
+  const from = (location.state as { from?: string } | null)?.from || "/";
+
+  const isAuthenticated = useAuthStore((state) => state.isAuthenticated);
+  const { mutate: login, isPending, isError } = useLogin();
+
+  if (isAuthenticated) {
+    return <Navigate to={from} replace />;
+  }
+
+  const handleSubmit = (e: FormEvent) => {
+    e.preventDefault();
+
+    login(
+      { email, password },
+      {
+        onSuccess: () => navigate(from, { replace: true }),
+      }
+    );
+  };
+
+  return (
+    <div className="min-h-screen bg-accent-500 flex items-center justify-center">
+      <form
+        onSubmit={handleSubmit}
+        className="w-full max-w-[400px] bg-accent-50 rounded-2xl px-[35px] py-[32px]"
+      >
+        <div className="flex items-center flex-row gap-2 mb-8">
+          <div className="size-9 rounded-full bg-accent-200 text-white flex items-center justify-center text-lg font-bold">H</div>
+          <h1 className="text-xl font-bold">Invoice System</h1>
+        </div>
+
+        <div className="mb-4">
+          <label className="text-xs mb-2 block">Email</label>
+          <input
+            type="email"
+            value={email}
+            onChange={(e) => setEmail(e.target.value)}
+            className="w-full rounded-lg shadow-input h-[46px] px-4 font-medium text-sm"
+            placeholder="Enter your email"
+            autoComplete="username"
+            required
+          />
+        </div>
+
+        <div className="mb-6">
+          <label className="text-xs mb-2 block">Password</label>
+          <input
+            type="password"
+            value={password}
+            onChange={(e) => setPassword(e.target.value)}
+            className="w-full rounded-lg shadow-input h-[46px] px-4 font-medium text-sm"
+            placeholder="Enter your password"
+            autoComplete="current-password"
+            required
+          />
+        </div>
+
+        {isError && (
+          <p className="text-red-700 text-sm mb-4">Invalid email or password.</p>
+        )}
+
+        <button
+          type="submit"
+          disabled={isPending}
+          className="w-full bg-accent-200 text-white py-3 px-[20px] rounded-lg"
+        >
+          {isPending ? "Signing in..." : "Sign in"}
+        </button>
+      </form>
+    </div>
+  );
+}
@@ -7,6 +7,15 @@ import ListInvoiceItemsView from "../components/invoices/ListInvoiceItemsView";
 import { LoadingSpinner } from "../components/ui";
 import { PrintInvoiceButton } from "../components/invoices";
 import { ArrowDownToLine } from "lucide-react";
+import { formatInvoiceStatus, invoiceStatusTone, type StatusTone } from "../utils/invoiceStatus";
+
+const statusBadgeColors: Record<StatusTone, string> = {
+  neutral: "bg-gray-100 text-gray-800",
+  info: "bg-blue-100 text-blue-800",
+  warning: "bg-amber-100 text-amber-800",
+  success: "bg-green-100 text-green-800",
+  danger: "bg-red-100 text-red-800",
+};
 
 export default function ViewInvoice() {
   const { id } = useParams<{ id: string }>();
@@ -114,14 +123,11 @@ export default function ViewInvoice() {
             <div className="flex items-center space-x-2">
               <span
                 className={`inline-flex items-center px-6 py-2 rounded-lg font-medium text-sm h-[42px] ${
-                  invoice.status === "paid"
-                    ? "bg-green-100 text-green-800"
-                    : "bg-red-100 text-red-800"
+                  statusBadgeColors[invoiceStatusTone(invoice.status)]
                 }`}
               >
                 Status {" : "}
-                {invoice.status.charAt(0).toUpperCase() +
-                  invoice.status.slice(1)}
+                {formatInvoiceStatus(invoice.status)}
               </span>
 
               <PrintInvoiceButton 
@@ -0,0 +1,7 @@
+import { apiClient } from "../lib/api";
+import type { TLogin, TTokenResponse } from "../types/auth";
+
+export const LoginRepository = async (credentials: TLogin) => {
+  const res = await apiClient.post<TTokenResponse>("/auth/login", credentials);
+  return res.data;
+};
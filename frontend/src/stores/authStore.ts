@@ -1,23 +1,21 @@
 import { create } from "zustand";
 import { devtools, persist } from "zustand/middleware";
+import type { TUser } from "../types/auth";
 
 // Auth state interface
-export interface User {
-  id: string;
-  name: string;
-  email: string;
-  role: "admin" | "user";
-}
+export type User = TUser;
 
 interface AuthState {
   user: User | null;
   token: string | null;
+  refreshToken: string | null;
   isAuthenticated: boolean;
   isLoading: boolean;
 }
 
 interface AuthActions {
-  login: (user: User, token: string) => void;
+  login: (user: User, token: string, refreshToken: string) => void;
+  setTokens: (token: string, refreshToken: string) => void;
   logout: () => void;
   setUser: (user: User) => void;
   setLoading: (loading: boolean) => void;
@@ -34,16 +32,18 @@ export const useAuthStore = create<AuthStore>()(
         // Initial state
         user: null,
         token: null,
+        refreshToken: null,
         isAuthenticated: false,
         isLoading: false,
 
         // Actions
-        login: (user, token) =>
+        login: (user, token, refreshToken) =>
           set(
             (state) => ({
               ...state,
               user,
               token,
+              refreshToken,
               isAuthenticated: true,
               isLoading: false,
             }),
@@ -51,12 +51,24 @@ export const useAuthStore = create<AuthStore>()(
             "auth/login"
           ),
 
+        setTokens: (token, refreshToken) =>
+          set(
+            (state) => ({
+              ...state,
+              token,
+              refreshToken,
+            }),
+            false,
+            "auth/setTokens"
+          ),
+
         logout: () =>
           set(
             (state) => ({
               ...state,
               user: null,
               token: null,
+              refreshToken: null,
               isAuthenticated: false,
               isLoading: false,
             }),
@@ -90,6 +102,7 @@ export const useAuthStore = create<AuthStore>()(
               ...state,
               user: null,
               token: null,
+              refreshToken: null,
               isAuthenticated: false,
               isLoading: false,
             }),
@@ -99,9 +112,11 @@ export const useAuthStore = create<AuthStore>()(
       }),
       {
         name: "auth-storage",
-        // Only persist non-sensitive data
+        // The short-lived access token stays in memory; after a reload it
+        // is renewed with the refresh token (see lib/api.ts)
         partialize: (state) => ({
           user: state.user,
+          refreshToken: state.refreshToken,
           isAuthenticated: state.isAuthenticated,
         }),
       }
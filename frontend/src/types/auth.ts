@@ -0,0 +1,26 @@
+export type TRole = "admin" | "clerk" | "accountant" | "auditor";
+
+export type TUser = {
+  id: number;
+  tenant_id: number;
+  name: string;
+  email: string;
+  role: TRole;
+  is_active: boolean;
+  permissions: string[];
+  created_at: string;
+};
+
+export type TLogin = {
+  email: string;
+  password: string;
+};
+
+export type TTokenResponse = {
+  access_token: string;
+  access_expires_at: string;
+  refresh_token: string;
+  refresh_expires_at: string;
+  token_type: string;
+  user: TUser;
+};
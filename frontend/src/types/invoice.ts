@@ -2,11 +2,16 @@ import type { TItem } from "./item";
 import type { TCustomer } from "./customer";
 
 export const InvoiceStatus = {
+  DRAFT: "draft",
+  ISSUED: "issued",
+  PARTIALLY_PAID: "partially_paid",
   PAID: "paid",
-  UNPAID: "unpaid",
+  OVERDUE: "overdue",
+  VOID: "void",
+  CANCELLED: "cancelled",
 } as const;
 
-type InvoiceStatus = (typeof InvoiceStatus)[keyof typeof InvoiceStatus];
+export type InvoiceStatus = (typeof InvoiceStatus)[keyof typeof InvoiceStatus];
 
 export type TInvoice = {
   id: number;
@@ -56,6 +61,8 @@ export type TCreateInvoice = {
     quantity: number;
     price: number;
   }[];
+  // version of the invoice an edit was made to
+  version?: number;
 };
 
 export type TUpdateInvoice = Partial<TCreateInvoice>;
@@ -95,6 +102,7 @@ export type TInvoiceDetail = {
   tax: number;
   total_amount: number;
   status: InvoiceStatus;
+  version: number;
   created_at: string;
   updated_at: string;
 };
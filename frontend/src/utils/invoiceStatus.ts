@@ -0,0 +1,42 @@
+import { InvoiceStatus } from '../types/invoice';
+
+/**
+ * Labels of the invoice statuses, in lifecycle order
+ */
+export const INVOICE_STATUS_LABELS: Record<InvoiceStatus, string> = {
+  [InvoiceStatus.DRAFT]: 'Draft',
+  [InvoiceStatus.ISSUED]: 'Issued',
+  [InvoiceStatus.PARTIALLY_PAID]: 'Partially paid',
+  [InvoiceStatus.PAID]: 'Paid',
+  [InvoiceStatus.OVERDUE]: 'Overdue',
+  [InvoiceStatus.VOID]: 'Void',
+  [InvoiceStatus.CANCELLED]: 'Cancelled',
+};
+
+export type StatusTone = 'neutral' | 'info' | 'warning' | 'success' | 'danger';
+
+/**
+ * Colour tone of a status: only overdue invoices are shown as a problem,
+ * drafts and closed invoices stay neutral
+ */
+export const invoiceStatusTone = (status: string): StatusTone => {
+  switch (status) {
+    case InvoiceStatus.ISSUED:
+      return 'info';
+    case InvoiceStatus.PARTIALLY_PAID:
+      return 'warning';
+    case InvoiceStatus.PAID:
+      return 'success';
+    case InvoiceStatus.OVERDUE:
+      return 'danger';
+    default:
+      return 'neutral';
+  }
+};
+
+/**
+ * Display label of a status, falling back to the raw value
+ */
+export const formatInvoiceStatus = (status: string): string => {
+  return INVOICE_STATUS_LABELS[status as InvoiceStatus] ?? status;
+};
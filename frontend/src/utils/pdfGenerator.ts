@@ -1,6 +1,15 @@
 import jsPDF from 'jspdf';
 import html2canvas from 'html2canvas';
 import type { TInvoiceDetail } from '../types/invoice';
+import { formatInvoiceStatus, invoiceStatusTone, type StatusTone } from './invoiceStatus';
+
+const statusColors: Record<StatusTone, [number, number, number]> = {
+  neutral: [117, 117, 117],
+  info: [33, 150, 243],
+  warning: [255, 160, 0],
+  success: [76, 175, 80],
+  danger: [244, 67, 54],
+};
 
 export interface PDFOptions {
   filename?: string;
@@ -121,9 +130,9 @@ export class InvoicePDFGenerator {
       pdf.setTextColor(0, 0, 0);
       pdf.text('INVOICE', margin.left, yPosition + 5);
 
-      // Status Badge (PAID/UNPAID) - positioned after header
-      const statusText = invoice.status.toUpperCase();
-      const statusColor = invoice.status === 'paid' ? [76, 175, 80] : [244, 67, 54]; // Green for PAID, Red for UNPAID
+      // Status Badge - positioned after header
+      const statusText = formatInvoiceStatus(invoice.status).toUpperCase();
+      const statusColor = statusColors[invoiceStatusTone(invoice.status)];
       
       // Calculate status badge dimensions
       const statusWidth = statusText.length * 3 + 8;